@@ -0,0 +1,111 @@
+package spdx
+
+import "slices"
+
+// Tally is the weight observed for one expression.
+type Tally struct {
+	Expression string  // normalized expression, in the form first observed
+	Count      int     // total weight observed
+	Share      float64 // Count as a fraction of all licensed weight
+}
+
+// Aggregate is the result of an Aggregator.
+type Aggregate struct {
+	// Dominant is the expression with the most weight. Its Expression
+	// is empty if no licensed expression was observed.
+	Dominant Tally
+	// Outliers are the other expressions, heaviest first.
+	Outliers []Tally
+	// Total is the weight of all licensed observations.
+	Total int
+	// Undetermined is the weight of NONE and NOASSERTION observations,
+	// which are left out of Total, Dominant and Outliers.
+	Undetermined int
+}
+
+// Aggregator tallies weighted observations of expressions, such as
+// per-file license detections, to find the license of a whole repository
+// and the files that differ from it. Expressions are compared by meaning,
+// so "MIT OR Apache-2.0" and "Apache-2.0 OR MIT" are tallied together.
+// The zero value is ready to use. An Aggregator is not safe for
+// concurrent use.
+//
+// Example:
+//
+//	var a Aggregator
+//	a.Observe("MIT", 120)
+//	a.Observe("mit license", 3)
+//	a.Observe("Apache-2.0", 2)
+//	a.Observe("NOASSERTION", 40)
+//	r := a.Result()
+//	r.Dominant  // Tally{Expression: "MIT", Count: 123, Share: 0.984}
+//	r.Outliers  // [Tally{Expression: "Apache-2.0", Count: 2, Share: 0.016}]
+type Aggregator struct {
+	keys         []string // distinct equivalence keys, in first-seen order
+	tallies      map[string]*Tally
+	undetermined int
+}
+
+// Observe parses expression and adds count to its weight. Counts below 1
+// are ignored. It returns the parse error, leaving the tallies unchanged,
+// if expression does not parse.
+func (a *Aggregator) Observe(expression string, count int) error {
+	expr, err := Parse(expression)
+	if err != nil {
+		return err
+	}
+	a.ObserveExpression(expr, count)
+	return nil
+}
+
+// ObserveExpression adds count to the weight of a parsed expression.
+// Counts below 1 are ignored.
+func (a *Aggregator) ObserveExpression(expr Expression, count int) {
+	if count < 1 {
+		return
+	}
+	if _, ok := expr.(*SpecialValue); ok {
+		a.undetermined += count
+		return
+	}
+
+	key := equivalenceKey(expr)
+	t, ok := a.tallies[key]
+	if !ok {
+		if a.tallies == nil {
+			a.tallies = make(map[string]*Tally)
+		}
+		t = &Tally{Expression: expr.String()}
+		a.tallies[key] = t
+		a.keys = append(a.keys, key)
+	}
+	t.Count += count
+}
+
+// Result returns the dominant expression and the outliers observed so
+// far. Ties go to the expression observed first, and outliers with equal
+// weight keep the order they were first observed in.
+func (a *Aggregator) Result() Aggregate {
+	r := Aggregate{Undetermined: a.undetermined}
+	tallies := make([]Tally, 0, len(a.keys))
+	for _, key := range a.keys {
+		t := *a.tallies[key]
+		r.Total += t.Count
+		tallies = append(tallies, t)
+	}
+	if len(tallies) == 0 {
+		return r
+	}
+
+	for i := range tallies {
+		tallies[i].Share = float64(tallies[i].Count) / float64(r.Total)
+	}
+	slices.SortStableFunc(tallies, func(x, y Tally) int {
+		return y.Count - x.Count
+	})
+	r.Dominant = tallies[0]
+	if len(tallies) > 1 {
+		r.Outliers = tallies[1:]
+	}
+	return r
+}
@@ -0,0 +1,72 @@
+package spdx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAggregator(t *testing.T) {
+	var a Aggregator
+	observations := []struct {
+		expr  string
+		count int
+	}{
+		{"Apache-2.0", 2},
+		{"MIT", 120},
+		{"mit license", 3},
+		{"BSD-3-Clause", 2},
+		{"NOASSERTION", 40},
+		{"NONE", 1},
+		{"MIT OR Apache-2.0", 1},
+		{"Apache-2.0 OR MIT", 1},
+		{"ISC", 0},
+	}
+	for _, o := range observations {
+		if err := a.Observe(o.expr, o.count); err != nil {
+			t.Fatalf("Observe(%q): %v", o.expr, err)
+		}
+	}
+	if err := a.Observe("MIT AND", 5); err == nil {
+		t.Error("Observe of an invalid expression should fail")
+	}
+
+	r := a.Result()
+	if r.Total != 129 || r.Undetermined != 41 {
+		t.Errorf("Total = %d, Undetermined = %d; want 129, 41", r.Total, r.Undetermined)
+	}
+	if r.Dominant.Expression != "MIT" || r.Dominant.Count != 123 || math.Abs(r.Dominant.Share-123.0/129) > 1e-9 {
+		t.Errorf("Dominant = %+v", r.Dominant)
+	}
+
+	want := []Tally{
+		{Expression: "Apache-2.0", Count: 2},
+		{Expression: "BSD-3-Clause", Count: 2},
+		{Expression: "MIT OR Apache-2.0", Count: 2},
+	}
+	if len(r.Outliers) != len(want) {
+		t.Fatalf("Outliers = %+v, want %+v", r.Outliers, want)
+	}
+	for i, w := range want {
+		if got := r.Outliers[i]; got.Expression != w.Expression || got.Count != w.Count {
+			t.Errorf("Outliers[%d] = %+v, want %+v", i, got, w)
+		}
+	}
+}
+
+func TestAggregatorTies(t *testing.T) {
+	var a Aggregator
+	a.Observe("ISC", 5)
+	a.Observe("MIT", 5)
+	if got := a.Result().Dominant.Expression; got != "ISC" {
+		t.Errorf("Dominant = %q, want the first observed on a tie", got)
+	}
+}
+
+func TestAggregatorEmpty(t *testing.T) {
+	var a Aggregator
+	a.Observe("NOASSERTION", 3)
+	r := a.Result()
+	if r.Dominant.Expression != "" || r.Outliers != nil || r.Total != 0 || r.Undetermined != 3 {
+		t.Errorf("Result() = %+v", r)
+	}
+}
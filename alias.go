@@ -0,0 +1,66 @@
+package spdx
+
+import (
+	"slices"
+	"strings"
+)
+
+// AllAliasesOf returns the known alternative names for a license: deprecated
+// SPDX identifiers, scancode license keys, and the informal names,
+// abbreviations and common misspellings known to the normalizer. Every
+// alias normalizes back to id with the current options. The result is sorted and excludes id itself;
+// it is nil if id is not a known SPDX license identifier.
+//
+// Example:
+//
+//	AllAliasesOf("Apache-2.0")
+//	// []string{"ALV2", "APACHE", "ASL", ..., "Apache License, Version 2.0", "apache-2.0", ...}
+func AllAliasesOf(id string) []string {
+	cfg := loadConfig()
+	reg := cfg.registry()
+	canonical := reg.lookupLicense(id)
+	if canonical == "" {
+		return nil
+	}
+	canonical = cfg.upgrade(canonical)
+
+	seen := map[string]bool{canonical: true}
+	var aliases []string
+	add := func(alias string) {
+		alias = strings.TrimSpace(alias)
+		if len(alias) < 2 || seen[alias] {
+			return
+		}
+		seen[alias] = true
+		if got, err := normalize(alias, cfg); err == nil && got == canonical {
+			aliases = append(aliases, alias)
+		}
+	}
+
+	// Deprecated SPDX identifiers, such as GPL-2.0 for GPL-2.0-only
+	for _, dep := range reg.deprecated {
+		add(dep)
+	}
+
+	// Scancode keys and alternative SPDX keys
+	for _, entry := range reg.entries() {
+		if !strings.EqualFold(entry.SPDXLicenseKey, canonical) && !strings.EqualFold(entry.SPDXLicenseKey, id) {
+			continue
+		}
+		add(entry.LicenseKey)
+		for _, key := range entry.OtherSPDXKeys {
+			add(key)
+		}
+	}
+
+	// Informal names from the normalization tables
+	for _, t := range transpositionData {
+		add(t.from)
+	}
+	for _, lr := range lastResorts {
+		add(lr.substring)
+	}
+
+	slices.Sort(aliases)
+	return aliases
+}
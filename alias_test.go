@@ -0,0 +1,44 @@
+package spdx
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAllAliasesOf(t *testing.T) {
+	tests := map[string][]string{
+		"Apache-2.0":       {"ASL2", "Apache License, Version 2.0", "apache-2.0"},
+		"GPL-2.0-only":     {"GPL-2.0", "GPLV2"},
+		"GPL-3.0-or-later": {"GPL-3.0", "GPL-3.0+", "GNU General Public License"},
+		"MIT":              {"The MIT License", "mit"},
+		"Unlicense":        {"PUBLIC DOMAIN"},
+	}
+
+	for id, want := range tests {
+		t.Run(id, func(t *testing.T) {
+			aliases := AllAliasesOf(id)
+			for _, alias := range want {
+				if !slices.Contains(aliases, alias) {
+					t.Errorf("AllAliasesOf(%q) missing %q, got %v", id, alias, aliases)
+				}
+			}
+			if slices.Contains(aliases, id) {
+				t.Errorf("AllAliasesOf(%q) should not contain the ID itself", id)
+			}
+			if !slices.IsSorted(aliases) {
+				t.Errorf("AllAliasesOf(%q) is not sorted", id)
+			}
+			for _, alias := range aliases {
+				if got, err := Normalize(alias); err != nil || got != id {
+					t.Errorf("Normalize(%q) = %q, %v, want %q", alias, got, err, id)
+				}
+			}
+		})
+	}
+}
+
+func TestAllAliasesOfUnknown(t *testing.T) {
+	if got := AllAliasesOf("FAKEYLICENSE"); got != nil {
+		t.Errorf("AllAliasesOf(FAKEYLICENSE) = %v, want nil", got)
+	}
+}
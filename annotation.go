@@ -0,0 +1,58 @@
+package spdx
+
+// Annotations are free-form curation notes attached to a node of an
+// expression tree, such as a reviewer's comment or a ticket link, keyed
+// by a name of the curator's choosing. They are carried through
+// MarshalExpression and UnmarshalExpression but never appear in String,
+// so annotated and plain trees print the same.
+//
+// Functions that rebuild a tree, such as FoldVersionRange, keep the
+// annotations of the licenses they reuse; AND and OR nodes they create
+// start without annotations.
+type Annotations map[string]string
+
+// Annotate sets the annotation key to value on the root node of expr.
+//
+// Example:
+//
+//	expr, _ := Parse("MIT OR GPL-2.0-only")
+//	Annotate(expr, "reviewer", "alice")
+//	Annotate(expr.(*OrExpression).Right, "ticket", "https://example.com/LEGAL-42")
+//	expr.String()  // "MIT OR GPL-2.0-only"
+func Annotate(expr Expression, key, value string) {
+	p := annotationsOf(expr)
+	if p == nil {
+		return
+	}
+	if *p == nil {
+		*p = make(Annotations)
+	}
+	(*p)[key] = value
+}
+
+// AnnotationsOf returns the annotations on the root node of expr, or nil
+// if it has none.
+func AnnotationsOf(expr Expression) Annotations {
+	if p := annotationsOf(expr); p != nil {
+		return *p
+	}
+	return nil
+}
+
+// annotationsOf returns a pointer to the Annotations field of expr's root
+// node.
+func annotationsOf(expr Expression) *Annotations {
+	switch e := expr.(type) {
+	case *License:
+		return &e.Annotations
+	case *LicenseRef:
+		return &e.Annotations
+	case *AndExpression:
+		return &e.Annotations
+	case *OrExpression:
+		return &e.Annotations
+	case *SpecialValue:
+		return &e.Annotations
+	}
+	return nil
+}
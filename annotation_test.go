@@ -0,0 +1,47 @@
+package spdx
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestAnnotate(t *testing.T) {
+	expr, err := Parse("MIT OR GPL-2.0-only")
+	if err != nil {
+		t.Fatal(err)
+	}
+	Annotate(expr, "reviewer", "alice")
+	right := expr.(*OrExpression).Right
+	Annotate(right, "ticket", "LEGAL-42")
+	Annotate(right, "note", "linked statically")
+
+	if got := expr.String(); got != "MIT OR GPL-2.0-only" {
+		t.Errorf("String() = %q; annotations must not appear", got)
+	}
+	if got := AnnotationsOf(expr); !maps.Equal(got, Annotations{"reviewer": "alice"}) {
+		t.Errorf("AnnotationsOf(root) = %v", got)
+	}
+	if got := AnnotationsOf(right); !maps.Equal(got, Annotations{"ticket": "LEGAL-42", "note": "linked statically"}) {
+		t.Errorf("AnnotationsOf(right) = %v", got)
+	}
+	if got := AnnotationsOf(expr.(*OrExpression).Left); got != nil {
+		t.Errorf("AnnotationsOf(left) = %v, want nil", got)
+	}
+
+	Annotate(nil, "ignored", "x")
+	if got := AnnotationsOf(nil); got != nil {
+		t.Errorf("AnnotationsOf(nil) = %v, want nil", got)
+	}
+}
+
+func TestAnnotationsSurviveFold(t *testing.T) {
+	expr, err := Parse("MIT OR GPL-2.0-only")
+	if err != nil {
+		t.Fatal(err)
+	}
+	Annotate(expr.(*OrExpression).Left, "ticket", "LEGAL-7")
+	folded := foldVersions(expr)
+	if got := AnnotationsOf(folded.(*OrExpression).Left); got["ticket"] != "LEGAL-7" {
+		t.Errorf("license annotations after foldVersions = %v", got)
+	}
+}
@@ -0,0 +1,117 @@
+package spdx
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Badge holds the data needed to render a license badge, in the shape
+// used by shields.io static badges.
+type Badge struct {
+	Label    string   // left-hand text, always "license"
+	Message  string   // right-hand text, the normalized expression
+	Color    string   // shields.io color name
+	Category Category // category the color was chosen from
+	URL      string   // license page for single-license expressions
+}
+
+// badgeColors maps categories to shields.io color names. Categories not
+// listed are shown in red, except unknown ones which are grey.
+var badgeColors = map[Category]string{
+	CategoryPublicDomain:    "brightgreen",
+	CategoryPermissive:      "green",
+	CategoryCopyleftLimited: "yellow",
+	CategoryCopyleft:        "orange",
+	CategoryUnknown:         "lightgrey",
+	CategoryUnstated:        "lightgrey",
+}
+
+// badgeRank orders categories for choosing a badge color. Unknown
+// categories rank last so that a known alternative is preferred.
+func badgeRank(c Category) int {
+	if r, ok := categoryRestrictiveness[c]; ok {
+		return r
+	}
+	if c == CategoryUnknown || c == CategoryUnstated {
+		return 5
+	}
+	return 4
+}
+
+// badgeCategory returns the category that describes an expression: the
+// least restrictive alternative of an OR, and the most restrictive operand
+// of an AND.
+func badgeCategory(expr Expression, cfg *config) Category {
+	switch e := expr.(type) {
+	case *License:
+		id := e.ID
+		if e.Plus {
+			id += "+"
+		}
+		if cfg.opts.ApplyExceptions {
+			return effectiveCategory(cfg.registry(), id, e.Exception)
+		}
+		return cfg.registry().category(id)
+	case *AndExpression:
+		left, right := badgeCategory(e.Left, cfg), badgeCategory(e.Right, cfg)
+		if badgeRank(right) > badgeRank(left) {
+			return right
+		}
+		return left
+	case *OrExpression:
+		left, right := badgeCategory(e.Left, cfg), badgeCategory(e.Right, cfg)
+		if badgeRank(right) < badgeRank(left) {
+			return right
+		}
+		return left
+	default:
+		return CategoryUnknown
+	}
+}
+
+// ExpressionBadge returns badge metadata for an expression. The color
+// reflects the category a consumer ends up with: "MIT OR GPL-3.0-only" is
+// green because MIT can be chosen, while "MIT AND GPL-3.0-only" is orange.
+//
+// Example:
+//
+//	b, _ := ExpressionBadge("Apache-2.0")
+//	// Badge{Label: "license", Message: "Apache-2.0", Color: "green",
+//	//       URL: "https://spdx.org/licenses/Apache-2.0.html"}
+func ExpressionBadge(expression string) (*Badge, error) {
+	cfg := loadConfig()
+	expr, err := parseConfig(expression, false, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	cat := badgeCategory(expr, cfg)
+	color, ok := badgeColors[cat]
+	if !ok {
+		color = "red"
+	}
+
+	b := &Badge{
+		Label:    "license",
+		Message:  expr.String(),
+		Color:    color,
+		Category: cat,
+	}
+	if lic, ok := expr.(*License); ok && lic.Exception == "" {
+		b.URL, _ = LicenseURL(lic.ID)
+	}
+	return b, nil
+}
+
+// ShieldsURL returns a shields.io static badge image URL for the badge.
+func (b *Badge) ShieldsURL() string {
+	return "https://img.shields.io/badge/" + shieldsEscape(b.Label) + "-" + shieldsEscape(b.Message) + "-" + b.Color
+}
+
+// shieldsEscape escapes text for a shields.io badge path segment, where
+// "-" and "_" are separators and must be doubled.
+func shieldsEscape(s string) string {
+	s = strings.ReplaceAll(s, "-", "--")
+	s = strings.ReplaceAll(s, "_", "__")
+	return url.PathEscape(s)
+}
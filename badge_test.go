@@ -0,0 +1,46 @@
+package spdx
+
+import "testing"
+
+func TestExpressionBadge(t *testing.T) {
+	tests := []struct {
+		input   string
+		message string
+		color   string
+		url     string
+	}{
+		{"MIT", "MIT", "green", "https://spdx.org/licenses/MIT.html"},
+		{"apache 2", "Apache-2.0", "green", "https://spdx.org/licenses/Apache-2.0.html"},
+		{"CC0-1.0", "CC0-1.0", "brightgreen", "https://spdx.org/licenses/CC0-1.0.html"},
+		{"MPL-2.0", "MPL-2.0", "yellow", "https://spdx.org/licenses/MPL-2.0.html"},
+		{"GPL-3.0-only", "GPL-3.0-only", "orange", "https://spdx.org/licenses/GPL-3.0-only.html"},
+		{"MIT OR GPL-3.0-only", "MIT OR GPL-3.0-only", "green", ""},
+		{"MIT AND GPL-3.0-only", "MIT AND GPL-3.0-only", "orange", ""},
+		{"LicenseRef-custom", "LicenseRef-custom", "lightgrey", ""},
+		{"MIT OR LicenseRef-custom", "MIT OR LicenseRef-custom", "green", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			b, err := ExpressionBadge(tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if b.Label != "license" || b.Message != tt.message || b.Color != tt.color || b.URL != tt.url {
+				t.Errorf("ExpressionBadge(%q) = %+v, want message %q color %q url %q", tt.input, b, tt.message, tt.color, tt.url)
+			}
+		})
+	}
+
+	if _, err := ExpressionBadge("MIT AND"); err == nil {
+		t.Error("ExpressionBadge should fail for invalid input")
+	}
+}
+
+func TestShieldsURL(t *testing.T) {
+	b := &Badge{Label: "license", Message: "MIT OR Apache-2.0", Color: "green"}
+	want := "https://img.shields.io/badge/license-MIT%20OR%20Apache--2.0-green"
+	if got := b.ShieldsURL(); got != want {
+		t.Errorf("ShieldsURL() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,139 @@
+package spdx
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Lic returns the expression for a single license identifier, for building
+// expressions in code with And, Or and With instead of concatenating
+// strings and parsing the result. Identifiers are put in their canonical
+// case, a trailing "+" sets Plus, "LicenseRef-" and "DocumentRef-"
+// identifiers give a LicenseRef, and NONE and NOASSERTION give a
+// SpecialValue. Unknown identifiers are kept as written, so check a built
+// expression with ValidateExpression.
+//
+// Example:
+//
+//	expr := Or(Lic("mit"), And(Lic("Apache-2.0"), Lic("GPL-2.0+")))
+//	expr.String() // "MIT OR (Apache-2.0 AND GPL-2.0+)"
+func Lic(id string) Expression {
+	id = strings.TrimSpace(id)
+	switch upper := strings.ToUpper(id); {
+	case upper == "NONE" || upper == "NOASSERTION":
+		return &SpecialValue{Value: upper}
+	case strings.HasPrefix(upper, "DOCUMENTREF-"):
+		return parseDocumentRef(id)
+	case strings.HasPrefix(upper, "LICENSEREF-"):
+		return parseLicenseRef(id)
+	}
+	return buildLicense(id, loadConfig().registry())
+}
+
+// With returns the expression for a license with an exception. The
+// exception is put in its canonical case; an "AdditionRef-" exception, such
+// as a rider from DetectRiders, is kept as written.
+//
+// Example:
+//
+//	With("GPL-2.0-only", "classpath-exception-2.0").String()
+//	// "GPL-2.0-only WITH Classpath-exception-2.0"
+func With(license, exception string) *License {
+	reg := loadConfig().registry()
+	l := buildLicense(strings.TrimSpace(license), reg)
+	l.Exception = strings.TrimSpace(exception)
+	if id := reg.lookupException(l.Exception); id != "" {
+		l.Exception = id
+	}
+	return l
+}
+
+// And joins expressions that all apply into a left-nested AND chain, as
+// Parse builds it. String adds the parentheses precedence needs.
+//
+// Example:
+//
+//	And(Lic("MIT"), Or(Lic("ISC"), Lic("0BSD"))).String()
+//	// "MIT AND (ISC OR 0BSD)"
+func And(a, b Expression, more ...Expression) Expression {
+	return joinAnd(append([]Expression{a, b}, more...))
+}
+
+// Or joins alternatives into a left-nested OR chain, as Parse builds it.
+//
+// Example:
+//
+//	Or(Lic("MIT"), Lic("Apache-2.0"), Lic("ISC")).String()
+//	// "MIT OR Apache-2.0 OR ISC"
+func Or(a, b Expression, more ...Expression) Expression {
+	return joinOr(append([]Expression{a, b}, more...))
+}
+
+// buildLicense returns the License for id, in its canonical case if it is
+// known. A trailing "+" is split off as Parse does.
+func buildLicense(id string, reg *registry) *License {
+	id, plus := strings.CutSuffix(id, "+")
+	if canonical := reg.lookupLicense(id); canonical != "" {
+		id = canonical
+	}
+	return &License{ID: id, Plus: plus}
+}
+
+// ValidateExpression checks an expression tree built in code, or edited
+// after parsing, with the rules ParseStrict applies to a string: license
+// and exception identifiers must be canonical SPDX identifiers, operators
+// need both operands, and NONE and NOASSERTION must stand alone.
+//
+// Example:
+//
+//	ValidateExpression(And(Lic("MIT"), Lic("Apache-2.0")))  // nil
+//	ValidateExpression(Or(Lic("MIT"), Lic("Apache 2")))     // ErrInvalidLicenseID
+//	ValidateExpression(And(Lic("MIT"), Lic("NONE")))        // ErrInvalidSpecialValue
+func ValidateExpression(expr Expression) error {
+	if expr == nil {
+		return ErrEmptyExpression
+	}
+	if s, ok := expr.(*SpecialValue); ok {
+		if s.Value != "NONE" && s.Value != "NOASSERTION" {
+			return fmt.Errorf("%w: %s", ErrInvalidSpecialValue, s.Value)
+		}
+		return nil
+	}
+	return validateNode(expr, loadConfig().registry())
+}
+
+// validateNode checks a node below the root, where special values are not
+// allowed.
+func validateNode(expr Expression, reg *registry) error {
+	switch e := expr.(type) {
+	case *License:
+		if e.ID == "" || reg.lookupLicense(e.ID) != e.ID {
+			return fmt.Errorf("%w: %s", ErrInvalidLicenseID, e.ID)
+		}
+		if e.Exception != "" && !isAdditionRef(e.Exception) && reg.lookupException(e.Exception) != e.Exception {
+			return fmt.Errorf("%w: %s", ErrInvalidException, e.Exception)
+		}
+	case *LicenseRef:
+		if e.LicenseRef == "" {
+			return fmt.Errorf("%w: %s", ErrInvalidLicenseID, e.String())
+		}
+	case *AndExpression:
+		return validateOperands(e.Left, e.Right, "AND", reg)
+	case *OrExpression:
+		return validateOperands(e.Left, e.Right, "OR", reg)
+	case *SpecialValue:
+		return fmt.Errorf("%w: %s", ErrInvalidSpecialValue, e.Value)
+	}
+	return nil
+}
+
+// validateOperands checks both operands of an AND or OR node.
+func validateOperands(left, right Expression, op string, reg *registry) error {
+	if left == nil || right == nil {
+		return fmt.Errorf("%w: %s", ErrMissingOperand, op)
+	}
+	if err := validateNode(left, reg); err != nil {
+		return err
+	}
+	return validateNode(right, reg)
+}
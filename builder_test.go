@@ -0,0 +1,76 @@
+package spdx
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestBuilder(t *testing.T) {
+	tests := []struct {
+		expr Expression
+		want string
+	}{
+		{Lic("MIT"), "MIT"},
+		{Lic(" apache-2.0 "), "Apache-2.0"},
+		{Lic("GPL-2.0+"), "GPL-2.0+"},
+		{Lic("licenseref-Internal"), "LicenseRef-Internal"},
+		{Lic("DocumentRef-doc:LicenseRef-Other"), "DocumentRef-doc:LicenseRef-Other"},
+		{Lic("noassertion"), "NOASSERTION"},
+		{With("GPL-2.0-only", "Classpath-exception-2.0"), "GPL-2.0-only WITH Classpath-exception-2.0"},
+		{With("gpl-2.0-only", "classpath-exception-2.0"), "GPL-2.0-only WITH Classpath-exception-2.0"},
+		{With("Apache-2.0", "AdditionRef-commons-clause"), "Apache-2.0 WITH AdditionRef-commons-clause"},
+		{And(Lic("MIT"), Lic("ISC")), "MIT AND ISC"},
+		{Or(Lic("MIT"), Lic("Apache-2.0"), Lic("ISC")), "MIT OR Apache-2.0 OR ISC"},
+		{And(Lic("MIT"), Or(Lic("ISC"), Lic("0BSD"))), "MIT AND (ISC OR 0BSD)"},
+		{Or(Lic("MIT"), With("GPL-2.0-only", "Classpath-exception-2.0")), "MIT OR (GPL-2.0-only WITH Classpath-exception-2.0)"},
+		{Or(And(Lic("MIT"), Lic("ISC")), Lic("Apache-2.0")), "(MIT AND ISC) OR Apache-2.0"},
+	}
+	for _, tt := range tests {
+		if got := tt.expr.String(); got != tt.want {
+			t.Errorf("built %q, want %q", got, tt.want)
+		}
+		if err := ValidateExpression(tt.expr); err != nil {
+			t.Errorf("ValidateExpression(%q) = %v", tt.want, err)
+		}
+		// The string form parses back to the same expression
+		parsed, err := ParseStrict(tt.expr.String())
+		if err != nil || parsed.String() != tt.want {
+			t.Errorf("ParseStrict(%q) = %v, %v", tt.want, parsed, err)
+		}
+	}
+}
+
+func TestBuilderChainsLikeParse(t *testing.T) {
+	built := And(Lic("MIT"), Lic("ISC"), Lic("0BSD"))
+	parsed, err := ParseStrict("MIT AND ISC AND 0BSD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(built, parsed) {
+		t.Errorf("And(MIT, ISC, 0BSD) = %#v, want the tree Parse builds", built)
+	}
+}
+
+func TestValidateExpression(t *testing.T) {
+	tests := []struct {
+		expr Expression
+		err  error
+	}{
+		{nil, ErrEmptyExpression},
+		{Lic("NONE"), nil},
+		{Lic("Apache 2"), ErrInvalidLicenseID},
+		{Or(Lic("MIT"), Lic("FAKEYLICENSE")), ErrInvalidLicenseID},
+		{&License{ID: "mit"}, ErrInvalidLicenseID},
+		{With("GPL-2.0-only", "Fake-exception"), ErrInvalidException},
+		{And(Lic("MIT"), Lic("NONE")), ErrInvalidSpecialValue},
+		{&SpecialValue{Value: "ALL"}, ErrInvalidSpecialValue},
+		{&AndExpression{Left: Lic("MIT")}, ErrMissingOperand},
+		{&LicenseRef{}, ErrInvalidLicenseID},
+	}
+	for _, tt := range tests {
+		if err := ValidateExpression(tt.expr); !errors.Is(err, tt.err) {
+			t.Errorf("ValidateExpression(%#v) = %v, want %v", tt.expr, err, tt.err)
+		}
+	}
+}
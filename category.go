@@ -2,9 +2,7 @@ package spdx
 
 import (
 	_ "embed"
-	"encoding/json"
 	"strings"
-	"sync"
 )
 
 //go:embed licenses.json
@@ -38,45 +36,6 @@ type licenseEntry struct {
 	IsDeprecated        bool     `json:"is_deprecated"`
 }
 
-var (
-	categoryOnce sync.Once
-	categoryMap  map[string]Category // lowercase SPDX key -> category
-	licenseData  []licenseEntry
-)
-
-func initCategoryMap() {
-	categoryOnce.Do(func() {
-		if err := json.Unmarshal(licensesJSON, &licenseData); err != nil {
-			// If JSON is invalid, map will be empty
-			categoryMap = make(map[string]Category)
-			return
-		}
-
-		categoryMap = make(map[string]Category, len(licenseData)*2)
-		for _, entry := range licenseData {
-			cat := Category(entry.Category)
-			if cat == "" {
-				cat = CategoryUnknown
-			}
-
-			// Map primary SPDX key
-			if entry.SPDXLicenseKey != "" {
-				categoryMap[strings.ToLower(entry.SPDXLicenseKey)] = cat
-			}
-
-			// Map alternative SPDX keys (skip LicenseRef- ones)
-			for _, key := range entry.OtherSPDXKeys {
-				if !strings.HasPrefix(key, "LicenseRef-") {
-					categoryMap[strings.ToLower(key)] = cat
-				}
-			}
-
-			// Also map the license_key itself
-			categoryMap[strings.ToLower(entry.LicenseKey)] = cat
-		}
-	})
-}
-
 // LicenseCategory returns the category for a given license identifier.
 // It accepts SPDX identifiers (like "MIT", "Apache-2.0") or scancode keys.
 // Returns CategoryUnknown if the license is not found.
@@ -87,25 +46,13 @@ func initCategoryMap() {
 //	LicenseCategory("GPL-3.0-only")  // CategoryCopyleft
 //	LicenseCategory("MPL-2.0")       // CategoryCopyleftLimited
 func LicenseCategory(license string) Category {
-	initCategoryMap()
-
-	// Try exact match first
-	if cat, ok := categoryMap[strings.ToLower(license)]; ok {
-		return cat
-	}
-
-	// Try without -only/-or-later suffixes
-	license = strings.TrimSuffix(license, "-only")
-	license = strings.TrimSuffix(license, "-or-later")
-	if cat, ok := categoryMap[strings.ToLower(license)]; ok {
-		return cat
-	}
-
-	return CategoryUnknown
+	return loadConfig().registry().category(license)
 }
 
 // ExpressionCategories returns all unique categories for licenses in an expression.
 // It parses the expression and returns the category for each license found.
+// Categories are listed in the order of the first license that has them,
+// with licenses ordered as ExtractLicenses orders them.
 //
 // Example:
 //
@@ -113,9 +60,12 @@ func LicenseCategory(license string) Category {
 //	// []Category{CategoryPermissive}  (both are Permissive)
 //
 //	ExpressionCategories("MIT OR GPL-3.0-only")
-//	// []Category{CategoryPermissive, CategoryCopyleft}
+//	// []Category{CategoryCopyleft, CategoryPermissive}
+//
+// With Options.ApplyExceptions set, "GPL-2.0-only WITH Classpath-exception-2.0"
+// reports CategoryCopyleftLimited rather than CategoryCopyleft.
 func ExpressionCategories(expression string) ([]Category, error) {
-	licenses, err := ExtractLicenses(expression)
+	cats, err := licenseCategories(expression)
 	if err != nil {
 		return nil, err
 	}
@@ -123,8 +73,7 @@ func ExpressionCategories(expression string) ([]Category, error) {
 	seen := make(map[Category]bool)
 	var categories []Category
 
-	for _, lic := range licenses {
-		cat := LicenseCategory(lic)
+	for _, cat := range cats {
 		if !seen[cat] {
 			seen[cat] = true
 			categories = append(categories, cat)
@@ -134,6 +83,14 @@ func ExpressionCategories(expression string) ([]Category, error) {
 	return categories, nil
 }
 
+// licenseCategories returns the category of each license in an expression.
+// With Options.ApplyExceptions set, licenses carrying a WITH exception get
+// their EffectiveCategory instead.
+func licenseCategories(expression string) ([]Category, error) {
+	cfg := loadConfig()
+	return treeCategories(expression, cfg, cfg.opts.ApplyExceptions)
+}
+
 // IsPermissive returns true if the license is in a permissive category.
 // This includes Permissive, Public Domain, and similar open categories.
 func IsPermissive(license string) bool {
@@ -163,13 +120,13 @@ func IsCommercial(license string) bool {
 //	HasCopyleft("MIT OR GPL-3.0-only")     // true
 //	HasCopyleft("MIT AND LGPL-2.1-only")   // true
 func HasCopyleft(expression string) bool {
-	licenses, err := ExtractLicenses(expression)
+	cats, err := licenseCategories(expression)
 	if err != nil {
 		return false
 	}
 
-	for _, lic := range licenses {
-		if IsCopyleft(lic) {
+	for _, cat := range cats {
+		if cat == CategoryCopyleft || cat == CategoryCopyleftLimited {
 			return true
 		}
 	}
@@ -185,56 +142,80 @@ func HasCopyleft(expression string) bool {
 //	IsFullyPermissive("MIT AND BSD-3-Clause") // true
 //	IsFullyPermissive("MIT OR GPL-3.0-only")  // false
 func IsFullyPermissive(expression string) bool {
-	licenses, err := ExtractLicenses(expression)
+	cats, err := licenseCategories(expression)
 	if err != nil {
 		return false
 	}
 
-	for _, lic := range licenses {
-		if !IsPermissive(lic) {
+	for _, cat := range cats {
+		if cat != CategoryPermissive && cat != CategoryPublicDomain {
 			return false
 		}
 	}
-	return len(licenses) > 0
+	return len(cats) > 0
 }
 
 // LicenseInfo contains detailed information about a license.
 type LicenseInfo struct {
 	Key          string   // scancode license key
 	SPDXKey      string   // primary SPDX identifier
+	Name         string   // full name from the SPDX license list, if known
 	Category     Category // license category
 	IsException  bool     // true if this is a license exception
 	IsDeprecated bool     // true if deprecated
+	GoverningLaw string   // jurisdiction named in a choice-of-law clause, if any
+
+	PatentGrant       bool // true if the license grants an explicit patent license
+	PatentRetaliation bool // true if patent litigation terminates the license
+
+	TrademarkRestrictions bool // true if the license restricts use of trademarks or names
+
+	// For exceptions, Category is the effective category of a license
+	// WITH this exception, and these describe the exception itself.
+	Description string   // what the exception permits
+	Modifies    []string // licenses the exception is written for
+}
+
+// newLicenseInfo builds a LicenseInfo from a scancode entry and the
+// curated metadata for its SPDX identifier.
+func newLicenseInfo(entry licenseEntry) *LicenseInfo {
+	traits := lookupTraits(entry.SPDXLicenseKey)
+	info := &LicenseInfo{
+		Key:          entry.LicenseKey,
+		SPDXKey:      entry.SPDXLicenseKey,
+		Name:         licenseNameData[entry.SPDXLicenseKey].full,
+		Category:     Category(entry.Category),
+		IsException:  entry.IsException,
+		IsDeprecated: entry.IsDeprecated,
+		GoverningLaw: traits.governingLaw,
+
+		PatentGrant:       traits.patentGrant,
+		PatentRetaliation: traits.patentRetaliation,
+
+		TrademarkRestrictions: traits.trademark,
+	}
+	if entry.IsException {
+		exc := lookupExceptionTraits(entry.SPDXLicenseKey)
+		info.Description = exc.description
+		info.Modifies = exc.modifies
+	}
+	return info
 }
 
 // GetLicenseInfo returns detailed information about a license.
 // Returns nil if the license is not found.
 func GetLicenseInfo(license string) *LicenseInfo {
-	initCategoryMap()
-
 	lower := strings.ToLower(license)
 
-	for _, entry := range licenseData {
+	for _, entry := range loadConfig().registry().entries() {
 		// Check SPDX key
 		if strings.ToLower(entry.SPDXLicenseKey) == lower {
-			return &LicenseInfo{
-				Key:          entry.LicenseKey,
-				SPDXKey:      entry.SPDXLicenseKey,
-				Category:     Category(entry.Category),
-				IsException:  entry.IsException,
-				IsDeprecated: entry.IsDeprecated,
-			}
+			return newLicenseInfo(entry)
 		}
 
 		// Check license key
 		if strings.ToLower(entry.LicenseKey) == lower {
-			return &LicenseInfo{
-				Key:          entry.LicenseKey,
-				SPDXKey:      entry.SPDXLicenseKey,
-				Category:     Category(entry.Category),
-				IsException:  entry.IsException,
-				IsDeprecated: entry.IsDeprecated,
-			}
+			return newLicenseInfo(entry)
 		}
 	}
 
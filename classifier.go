@@ -0,0 +1,163 @@
+package spdx
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// Bucket is the kind of content a raw license string holds.
+type Bucket string
+
+const (
+	// BucketExpression is a string that parses as an SPDX expression,
+	// informal names included.
+	BucketExpression Bucket = "expression"
+	// BucketProprietary is a string describing proprietary terms, such as
+	// "Freeware" or "All rights reserved".
+	BucketProprietary Bucket = "proprietary"
+	// BucketSeeFile is a pointer to a license file, such as npm's
+	// "SEE LICENSE IN LICENSE.md", "see COPYING" or a bare "LICENSE.txt".
+	BucketSeeFile Bucket = "see-file"
+	// BucketURLOnly is a URL and nothing else.
+	BucketURLOnly Bucket = "url"
+	// BucketUnknown is a placeholder like "TODO" or "custom", or a string
+	// that failed to parse.
+	BucketUnknown Bucket = "unknown"
+)
+
+// Classification is the result of classifying one raw license string.
+type Classification struct {
+	Input      string
+	Bucket     Bucket
+	Expression Expression      // the parsed expression, for BucketExpression
+	Kind       ProprietaryKind // the kind of terms, for BucketProprietary
+	File       string          // the file named, for BucketSeeFile, if any
+	URL        string          // the URL, for BucketURLOnly
+	Err        error           // the parse error, for BucketUnknown strings that were parsed
+}
+
+// defaultIgnorePatterns are placeholders registries accept in place of a
+// license.
+var defaultIgnorePatterns = []string{
+	"unknown", "custom", "private",
+	"non-standard", "nonstandard",
+	"todo", "tbd", "tbc", "n/a",
+}
+
+// DefaultIgnorePatterns returns the patterns a Classifier with a nil
+// Ignore list uses. Append to it to extend the defaults.
+func DefaultIgnorePatterns() []string {
+	return append([]string(nil), defaultIgnorePatterns...)
+}
+
+// Classifier buckets raw license strings from package metadata before
+// they reach a normalization pipeline, so placeholders, file pointers and
+// proprietary terms can be routed without a hand-written skip list. The
+// zero value is ready to use.
+//
+// Example:
+//
+//	var c Classifier
+//	c.Classify("Apache 2").Bucket                   // BucketExpression
+//	c.Classify("SEE LICENSE IN LICENSE.md").File    // "LICENSE.md"
+//	c.Classify("Freeware").Kind                     // ProprietaryFreeware
+type Classifier struct {
+	// Ignore lists words and phrases that mark a string as BucketUnknown
+	// without parsing it. They match whole words, ignoring case. Nil
+	// uses DefaultIgnorePatterns; an empty slice ignores nothing.
+	Ignore []string
+}
+
+// reSeeFile matches a string that only points at a license file, either
+// with "see" or as a bare file name like "LICENSE.md".
+var reSeeFile = regexp.MustCompile(`(?i)^(?:see\s+(?:licen[sc]e\s+in\s+)?(?:the\s+)?(\S+?)(?:\s+file)?[\s.]*|((?:licen[sc]e|copying)(?:[._-]\w+)?))$`)
+
+// Classify reports which bucket s belongs in. URLs, file pointers and
+// strings containing an ignore pattern are bucketed without parsing;
+// anything else is parsed with Parse.
+func (c *Classifier) Classify(s string) Classification {
+	cl := Classification{Input: s}
+	trimmed := strings.TrimSpace(s)
+
+	switch {
+	case trimmed == "":
+		cl.Bucket = BucketUnknown
+		cl.Err = ErrEmptyExpression
+		return cl
+	case isURL(trimmed):
+		cl.Bucket = BucketURLOnly
+		cl.URL = trimmed
+		return cl
+	}
+	if m := reSeeFile.FindStringSubmatch(trimmed); m != nil {
+		cl.Bucket = BucketSeeFile
+		cl.File = m[1] + m[2]
+		return cl
+	}
+	if c.ignored(trimmed) {
+		cl.Bucket = BucketUnknown
+		return cl
+	}
+
+	expr, err := Parse(trimmed)
+	var perr *ProprietaryError
+	switch {
+	case err == nil:
+		cl.Bucket = BucketExpression
+		cl.Expression = expr
+	case errors.As(err, &perr):
+		cl.Bucket = BucketProprietary
+		cl.Kind = perr.Kind
+	default:
+		cl.Bucket = BucketUnknown
+		cl.Err = err
+	}
+	return cl
+}
+
+// ignored reports whether s contains one of the ignore patterns.
+func (c *Classifier) ignored(s string) bool {
+	patterns := c.Ignore
+	if patterns == nil {
+		patterns = defaultIgnorePatterns
+	}
+	lower := strings.ToLower(s)
+	for _, p := range patterns {
+		if containsWord(lower, strings.ToLower(p)) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsWord reports whether word appears in s with no letter, digit or
+// hyphen directly on either side, so "custom" does not match
+// "LicenseRef-custom".
+func containsWord(s, word string) bool {
+	if word == "" {
+		return false
+	}
+	for i := 0; ; {
+		j := strings.Index(s[i:], word)
+		if j < 0 {
+			return false
+		}
+		start, end := i+j, i+j+len(word)
+		if (start == 0 || !isWordByte(s[start-1])) && (end == len(s) || !isWordByte(s[end])) {
+			return true
+		}
+		i = start + 1
+	}
+}
+
+func isWordByte(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b >= '0' && b <= '9' || b == '-'
+}
+
+// isURL reports whether s is a single http or https URL.
+func isURL(s string) bool {
+	lower := strings.ToLower(s)
+	return (strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://")) &&
+		!strings.ContainsAny(s, " \t\n")
+}
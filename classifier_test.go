@@ -0,0 +1,79 @@
+package spdx
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestClassifier(t *testing.T) {
+	tests := []struct {
+		input  string
+		bucket Bucket
+	}{
+		{"MIT", BucketExpression},
+		{"Apache 2 OR mit", BucketExpression},
+		{"NOASSERTION", BucketExpression},
+		{"LicenseRef-custom", BucketExpression},
+		{"Freeware", BucketProprietary},
+		{"All rights reserved", BucketProprietary},
+		{"SEE LICENSE IN LICENSE.md", BucketSeeFile},
+		{"see COPYING", BucketSeeFile},
+		{"See the LICENSE file.", BucketSeeFile},
+		{"LICENSE.txt", BucketSeeFile},
+		{"License", BucketSeeFile},
+		{"https://example.com/LICENSE.txt", BucketURLOnly},
+		{"TODO", BucketUnknown},
+		{"Custom", BucketUnknown},
+		{"unknown", BucketUnknown},
+		{"iewrbb", BucketUnknown},
+		{"", BucketUnknown},
+	}
+
+	var c Classifier
+	for _, tt := range tests {
+		if got := c.Classify(tt.input); got.Bucket != tt.bucket {
+			t.Errorf("Classify(%q).Bucket = %q, want %q", tt.input, got.Bucket, tt.bucket)
+		}
+	}
+}
+
+func TestClassifierResults(t *testing.T) {
+	var c Classifier
+
+	if got := c.Classify("Apache 2"); got.Expression == nil || got.Expression.String() != "Apache-2.0" {
+		t.Errorf("Classify(Apache 2).Expression = %v", got.Expression)
+	}
+	if got := c.Classify("SEE LICENSE IN LICENSE.md"); got.File != "LICENSE.md" {
+		t.Errorf("Classify(SEE LICENSE IN LICENSE.md).File = %q", got.File)
+	}
+	if got := c.Classify("COPYING"); got.File != "COPYING" {
+		t.Errorf("Classify(COPYING).File = %q", got.File)
+	}
+	if got := c.Classify("Shareware"); got.Kind != ProprietaryShareware {
+		t.Errorf("Classify(Shareware).Kind = %q", got.Kind)
+	}
+	if got := c.Classify(" https://example.com/l "); got.URL != "https://example.com/l" {
+		t.Errorf("Classify(url).URL = %q", got.URL)
+	}
+	if got := c.Classify("iewrbb"); !errors.Is(got.Err, ErrInvalidLicenseID) {
+		t.Errorf("Classify(iewrbb).Err = %v, want ErrInvalidLicenseID", got.Err)
+	}
+	if got := c.Classify("TODO"); got.Err != nil {
+		t.Errorf("Classify(TODO).Err = %v, want nil for an ignored string", got.Err)
+	}
+}
+
+func TestClassifierIgnore(t *testing.T) {
+	c := Classifier{Ignore: append(DefaultIgnorePatterns(), "internal only")}
+	if got := c.Classify("Internal Only").Bucket; got != BucketUnknown {
+		t.Errorf("Classify(Internal Only) = %q, want unknown", got)
+	}
+	if got := c.Classify("TODO").Bucket; got != BucketUnknown {
+		t.Errorf("Classify(TODO) with extended defaults = %q, want unknown", got)
+	}
+
+	none := Classifier{Ignore: []string{}}
+	if got := none.Classify("TODO"); got.Bucket != BucketUnknown || got.Err == nil {
+		t.Errorf("Classify(TODO) with no patterns = %+v, want a parse error", got)
+	}
+}
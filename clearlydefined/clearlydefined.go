@@ -0,0 +1,237 @@
+// Package clearlydefined fetches and imports ClearlyDefined license
+// definitions and reconciles them with locally detected expressions.
+//
+// ClearlyDefined (clearlydefined.io) curates the declared license of open
+// source packages, the licenses discovered in their files, and a score for
+// how complete that information is. Comparing it with what your own scanner
+// found gives a second opinion: a disagreement is worth a human look.
+//
+// Example:
+//
+//	c := &clearlydefined.Client{}
+//	def, err := c.Definition(ctx, "pkg:npm/lodash@4.17.21")
+//	rec, err := clearlydefined.Reconcile(def, "MIT")
+//	if !rec.Agrees() {
+//		fmt.Println("ClearlyDefined declares", rec.Declared)
+//	}
+package clearlydefined
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// DefaultBaseURL is the public ClearlyDefined API.
+const DefaultBaseURL = "https://api.clearlydefined.io"
+
+var (
+	// ErrInvalidPurl is returned for a string that is not a package URL
+	// with a name and version.
+	ErrInvalidPurl = errors.New("invalid package URL")
+	// ErrUnsupportedPurl is returned for a package URL type ClearlyDefined
+	// does not harvest.
+	ErrUnsupportedPurl = errors.New("unsupported package URL type")
+)
+
+// Coordinates identify a component in ClearlyDefined.
+type Coordinates struct {
+	Type      string `json:"type"`
+	Provider  string `json:"provider"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	Revision  string `json:"revision"`
+}
+
+// String returns the coordinates in the type/provider/namespace/name/revision
+// form used in ClearlyDefined URLs, with "-" for an empty namespace.
+func (c Coordinates) String() string {
+	ns := c.Namespace
+	if ns == "" {
+		ns = "-"
+	}
+	parts := []string{c.Type, c.Provider, ns, c.Name, c.Revision}
+	for i, p := range parts {
+		parts[i] = url.PathEscape(p)
+	}
+	return strings.Join(parts, "/")
+}
+
+// Definition is the licensing part of a ClearlyDefined definition.
+type Definition struct {
+	Coordinates Coordinates `json:"coordinates"`
+	Licensed    struct {
+		// Declared is the license the package declares, as an SPDX
+		// expression. ClearlyDefined uses NOASSERTION when it has none.
+		Declared string `json:"declared"`
+		Score    struct {
+			Total int `json:"total"`
+		} `json:"score"`
+		Facets struct {
+			Core struct {
+				Discovered struct {
+					// Expressions are the licenses found in the files.
+					Expressions []string `json:"expressions"`
+				} `json:"discovered"`
+			} `json:"core"`
+		} `json:"facets"`
+	} `json:"licensed"`
+}
+
+// Declared returns the declared license, or "" if there is none.
+func (d *Definition) Declared() string {
+	if strings.EqualFold(d.Licensed.Declared, "NOASSERTION") {
+		return ""
+	}
+	return d.Licensed.Declared
+}
+
+// Discovered returns the license expressions found in the package files.
+func (d *Definition) Discovered() []string {
+	return d.Licensed.Facets.Core.Discovered.Expressions
+}
+
+// Score returns the licensed score, from 0 to 100.
+func (d *Definition) Score() int {
+	return d.Licensed.Score.Total
+}
+
+// Decode reads a single definition, as returned by GET /definitions.
+func Decode(r io.Reader) (*Definition, error) {
+	var def Definition
+	if err := json.NewDecoder(r).Decode(&def); err != nil {
+		return nil, err
+	}
+	return &def, nil
+}
+
+// DecodeBatch reads definitions keyed by coordinates, as returned by
+// POST /definitions. Use it to import a bulk export instead of querying
+// one package at a time.
+func DecodeBatch(r io.Reader) (map[string]*Definition, error) {
+	var defs map[string]*Definition
+	if err := json.NewDecoder(r).Decode(&defs); err != nil {
+		return nil, err
+	}
+	return defs, nil
+}
+
+// purlProviders maps package URL types to ClearlyDefined type and provider.
+var purlProviders = map[string][2]string{
+	"cargo":     {"crate", "cratesio"},
+	"cocoapods": {"pod", "cocoapods"},
+	"composer":  {"composer", "packagist"},
+	"deb":       {"deb", "debian"},
+	"gem":       {"gem", "rubygems"},
+	"github":    {"git", "github"},
+	"gitlab":    {"git", "gitlab"},
+	"golang":    {"go", "golang"},
+	"maven":     {"maven", "mavencentral"},
+	"npm":       {"npm", "npmjs"},
+	"nuget":     {"nuget", "nuget"},
+	"pypi":      {"pypi", "pypi"},
+}
+
+// CoordinatesFromPurl converts a package URL to ClearlyDefined
+// coordinates. Qualifiers and subpaths are ignored.
+//
+// Example:
+//
+//	CoordinatesFromPurl("pkg:npm/%40babel/core@7.24.0")
+//	// Coordinates{Type: "npm", Provider: "npmjs", Namespace: "@babel", Name: "core", Revision: "7.24.0"}
+func CoordinatesFromPurl(purl string) (Coordinates, error) {
+	rest, ok := strings.CutPrefix(purl, "pkg:")
+	if !ok {
+		return Coordinates{}, fmt.Errorf("%w: %s", ErrInvalidPurl, purl)
+	}
+	rest, _, _ = strings.Cut(rest, "#")
+	rest, _, _ = strings.Cut(rest, "?")
+
+	rest, version, ok := cutLast(rest, "@")
+	if !ok || version == "" {
+		return Coordinates{}, fmt.Errorf("%w: no version in %s", ErrInvalidPurl, purl)
+	}
+
+	segments := strings.Split(strings.Trim(rest, "/"), "/")
+	if len(segments) < 2 {
+		return Coordinates{}, fmt.Errorf("%w: %s", ErrInvalidPurl, purl)
+	}
+	for i, s := range segments {
+		decoded, err := url.PathUnescape(s)
+		if err != nil {
+			return Coordinates{}, fmt.Errorf("%w: %s", ErrInvalidPurl, purl)
+		}
+		segments[i] = decoded
+	}
+	version, err := url.PathUnescape(version)
+	if err != nil {
+		return Coordinates{}, fmt.Errorf("%w: %s", ErrInvalidPurl, purl)
+	}
+
+	typ := strings.ToLower(segments[0])
+	provider, ok := purlProviders[typ]
+	if !ok {
+		return Coordinates{}, fmt.Errorf("%w: %s", ErrUnsupportedPurl, typ)
+	}
+
+	return Coordinates{
+		Type:      provider[0],
+		Provider:  provider[1],
+		Namespace: strings.Join(segments[1:len(segments)-1], "/"),
+		Name:      segments[len(segments)-1],
+		Revision:  version,
+	}, nil
+}
+
+// cutLast is strings.Cut around the last instance of sep.
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
+
+// Client queries the ClearlyDefined API. The zero value uses the public
+// API and http.DefaultClient.
+type Client struct {
+	BaseURL    string       // defaults to DefaultBaseURL
+	HTTPClient *http.Client // defaults to http.DefaultClient
+}
+
+// Definition fetches the definition of the package identified by purl.
+func (c *Client) Definition(ctx context.Context, purl string) (*Definition, error) {
+	coords, err := CoordinatesFromPurl(purl)
+	if err != nil {
+		return nil, err
+	}
+
+	base := c.BaseURL
+	if base == "" {
+		base = DefaultBaseURL
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(base, "/")+"/definitions/"+coords.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("clearlydefined: %s: %s", coords, resp.Status)
+	}
+	return Decode(resp.Body)
+}
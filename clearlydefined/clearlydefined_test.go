@@ -0,0 +1,106 @@
+package clearlydefined
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const lodash = `{
+	"coordinates": {"type": "npm", "provider": "npmjs", "name": "lodash", "revision": "4.17.21"},
+	"licensed": {
+		"declared": "MIT",
+		"score": {"total": 87, "declared": 30},
+		"facets": {"core": {"discovered": {"expressions": ["MIT", "CC0-1.0"], "unknown": 3}}}
+	},
+	"described": {"releaseDate": "2021-02-20"}
+}`
+
+func TestCoordinatesFromPurl(t *testing.T) {
+	tests := []struct {
+		purl string
+		want string
+		err  error
+	}{
+		{"pkg:npm/lodash@4.17.21", "npm/npmjs/-/lodash/4.17.21", nil},
+		{"pkg:npm/%40babel/core@7.24.0", "npm/npmjs/@babel/core/7.24.0", nil},
+		{"pkg:pypi/requests@2.31.0?arch=any#src", "pypi/pypi/-/requests/2.31.0", nil},
+		{"pkg:maven/org.apache.commons/commons-lang3@3.14.0", "maven/mavencentral/org.apache.commons/commons-lang3/3.14.0", nil},
+		{"pkg:golang/github.com/spf13/cobra@v1.8.0", "go/golang/github.com%2Fspf13/cobra/v1.8.0", nil},
+		{"pkg:cargo/serde@1.0.197", "crate/cratesio/-/serde/1.0.197", nil},
+		{"pkg:npm/lodash", "", ErrInvalidPurl},
+		{"npm/lodash@4.17.21", "", ErrInvalidPurl},
+		{"pkg:npm@1.0", "", ErrInvalidPurl},
+		{"pkg:hex/phoenix@1.7.0", "", ErrUnsupportedPurl},
+	}
+
+	for _, tt := range tests {
+		got, err := CoordinatesFromPurl(tt.purl)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("CoordinatesFromPurl(%q) error = %v, want %v", tt.purl, err, tt.err)
+			continue
+		}
+		if err == nil && got.String() != tt.want {
+			t.Errorf("CoordinatesFromPurl(%q) = %s, want %s", tt.purl, got, tt.want)
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+	def, err := Decode(strings.NewReader(lodash))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if def.Declared() != "MIT" || def.Score() != 87 || len(def.Discovered()) != 2 {
+		t.Errorf("Decode() = declared %q, score %d, discovered %v", def.Declared(), def.Score(), def.Discovered())
+	}
+
+	defs, err := DecodeBatch(strings.NewReader(`{"npm/npmjs/-/lodash/4.17.21": ` + lodash + `}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if defs["npm/npmjs/-/lodash/4.17.21"].Coordinates.Name != "lodash" {
+		t.Errorf("DecodeBatch() = %v", defs)
+	}
+
+	noassert := &Definition{}
+	noassert.Licensed.Declared = "NOASSERTION"
+	if noassert.Declared() != "" {
+		t.Error("NOASSERTION should read as no declared license")
+	}
+}
+
+func TestClientDefinition(t *testing.T) {
+	var path string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.EscapedPath()
+		if strings.Contains(path, "missing") {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(lodash))
+	}))
+	defer srv.Close()
+
+	c := &Client{BaseURL: srv.URL + "/"}
+	def, err := c.Definition(context.Background(), "pkg:npm/lodash@4.17.21")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/definitions/npm/npmjs/-/lodash/4.17.21" {
+		t.Errorf("requested %s", path)
+	}
+	if def.Declared() != "MIT" {
+		t.Errorf("Declared() = %q", def.Declared())
+	}
+
+	if _, err := c.Definition(context.Background(), "pkg:npm/missing@1.0.0"); err == nil {
+		t.Error("a 404 should be an error")
+	}
+	if _, err := c.Definition(context.Background(), "pkg:hex/phoenix@1.7.0"); !errors.Is(err, ErrUnsupportedPurl) {
+		t.Errorf("unsupported purl error = %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package clearlydefined
+
+import (
+	"slices"
+
+	"github.com/git-pkgs/spdx"
+)
+
+// Reconciliation compares a locally detected expression with a
+// ClearlyDefined definition.
+type Reconciliation struct {
+	Local      string   // local expression, normalized
+	Declared   string   // ClearlyDefined declared license, normalized when possible; "" if none
+	Discovered []string // licenses ClearlyDefined found in the files, sorted
+	Score      int      // ClearlyDefined licensed score, 0 to 100
+
+	// DeclaredMismatch is set when Declared names different licenses
+	// from Local. A missing declaration is not a mismatch.
+	DeclaredMismatch bool
+	// Unexplained lists discovered licenses that Local does not mention.
+	Unexplained []string
+}
+
+// Agrees reports whether ClearlyDefined supports the local expression:
+// the declared license names the same licenses and every discovered
+// license is accounted for.
+func (r *Reconciliation) Agrees() bool {
+	return !r.DeclaredMismatch && len(r.Unexplained) == 0
+}
+
+// Reconcile compares local, an expression detected by your own tooling,
+// with def. Licenses are compared as sets, so "MIT OR Apache-2.0" and
+// "Apache-2.0 OR MIT" agree. Declared and discovered expressions that do
+// not parse, such as ClearlyDefined's OTHER, are compared verbatim.
+//
+// Example:
+//
+//	rec, err := Reconcile(def, "MIT")
+//	rec.Declared          // "MIT OR Apache-2.0"
+//	rec.DeclaredMismatch  // true
+func Reconcile(def *Definition, local string) (*Reconciliation, error) {
+	localExpr, err := spdx.Parse(local)
+	if err != nil {
+		return nil, err
+	}
+	localLicenses := localExpr.Licenses()
+
+	rec := &Reconciliation{
+		Local: localExpr.String(),
+		Score: def.Score(),
+	}
+
+	if declared := def.Declared(); declared != "" {
+		rec.Declared = declared
+		declaredLicenses := []string{declared}
+		if expr, err := spdx.Parse(declared); err == nil {
+			rec.Declared = expr.String()
+			declaredLicenses = expr.Licenses()
+		}
+		rec.DeclaredMismatch = !sameSet(localLicenses, declaredLicenses)
+	}
+
+	for _, discovered := range def.Discovered() {
+		licenses := []string{discovered}
+		if expr, err := spdx.Parse(discovered); err == nil {
+			licenses = expr.Licenses()
+		}
+		for _, lic := range licenses {
+			if !slices.Contains(rec.Discovered, lic) {
+				rec.Discovered = append(rec.Discovered, lic)
+			}
+			if !slices.Contains(localLicenses, lic) && !slices.Contains(rec.Unexplained, lic) {
+				rec.Unexplained = append(rec.Unexplained, lic)
+			}
+		}
+	}
+	slices.Sort(rec.Discovered)
+	slices.Sort(rec.Unexplained)
+
+	return rec, nil
+}
+
+// sameSet reports whether a and b contain the same strings.
+func sameSet(a, b []string) bool {
+	for _, s := range a {
+		if !slices.Contains(b, s) {
+			return false
+		}
+	}
+	for _, s := range b {
+		if !slices.Contains(a, s) {
+			return false
+		}
+	}
+	return true
+}
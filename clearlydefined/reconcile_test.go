@@ -0,0 +1,60 @@
+package clearlydefined
+
+import (
+	"slices"
+	"testing"
+)
+
+func definition(declared string, score int, discovered ...string) *Definition {
+	def := &Definition{}
+	def.Licensed.Declared = declared
+	def.Licensed.Score.Total = score
+	def.Licensed.Facets.Core.Discovered.Expressions = discovered
+	return def
+}
+
+func TestReconcile(t *testing.T) {
+	tests := []struct {
+		name        string
+		def         *Definition
+		local       string
+		declared    string
+		mismatch    bool
+		unexplained []string
+	}{
+		{"agree", definition("MIT", 90, "MIT"), "mit", "MIT", false, nil},
+		{"order ignored", definition("Apache-2.0 OR MIT", 90), "MIT OR Apache-2.0", "Apache-2.0 OR MIT", false, nil},
+		{"declared differs", definition("MIT OR Apache-2.0", 70), "MIT", "MIT OR Apache-2.0", true, nil},
+		{"no assertion", definition("NOASSERTION", 20, "MIT"), "MIT", "", false, nil},
+		{"unexplained discovered", definition("MIT", 60, "MIT", "GPL-2.0-only AND BSD-3-Clause"), "MIT", "MIT", false, []string{"BSD-3-Clause", "GPL-2.0-only"}},
+		{"unparseable declared", definition("OTHER", 10), "MIT", "OTHER", true, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, err := Reconcile(tt.def, tt.local)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rec.Declared != tt.declared {
+				t.Errorf("Declared = %q, want %q", rec.Declared, tt.declared)
+			}
+			if rec.DeclaredMismatch != tt.mismatch {
+				t.Errorf("DeclaredMismatch = %v, want %v", rec.DeclaredMismatch, tt.mismatch)
+			}
+			if !slices.Equal(rec.Unexplained, tt.unexplained) {
+				t.Errorf("Unexplained = %v, want %v", rec.Unexplained, tt.unexplained)
+			}
+			if rec.Score != tt.def.Score() {
+				t.Errorf("Score = %d, want %d", rec.Score, tt.def.Score())
+			}
+			if want := !tt.mismatch && len(tt.unexplained) == 0; rec.Agrees() != want {
+				t.Errorf("Agrees() = %v, want %v", rec.Agrees(), want)
+			}
+		})
+	}
+
+	if _, err := Reconcile(definition("MIT", 90), "MIT AND"); err == nil {
+		t.Error("an invalid local expression should be an error")
+	}
+}
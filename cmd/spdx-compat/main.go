@@ -0,0 +1,96 @@
+// Command spdx-compat reports how closely the spdx package agrees with
+// other license libraries.
+//
+// It runs the built-in spdx-correct.js and license-expression corpora, or
+// the JSON corpora named as arguments, and writes one report per corpus
+// as JSON: the agreement rate and every input where the outputs differ.
+//
+// Usage:
+//
+//	go run ./cmd/spdx-compat [flags] [corpus.json ...]
+//
+// Exit codes:
+//
+//	0  reports written, and every corpus met -min
+//	1  a corpus could not be read, or fell below -min
+//	2  bad command-line usage
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/git-pkgs/spdx/compat"
+)
+
+// Exit codes returned by the command.
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("spdx-compat", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	minAgreement := fs.Float64("min", 0, "fail if any corpus agrees less than this, from 0 to 1")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: spdx-compat [flags] [corpus.json ...]")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	corpora := []compat.Corpus{compat.SPDXCorrect, compat.LicenseExpression}
+	if fs.NArg() > 0 {
+		corpora = corpora[:0]
+		for _, path := range fs.Args() {
+			c, err := loadCorpus(path)
+			if err != nil {
+				fmt.Fprintf(stderr, "spdx-compat: %s: %v\n", path, err)
+				return exitError
+			}
+			corpora = append(corpora, c)
+		}
+	}
+
+	reports := make([]compat.Report, len(corpora))
+	code := exitOK
+	for i, c := range corpora {
+		reports[i] = compat.Run(c)
+		if reports[i].Agreement < *minAgreement {
+			fmt.Fprintf(stderr, "spdx-compat: %s: agreement %.3f below %.3f\n", c.Name, reports[i].Agreement, *minAgreement)
+			code = exitError
+		}
+	}
+
+	enc := json.NewEncoder(stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(reports); err != nil {
+		fmt.Fprintf(stderr, "spdx-compat: %v\n", err)
+		return exitError
+	}
+	return code
+}
+
+func loadCorpus(path string) (compat.Corpus, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return compat.Corpus{}, err
+	}
+	defer f.Close()
+	return compat.LoadCorpus(f)
+}
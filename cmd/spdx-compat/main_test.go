@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/git-pkgs/spdx/compat"
+)
+
+func TestRunBuiltin(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := run(nil, &stdout, &stderr); code != exitOK {
+		t.Fatalf("exit %d: %s", code, stderr.String())
+	}
+	var reports []compat.Report
+	if err := json.Unmarshal(stdout.Bytes(), &reports); err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 2 || reports[0].Corpus != "spdx-correct.js" || reports[1].Corpus != "license-expression" {
+		t.Errorf("reports = %+v", reports)
+	}
+}
+
+func TestRunCorpusFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "corpus.json")
+	corpus := `{"name": "mine", "cases": [{"input": "Apache 2", "want": "Apache-2.0"}, {"input": "mit", "want": "ISC"}]}`
+	if err := os.WriteFile(path, []byte(corpus), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"-min", "0.9", path}, &stdout, &stderr); code != exitError {
+		t.Errorf("exit %d, want %d for agreement below -min", code, exitError)
+	}
+	var reports []compat.Report
+	if err := json.Unmarshal(stdout.Bytes(), &reports); err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 1 || reports[0].Agreement != 0.5 || len(reports[0].Divergences) != 1 {
+		t.Errorf("reports = %+v", reports)
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"-bogus"}, &stdout, &stderr); code != exitUsage {
+		t.Errorf("bad flag exit = %d, want %d", code, exitUsage)
+	}
+	if code := run([]string{"missing.json"}, &stdout, &stderr); code != exitError {
+		t.Errorf("missing file exit = %d, want %d", code, exitError)
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/git-pkgs/spdx"
+)
+
+// scancodeEntry is one license in the embedded licenses.json. The field
+// order matches the file so regenerated data diffs cleanly.
+type scancodeEntry struct {
+	LicenseKey     string   `json:"license_key"`
+	Category       string   `json:"category"`
+	SPDXLicenseKey *string  `json:"spdx_license_key"` // null for keys with no SPDX ID
+	OtherSPDXKeys  []string `json:"other_spdx_license_keys"`
+	IsException    bool     `json:"is_exception"`
+	IsDeprecated   bool     `json:"is_deprecated"`
+}
+
+// convertScancode reduces a scancode-licensedb index to the fields the
+// package uses, sorted by license key and indented like licenses.json.
+// Unknown fields in the index are dropped.
+func convertScancode(index []byte) ([]byte, error) {
+	var entries []scancodeEntry
+	if err := json.Unmarshal(index, &entries); err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, errors.New("no licenses in scancode index")
+	}
+
+	for i := range entries {
+		if entries[i].LicenseKey == "" {
+			return nil, fmt.Errorf("entry %d has no license_key", i)
+		}
+		if entries[i].OtherSPDXKeys == nil {
+			entries[i].OtherSPDXKeys = []string{}
+		}
+	}
+	slices.SortFunc(entries, func(a, b scancodeEntry) int {
+		return strings.Compare(a.LicenseKey, b.LicenseKey)
+	})
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(entries); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// spdxList is the SPDX license list converted to identifier lists.
+type spdxList struct {
+	version string
+	data    spdx.LicenseData
+}
+
+// parseSPDXList reads the licenses.json and exceptions.json published at
+// spdx.org/licenses. Deprecated license IDs go to Deprecated; exceptions
+// are listed whether or not they are deprecated.
+func parseSPDXList(licensesJSON, exceptionsJSON []byte) (spdxList, error) {
+	var licenses struct {
+		Version  string `json:"licenseListVersion"`
+		Licenses []struct {
+			ID         string `json:"licenseId"`
+			Deprecated bool   `json:"isDeprecatedLicenseId"`
+		} `json:"licenses"`
+	}
+	if err := json.Unmarshal(licensesJSON, &licenses); err != nil {
+		return spdxList{}, fmt.Errorf("SPDX licenses: %w", err)
+	}
+	if len(licenses.Licenses) == 0 {
+		return spdxList{}, errors.New("SPDX licenses: no licenses")
+	}
+
+	var exceptions struct {
+		Exceptions []struct {
+			ID string `json:"licenseExceptionId"`
+		} `json:"exceptions"`
+	}
+	if err := json.Unmarshal(exceptionsJSON, &exceptions); err != nil {
+		return spdxList{}, fmt.Errorf("SPDX exceptions: %w", err)
+	}
+
+	list := spdxList{version: licenses.Version}
+	for _, l := range licenses.Licenses {
+		if l.Deprecated {
+			list.data.Deprecated = append(list.data.Deprecated, l.ID)
+		} else {
+			list.data.Licenses = append(list.data.Licenses, l.ID)
+		}
+	}
+	for _, e := range exceptions.Exceptions {
+		list.data.Exceptions = append(list.data.Exceptions, e.ID)
+	}
+	return list, nil
+}
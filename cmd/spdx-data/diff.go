@@ -0,0 +1,108 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/git-pkgs/spdx"
+)
+
+// writeDiff reports the identifiers and scancode entries added, removed
+// or recategorized between before and after.
+func writeDiff(w io.Writer, version string, before, after spdx.LicenseData) error {
+	if version != "" {
+		fmt.Fprintf(w, "SPDX license list %s\n", version)
+	}
+
+	for _, section := range []struct {
+		name          string
+		before, after []string
+	}{
+		{"licenses", before.Licenses, after.Licenses},
+		{"deprecated", before.Deprecated, after.Deprecated},
+		{"exceptions", before.Exceptions, after.Exceptions},
+	} {
+		added, removed := diffIDs(section.before, section.after)
+		fmt.Fprintf(w, "%s: %d -> %d, +%d -%d\n", section.name, len(section.before), len(section.after), len(added), len(removed))
+		for _, id := range added {
+			fmt.Fprintf(w, "  + %s\n", id)
+		}
+		for _, id := range removed {
+			fmt.Fprintf(w, "  - %s\n", id)
+		}
+	}
+
+	oldEntries, err := scancodeCategories(before.Scancode)
+	if err != nil {
+		return fmt.Errorf("embedded scancode data: %w", err)
+	}
+	newEntries, err := scancodeCategories(after.Scancode)
+	if err != nil {
+		return err
+	}
+
+	var added, removed, changed []string
+	for key, cat := range newEntries {
+		oldCat, ok := oldEntries[key]
+		switch {
+		case !ok:
+			added = append(added, fmt.Sprintf("+ %s (%s)", key, cat))
+		case oldCat != cat:
+			changed = append(changed, fmt.Sprintf("~ %s: %s -> %s", key, oldCat, cat))
+		}
+	}
+	for key := range oldEntries {
+		if _, ok := newEntries[key]; !ok {
+			removed = append(removed, "- "+key)
+		}
+	}
+	slices.Sort(added)
+	slices.Sort(removed)
+	slices.Sort(changed)
+
+	fmt.Fprintf(w, "scancode: %d -> %d, +%d -%d ~%d\n", len(oldEntries), len(newEntries), len(added), len(removed), len(changed))
+	for _, lines := range [][]string{added, removed, changed} {
+		for _, line := range lines {
+			if _, err := fmt.Fprintf(w, "  %s\n", line); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// diffIDs returns the identifiers only in after and only in before, sorted.
+func diffIDs(before, after []string) (added, removed []string) {
+	for _, id := range after {
+		if !slices.Contains(before, id) {
+			added = append(added, id)
+		}
+	}
+	for _, id := range before {
+		if !slices.Contains(after, id) {
+			removed = append(removed, id)
+		}
+	}
+	slices.Sort(added)
+	slices.Sort(removed)
+	return added, removed
+}
+
+// scancodeCategories maps each license key in a scancode index to its
+// category.
+func scancodeCategories(data []byte) (map[string]string, error) {
+	var entries []scancodeEntry
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &entries); err != nil {
+			return nil, err
+		}
+	}
+
+	cats := make(map[string]string, len(entries))
+	for _, e := range entries {
+		cats[e.LicenseKey] = e.Category
+	}
+	return cats, nil
+}
@@ -0,0 +1,142 @@
+// Command spdx-data refreshes the license data embedded in the spdx
+// package.
+//
+// It reads the SPDX license and exception lists and the scancode-licensedb
+// index, converts the index into the format of the package's
+// licenses.json, writes it, and reports how the new data differs from the
+// data compiled into the package. Sources are URLs or local files, so a
+// refresh can be repeated offline from saved downloads.
+//
+// Usage:
+//
+//	go run ./cmd/spdx-data [flags]
+//
+// The SPDX identifier lists come from github.com/github/go-spdx and are
+// not written; their diff shows when that dependency needs updating.
+//
+// Exit codes:
+//
+//	0  data written, or reported with -n
+//	1  fetching, converting or writing failed
+//	2  bad command-line usage
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/git-pkgs/spdx"
+)
+
+// Exit codes returned by the command.
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+// Default sources for the license data.
+const (
+	defaultSPDXLicenses   = "https://spdx.org/licenses/licenses.json"
+	defaultSPDXExceptions = "https://spdx.org/licenses/exceptions.json"
+	defaultScancode       = "https://scancode-licensedb.aboutcode.org/index.json"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("spdx-data", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	licensesSrc := fs.String("licenses", defaultSPDXLicenses, "URL or file of the SPDX licenses.json")
+	exceptionsSrc := fs.String("exceptions", defaultSPDXExceptions, "URL or file of the SPDX exceptions.json")
+	scancodeSrc := fs.String("scancode", defaultScancode, "URL or file of the scancode-licensedb index.json")
+	out := fs.String("out", "licenses.json", "file to write the converted scancode data to")
+	dryRun := fs.Bool("n", false, "report the diff without writing")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: spdx-data [flags]")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if fs.NArg() > 0 {
+		fmt.Fprintf(stderr, "spdx-data: unexpected argument %q\n", fs.Arg(0))
+		return exitUsage
+	}
+
+	client := &http.Client{Timeout: time.Minute}
+
+	licensesJSON, err := fetch(client, *licensesSrc)
+	if err != nil {
+		return fail(stderr, err)
+	}
+	exceptionsJSON, err := fetch(client, *exceptionsSrc)
+	if err != nil {
+		return fail(stderr, err)
+	}
+	index, err := fetch(client, *scancodeSrc)
+	if err != nil {
+		return fail(stderr, err)
+	}
+
+	list, err := parseSPDXList(licensesJSON, exceptionsJSON)
+	if err != nil {
+		return fail(stderr, err)
+	}
+	scancode, err := convertScancode(index)
+	if err != nil {
+		return fail(stderr, fmt.Errorf("%s: %w", *scancodeSrc, err))
+	}
+
+	data := list.data
+	data.Scancode = scancode
+	data.Source = *scancodeSrc
+
+	if err := writeDiff(stdout, list.version, spdx.EmbeddedLicenseData(), data); err != nil {
+		return fail(stderr, err)
+	}
+
+	if *dryRun {
+		return exitOK
+	}
+	if err := os.WriteFile(*out, scancode, 0o644); err != nil {
+		return fail(stderr, err)
+	}
+	fmt.Fprintf(stdout, "wrote %s (%s)\n", *out, data.Digest())
+	return exitOK
+}
+
+func fail(stderr io.Writer, err error) int {
+	fmt.Fprintf(stderr, "spdx-data: %v\n", err)
+	return exitError
+}
+
+// fetch reads src, which is an http(s) URL or a local file path.
+func fetch(client *http.Client, src string) ([]byte, error) {
+	if !strings.HasPrefix(src, "http://") && !strings.HasPrefix(src, "https://") {
+		return os.ReadFile(src)
+	}
+
+	resp, err := client.Get(src)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", src, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	testLicenses = `{"licenseListVersion": "9.99", "licenses": [
+		{"licenseId": "MIT", "isDeprecatedLicenseId": false},
+		{"licenseId": "Acme-1.0", "isDeprecatedLicenseId": false},
+		{"licenseId": "GPL-2.0", "isDeprecatedLicenseId": true}
+	]}`
+	testExceptions = `{"exceptions": [{"licenseExceptionId": "Classpath-exception-2.0"}]}`
+	testIndex      = `[
+		{"license_key": "mit", "category": "Public Domain", "spdx_license_key": "MIT", "is_exception": false, "is_deprecated": false, "json": "mit.json"},
+		{"license_key": "acme", "category": "Copyleft", "spdx_license_key": "Acme-1.0", "other_spdx_license_keys": ["LicenseRef-Acme"], "is_exception": false, "is_deprecated": false}
+	]`
+)
+
+func TestConvertScancodeEmbeddedRoundTrip(t *testing.T) {
+	embedded, err := os.ReadFile("../../licenses.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := convertScancode(embedded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, embedded) {
+		t.Error("converting the embedded licenses.json should reproduce it byte for byte")
+	}
+}
+
+func TestConvertScancode(t *testing.T) {
+	got, err := convertScancode([]byte(testIndex))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `[
+  {
+    "license_key": "acme",
+    "category": "Copyleft",
+    "spdx_license_key": "Acme-1.0",
+    "other_spdx_license_keys": [
+      "LicenseRef-Acme"
+    ],
+    "is_exception": false,
+    "is_deprecated": false
+  },
+  {
+    "license_key": "mit",
+    "category": "Public Domain",
+    "spdx_license_key": "MIT",
+    "other_spdx_license_keys": [],
+    "is_exception": false,
+    "is_deprecated": false
+  }
+]
+`
+	if string(got) != want {
+		t.Errorf("convertScancode() =\n%s\nwant\n%s", got, want)
+	}
+
+	for _, bad := range []string{"{", "[]", `[{"category": "Permissive"}]`} {
+		if _, err := convertScancode([]byte(bad)); err == nil {
+			t.Errorf("convertScancode(%q) should fail", bad)
+		}
+	}
+}
+
+func TestParseSPDXList(t *testing.T) {
+	list, err := parseSPDXList([]byte(testLicenses), []byte(testExceptions))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.version != "9.99" {
+		t.Errorf("version = %q", list.version)
+	}
+	if got := strings.Join(list.data.Licenses, ","); got != "MIT,Acme-1.0" {
+		t.Errorf("Licenses = %s", got)
+	}
+	if got := strings.Join(list.data.Deprecated, ","); got != "GPL-2.0" {
+		t.Errorf("Deprecated = %s", got)
+	}
+	if got := strings.Join(list.data.Exceptions, ","); got != "Classpath-exception-2.0" {
+		t.Errorf("Exceptions = %s", got)
+	}
+
+	if _, err := parseSPDXList([]byte(`{"licenses": []}`), []byte(testExceptions)); err == nil {
+		t.Error("an empty license list should fail")
+	}
+}
+
+func TestRunFromServer(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/licenses.json":
+			w.Write([]byte(testLicenses))
+		case "/exceptions.json":
+			w.Write([]byte(testExceptions))
+		case "/index.json":
+			w.Write([]byte(testIndex))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	out := filepath.Join(t.TempDir(), "licenses.json")
+	var stdout, stderr bytes.Buffer
+	code := run([]string{
+		"-licenses", srv.URL + "/licenses.json",
+		"-exceptions", srv.URL + "/exceptions.json",
+		"-scancode", srv.URL + "/index.json",
+		"-out", out,
+	}, &stdout, &stderr)
+	if code != exitOK {
+		t.Fatalf("run() = %d, stderr: %s", code, stderr.String())
+	}
+
+	report := stdout.String()
+	for _, want := range []string{
+		"SPDX license list 9.99",
+		"  + Acme-1.0\n",
+		"  + acme (Copyleft)\n",
+		"  - 389-exception\n",
+		"  ~ mit: Permissive -> Public Domain\n",
+		"wrote " + out + " (sha256:",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("report missing %q:\n%s", want, report)
+		}
+	}
+
+	written, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(written, []byte("[\n  {\n    \"license_key\": \"acme\"")) {
+		t.Errorf("unexpected output file:\n%s", written)
+	}
+}
+
+func TestRunDryRunFromFiles(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"licenses.json":   testLicenses,
+		"exceptions.json": testExceptions,
+		"index.json":      testIndex,
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	out := filepath.Join(dir, "out.json")
+	var stdout, stderr bytes.Buffer
+	code := run([]string{
+		"-n",
+		"-licenses", filepath.Join(dir, "licenses.json"),
+		"-exceptions", filepath.Join(dir, "exceptions.json"),
+		"-scancode", filepath.Join(dir, "index.json"),
+		"-out", out,
+	}, &stdout, &stderr)
+	if code != exitOK {
+		t.Fatalf("run() = %d, stderr: %s", code, stderr.String())
+	}
+	if _, err := os.Stat(out); !os.IsNotExist(err) {
+		t.Error("-n should not write the output file")
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{"unknown flag", []string{"-bogus"}, exitUsage},
+		{"extra argument", []string{"extra"}, exitUsage},
+		{"missing file", []string{"-licenses", filepath.Join(t.TempDir(), "missing.json")}, exitError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			if got := run(tt.args, &stdout, &stderr); got != tt.want {
+				t.Errorf("run(%q) = %d, want %d (stderr: %s)", tt.args, got, tt.want, stderr.String())
+			}
+		})
+	}
+}
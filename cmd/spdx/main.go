@@ -0,0 +1,251 @@
+// Command spdx validates and normalizes SPDX license expressions.
+//
+// Expressions are taken from the command line, or read from stdin one per
+// line when no arguments are given. Each expression is parsed and, when an
+// allow list is given, checked against it.
+//
+// Usage:
+//
+//	spdx [flags] [expression ...]
+//
+// Exit codes are stable so CI pipelines can gate on them:
+//
+//	0  all expressions passed
+//	1  at least one expression is invalid
+//	2  at least one expression violates the allow list
+//	3  reading input or writing output failed
+//	4  bad command-line usage
+//
+// When several failures occur the highest-severity one wins: an invalid
+// expression outranks a policy violation.
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/git-pkgs/spdx"
+)
+
+// Exit codes returned by the command.
+const (
+	exitOK        = 0
+	exitInvalid   = 1
+	exitViolation = 2
+	exitIOError   = 3
+	exitUsage     = 4
+)
+
+// status is the outcome of checking a single expression.
+type status string
+
+const (
+	statusOK        status = "ok"
+	statusViolation status = "violation"
+	statusInvalid   status = "invalid"
+)
+
+// severity orders statuses for the --fail-on threshold.
+func (s status) severity() int {
+	switch s {
+	case statusViolation:
+		return 1
+	case statusInvalid:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// result is the outcome of checking one input line or argument.
+type result struct {
+	Input      string `json:"input"`
+	Line       int    `json:"line,omitempty"`
+	Normalized string `json:"normalized,omitempty"`
+	Status     status `json:"status"`
+	Code       string `json:"code,omitempty"`
+	Message    string `json:"message,omitempty"`
+}
+
+type config struct {
+	format  string
+	strict  bool
+	allowed []string
+	failOn  status
+	source  string
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("spdx", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	format := fs.String("format", "text", "output format: text, json, sarif or record")
+	strict := fs.Bool("strict", false, "require exact SPDX identifiers (no informal names)")
+	allow := fs.String("allow", "", "comma-separated list of allowed license identifiers")
+	failOn := fs.String("fail-on", "violation", "lowest result that fails the run: violation, invalid or never")
+	source := fs.String("source", "", "file name to report in locations when reading stdin")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: spdx [flags] [expression ...]")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	cfg := config{format: *format, strict: *strict, source: *source}
+
+	switch cfg.format {
+	case "text", "json", "sarif", "record":
+	default:
+		fmt.Fprintf(stderr, "spdx: unknown format %q\n", cfg.format)
+		return exitUsage
+	}
+
+	switch *failOn {
+	case "violation":
+		cfg.failOn = statusViolation
+	case "invalid":
+		cfg.failOn = statusInvalid
+	case "never":
+		cfg.failOn = ""
+	default:
+		fmt.Fprintf(stderr, "spdx: unknown --fail-on value %q\n", *failOn)
+		return exitUsage
+	}
+
+	if *allow != "" {
+		for _, id := range strings.Split(*allow, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				cfg.allowed = append(cfg.allowed, id)
+			}
+		}
+		if err := validateAllowed(cfg.allowed); err != nil {
+			fmt.Fprintf(stderr, "spdx: invalid --allow list: %v\n", err)
+			return exitUsage
+		}
+	}
+
+	var results []result
+	if fs.NArg() > 0 {
+		for _, arg := range fs.Args() {
+			results = append(results, check(cfg, arg))
+		}
+	} else {
+		scanner := bufio.NewScanner(stdin)
+		line := 0
+		for scanner.Scan() {
+			line++
+			text := strings.TrimSpace(scanner.Text())
+			if text == "" {
+				continue
+			}
+			r := check(cfg, text)
+			r.Line = line
+			results = append(results, r)
+		}
+		if err := scanner.Err(); err != nil {
+			fmt.Fprintf(stderr, "spdx: reading input: %v\n", err)
+			return exitIOError
+		}
+	}
+
+	if err := write(stdout, cfg, results); err != nil {
+		fmt.Fprintf(stderr, "spdx: writing output: %v\n", err)
+		return exitIOError
+	}
+
+	return exitCode(cfg.failOn, results)
+}
+
+// validateAllowed checks the allow list once, with the rules Satisfies
+// applies to it, so a bad entry is reported as a usage error rather than
+// as an invalid result for every input.
+func validateAllowed(allowed []string) error {
+	for _, id := range allowed {
+		expr, err := spdx.ParseStrict(id)
+		if err != nil {
+			return fmt.Errorf("allowed license %q: %w", id, err)
+		}
+		switch expr.(type) {
+		case *spdx.AndExpression, *spdx.OrExpression:
+			return fmt.Errorf("allowed license %q is an expression", id)
+		}
+	}
+	return nil
+}
+
+// check parses a single expression and applies the allow list.
+func check(cfg config, input string) result {
+	r := result{Input: input}
+
+	parse := spdx.Parse
+	if cfg.strict {
+		parse = spdx.ParseStrict
+	}
+
+	expr, err := parse(input)
+	if err != nil {
+		r.Status = statusInvalid
+		r.Code = string(spdx.ErrorCode(err))
+		r.Message = err.Error()
+		return r
+	}
+	r.Normalized = expr.String()
+
+	if len(cfg.allowed) > 0 {
+		ok, err := spdx.Satisfies(r.Normalized, cfg.allowed)
+		if err != nil {
+			r.Status = statusInvalid
+			r.Code = string(spdx.ErrorCode(err))
+			r.Message = err.Error()
+			return r
+		}
+		if !ok {
+			r.Status = statusViolation
+			r.Message = "not satisfied by allowed licenses: " + strings.Join(cfg.allowed, ", ")
+			return r
+		}
+	}
+
+	r.Status = statusOK
+	return r
+}
+
+// exitCode returns the exit code for the most severe result at or above
+// the failure threshold. An empty threshold never fails.
+func exitCode(failOn status, results []result) int {
+	if failOn == "" {
+		return exitOK
+	}
+
+	worst := statusOK
+	for _, r := range results {
+		if r.Status.severity() > worst.severity() {
+			worst = r.Status
+		}
+	}
+
+	if worst.severity() < failOn.severity() {
+		return exitOK
+	}
+	switch worst {
+	case statusInvalid:
+		return exitInvalid
+	case statusViolation:
+		return exitViolation
+	default:
+		return exitOK
+	}
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/fs"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/git-pkgs/spdx"
+	"github.com/git-pkgs/spdx/schema"
+)
+
+func TestRunExitCodes(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{"valid", []string{"MIT OR Apache-2.0"}, exitOK},
+		{"invalid", []string{"FAKE-LICENSE"}, exitInvalid},
+		{"allowed", []string{"-allow", "MIT", "MIT"}, exitOK},
+		{"violation", []string{"-allow", "MIT", "GPL-3.0-only"}, exitViolation},
+		{"invalid outranks violation", []string{"-allow", "MIT", "GPL-3.0-only", "FAKE-LICENSE"}, exitInvalid},
+		{"fail on invalid ignores violation", []string{"-allow", "MIT", "-fail-on", "invalid", "GPL-3.0-only"}, exitOK},
+		{"fail on never", []string{"-fail-on", "never", "FAKE-LICENSE"}, exitOK},
+		{"strict rejects informal", []string{"-strict", "Apache 2"}, exitInvalid},
+		{"invalid allow list", []string{"-allow", "FAKE-LICENSE", "MIT"}, exitUsage},
+		{"invalid allow list with fail-on never", []string{"-allow", "FAKE-LICENSE", "-fail-on", "never", "MIT"}, exitUsage},
+		{"unknown format", []string{"-format", "xml", "MIT"}, exitUsage},
+		{"unknown fail-on", []string{"-fail-on", "sometimes", "MIT"}, exitUsage},
+		{"unknown flag", []string{"-bogus"}, exitUsage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			got := run(tt.args, strings.NewReader(""), &stdout, &stderr)
+			if got != tt.want {
+				t.Errorf("run(%q) = %d, want %d (stderr: %s)", tt.args, got, tt.want, stderr.String())
+			}
+		})
+	}
+}
+
+func TestRunStdinJSON(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	stdin := strings.NewReader("Apache 2\n\nFAKE-LICENSE\n")
+
+	code := run([]string{"-format", "json"}, stdin, &stdout, &stderr)
+	if code != exitInvalid {
+		t.Fatalf("exit code = %d, want %d", code, exitInvalid)
+	}
+
+	var results []result
+	if err := json.Unmarshal(stdout.Bytes(), &results); err != nil {
+		t.Fatalf("invalid JSON output: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	if results[0].Normalized != "Apache-2.0" || results[0].Status != statusOK || results[0].Line != 1 {
+		t.Errorf("results[0] = %+v", results[0])
+	}
+	if results[1].Status != statusInvalid || results[1].Line != 3 || results[1].Code != "E104" {
+		t.Errorf("results[1] = %+v", results[1])
+	}
+}
+
+// TestResultSchema keeps schema/check-result.schema.json in step with the
+// result type.
+func TestResultSchema(t *testing.T) {
+	b, err := fs.ReadFile(schema.FS, "check-result.schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s struct {
+		Defs struct {
+			Result struct {
+				Properties map[string]json.RawMessage `json:"properties"`
+			} `json:"result"`
+		} `json:"$defs"`
+	}
+	if err := json.Unmarshal(b, &s); err != nil {
+		t.Fatal(err)
+	}
+
+	typ := reflect.TypeFor[result]()
+	var fields []string
+	for i := range typ.NumField() {
+		name, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ",")
+		fields = append(fields, name)
+	}
+	props := slices.Sorted(maps.Keys(s.Defs.Result.Properties))
+	slices.Sort(fields)
+	if !slices.Equal(props, fields) {
+		t.Errorf("schema properties = %v, want %v", props, fields)
+	}
+}
+
+// TestRunInvalidAllowList checks that a bad -allow entry is a usage error,
+// reported once, rather than an invalid result for each expression.
+func TestRunInvalidAllowList(t *testing.T) {
+	for _, allow := range []string{"MIT,FAKE-LICENSE", "MIT AND Apache-2.0"} {
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"-allow", allow, "MIT", "Apache-2.0"}, nil, &stdout, &stderr)
+		if code != exitUsage {
+			t.Errorf("run(-allow %q) = %d, want %d", allow, code, exitUsage)
+		}
+		if stdout.Len() != 0 {
+			t.Errorf("run(-allow %q) wrote results:\n%s", allow, stdout.String())
+		}
+		if n := strings.Count(stderr.String(), "\n"); n != 1 {
+			t.Errorf("run(-allow %q) stderr has %d lines, want 1:\n%s", allow, n, stderr.String())
+		}
+	}
+}
+
+func TestRunSARIF(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	stdin := strings.NewReader("MIT\nFAKE-LICENSE\n")
+
+	run([]string{"-format", "sarif", "-source", "LICENSES.txt"}, stdin, &stdout, &stderr)
+
+	var log sarifLog
+	if err := json.Unmarshal(stdout.Bytes(), &log); err != nil {
+		t.Fatalf("invalid SARIF output: %v", err)
+	}
+	if log.Version != "2.1.0" || len(log.Runs) != 1 {
+		t.Fatalf("unexpected SARIF log: %+v", log)
+	}
+
+	results := log.Runs[0].Results
+	if len(results) != 1 {
+		t.Fatalf("got %d SARIF results, want 1", len(results))
+	}
+	if results[0].RuleID != ruleInvalid {
+		t.Errorf("RuleID = %q, want %q", results[0].RuleID, ruleInvalid)
+	}
+	loc := results[0].Locations[0].PhysicalLocation
+	if loc.ArtifactLocation.URI != "LICENSES.txt" || loc.Region == nil || loc.Region.StartLine != 2 {
+		t.Errorf("location = %+v", loc)
+	}
+}
+
+func TestRunRecord(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"-format", "record", "-allow", "MIT", "Apache 2", "MIT", "FAKE-LICENSE"}, nil, &stdout, &stderr)
+	if code != exitInvalid {
+		t.Errorf("exit = %d, want %d", code, exitInvalid)
+	}
+
+	var records []spdx.NormalizationRecord
+	if err := json.Unmarshal(stdout.Bytes(), &records); err != nil {
+		t.Fatalf("invalid output: %v\n%s", err, stdout.String())
+	}
+	if len(records) != 3 {
+		t.Fatalf("got %d records, want 3", len(records))
+	}
+	if r := records[0]; r.Output != "Apache-2.0" || len(r.Rules) == 0 || len(r.Warnings) != 1 {
+		t.Errorf("records[0] = %+v, want a normalized record with the violation as a warning", r)
+	}
+	if r := records[1]; r.Output != "MIT" || r.Confidence != 1 || len(r.Warnings) != 0 {
+		t.Errorf("records[1] = %+v", r)
+	}
+	if r := records[2]; r.Code != spdx.CodeInvalidLicenseID || r.LicenseList == "" {
+		t.Errorf("records[2] = %+v", r)
+	}
+
+	stdout.Reset()
+	records = nil
+	run([]string{"-format", "record", "-strict", "Apache 2"}, nil, &stdout, &stderr)
+	if err := json.Unmarshal(stdout.Bytes(), &records); err != nil {
+		t.Fatal(err)
+	}
+	if r := records[0]; r.Output != "" || r.Code != spdx.CodeInvalidLicenseID || r.Confidence != 0 {
+		t.Errorf("strict record = %+v, want the strict failure", r)
+	}
+}
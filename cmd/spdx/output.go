@@ -0,0 +1,185 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/git-pkgs/spdx"
+)
+
+// write renders results in the configured format.
+func write(w io.Writer, cfg config, results []result) error {
+	switch cfg.format {
+	case "json":
+		return writeJSON(w, results)
+	case "sarif":
+		return writeSARIF(w, cfg, results)
+	case "record":
+		return writeRecords(w, cfg, results)
+	default:
+		return writeText(w, results)
+	}
+}
+
+func writeText(w io.Writer, results []result) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for _, r := range results {
+		detail := r.Normalized
+		if r.Message != "" {
+			detail = r.Message
+		}
+		if _, err := fmt.Fprintf(tw, "%s\t%s\t%s\n", r.Status, r.Input, detail); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+func writeJSON(w io.Writer, results []result) error {
+	if results == nil {
+		results = []result{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(results)
+}
+
+// writeRecords writes a spdx.NormalizationRecord per result. The policy
+// verdict is added as a warning, and under -strict the outcome comes from
+// the strict check, since NormalizeRecord accepts informal names.
+func writeRecords(w io.Writer, cfg config, results []result) error {
+	inputs := make([]string, len(results))
+	for i, r := range results {
+		inputs[i] = r.Input
+	}
+	records := spdx.NormalizeRecords(inputs)
+	for i, r := range results {
+		rec := &records[i]
+		if cfg.strict {
+			rec.Output, rec.Code, rec.Error = r.Normalized, spdx.Code(r.Code), ""
+			rec.Rules, rec.Confidence = []string{}, spdx.ConfidenceExact
+			if r.Status == statusInvalid {
+				rec.Error, rec.Confidence = r.Message, 0
+			}
+		}
+		if r.Status == statusViolation {
+			rec.Warnings = append(rec.Warnings, r.Message)
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(records)
+}
+
+// SARIF 2.1.0 types, limited to the fields this command emits.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+// SARIF rule IDs, one per failing status.
+const (
+	ruleInvalid   = "spdx/invalid-expression"
+	ruleViolation = "spdx/policy-violation"
+)
+
+// writeSARIF emits a SARIF log containing one result per failing expression.
+// Locations are included when --source names the file the input came from.
+func writeSARIF(w io.Writer, cfg config, results []result) error {
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           "spdx",
+			InformationURI: "https://github.com/git-pkgs/spdx",
+			Rules: []sarifRule{
+				{ID: ruleInvalid, ShortDescription: sarifMessage{Text: "Invalid SPDX license expression"}},
+				{ID: ruleViolation, ShortDescription: sarifMessage{Text: "License not allowed by policy"}},
+			},
+		}},
+		Results: []sarifResult{},
+	}
+
+	for _, r := range results {
+		var sr sarifResult
+		switch r.Status {
+		case statusInvalid:
+			sr = sarifResult{RuleID: ruleInvalid, Level: "error"}
+		case statusViolation:
+			sr = sarifResult{RuleID: ruleViolation, Level: "error"}
+		default:
+			continue
+		}
+		sr.Message = sarifMessage{Text: fmt.Sprintf("%s: %s", r.Input, r.Message)}
+
+		if cfg.source != "" {
+			loc := sarifLocation{PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: cfg.source},
+			}}
+			if r.Line > 0 {
+				loc.PhysicalLocation.Region = &sarifRegion{StartLine: r.Line}
+			}
+			sr.Locations = []sarifLocation{loc}
+		}
+
+		run.Results = append(run.Results, sr)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	})
+}
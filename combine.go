@@ -0,0 +1,201 @@
+package spdx
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrNoCommonAlternative is returned by Intersect when no alternative is
+// acceptable under both expressions.
+var ErrNoCommonAlternative = errors.New("no alternative in common")
+
+// Operator is a binary operator for combining expressions.
+type Operator string
+
+const (
+	OperatorAnd Operator = "AND"
+	OperatorOr  Operator = "OR"
+)
+
+// Combine joins expressions with op, for example to merge per-file
+// findings into a package-level expression. Operands are parsed and
+// normalized, nested chains of the same operator are flattened, and
+// duplicates are dropped. NONE and NOASSERTION are ignored unless every
+// operand is one of them, in which case NOASSERTION wins over NONE.
+//
+// Example:
+//
+//	Combine([]string{"MIT", "Apache-2.0", "mit"}, OperatorAnd)
+//	// "MIT AND Apache-2.0"
+//
+//	Combine([]string{"MIT OR ISC", "Apache-2.0"}, OperatorOr)
+//	// "MIT OR ISC OR Apache-2.0"
+func Combine(exprs []string, op Operator) (string, error) {
+	if op != OperatorAnd && op != OperatorOr {
+		return "", fmt.Errorf("%w: %s", ErrUnexpectedToken, op)
+	}
+
+	var operands []Expression
+	var special *SpecialValue
+	seen := make(map[string]bool)
+
+	for _, s := range exprs {
+		expr, err := Parse(s)
+		if err != nil {
+			return "", err
+		}
+		if sv, ok := expr.(*SpecialValue); ok {
+			if special == nil || sv.Value == "NOASSERTION" {
+				special = sv
+			}
+			continue
+		}
+
+		parts := []Expression{expr}
+		if op == OperatorAnd {
+			parts = andOperands(expr)
+		} else if or, ok := expr.(*OrExpression); ok {
+			parts = flattenOr(or)
+		}
+		for _, p := range parts {
+			if key := p.String(); !seen[key] {
+				seen[key] = true
+				operands = append(operands, p)
+			}
+		}
+	}
+
+	switch {
+	case len(operands) > 0 && op == OperatorAnd:
+		return joinAnd(operands).String(), nil
+	case len(operands) > 0:
+		return joinOr(operands).String(), nil
+	case special != nil:
+		return special.Value, nil
+	default:
+		return "", ErrEmptyExpression
+	}
+}
+
+// Intersect returns the alternatives acceptable under both a and b: the
+// alternatives of either expression that on their own also satisfy the
+// other, keeping only the smallest ones. Unlike joining with AND, it never
+// adds licenses that neither expression offers together. It returns
+// ErrNoCommonAlternative if there are none.
+//
+// Example:
+//
+//	Intersect("MIT OR Apache-2.0", "Apache-2.0 OR GPL-2.0-only")
+//	// "Apache-2.0"
+//
+//	Intersect("MIT", "MIT AND ISC")
+//	// "MIT AND ISC"
+//
+//	Intersect("MIT", "Apache-2.0")
+//	// "", ErrNoCommonAlternative
+func Intersect(a, b string) (string, error) {
+	exprA, err := Parse(a)
+	if err != nil {
+		return "", err
+	}
+	exprB, err := Parse(b)
+	if err != nil {
+		return "", err
+	}
+
+	termsA, termsB := alternatives(exprA), alternatives(exprB)
+
+	// A term is acceptable under an expression if it includes every
+	// license of at least one of that expression's alternatives.
+	var common []alternative
+	for _, t := range append(termsA, termsB...) {
+		if t.satisfiesAny(termsA) && t.satisfiesAny(termsB) {
+			common = append(common, t)
+		}
+	}
+
+	// Keep the minimal terms, dropping duplicates and supersets
+	var minimal []alternative
+	for i, t := range common {
+		redundant := false
+		for j, u := range common {
+			if i == j || !t.includes(u) {
+				continue
+			}
+			if !u.includes(t) || j < i {
+				redundant = true
+				break
+			}
+		}
+		if !redundant {
+			minimal = append(minimal, t)
+		}
+	}
+
+	if len(minimal) == 0 {
+		return "", fmt.Errorf("%w: %s and %s", ErrNoCommonAlternative, exprA, exprB)
+	}
+	ors := make([]Expression, len(minimal))
+	for i, t := range minimal {
+		ors[i] = joinAnd(t.leaves)
+	}
+	return joinOr(ors).String(), nil
+}
+
+// alternative is one way of satisfying an expression: a set of licenses
+// that must all be honored.
+type alternative struct {
+	leaves []Expression
+	keys   []string // sorted canonical forms of leaves
+}
+
+func newAlternative(leaves []Expression) alternative {
+	var t alternative
+	for _, l := range leaves {
+		key := l.String()
+		if !slices.Contains(t.keys, key) {
+			t.leaves = append(t.leaves, l)
+			t.keys = append(t.keys, key)
+		}
+	}
+	slices.Sort(t.keys)
+	return t
+}
+
+// includes reports whether t contains every license of u.
+func (t alternative) includes(u alternative) bool {
+	for _, k := range u.keys {
+		if _, found := slices.BinarySearch(t.keys, k); !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (t alternative) satisfiesAny(terms []alternative) bool {
+	for _, u := range terms {
+		if t.includes(u) {
+			return true
+		}
+	}
+	return false
+}
+
+// alternatives expands an expression into disjunctive normal form.
+func alternatives(expr Expression) []alternative {
+	switch e := expr.(type) {
+	case *OrExpression:
+		return append(alternatives(e.Left), alternatives(e.Right)...)
+	case *AndExpression:
+		var out []alternative
+		for _, l := range alternatives(e.Left) {
+			for _, r := range alternatives(e.Right) {
+				out = append(out, newAlternative(append(slices.Clone(l.leaves), r.leaves...)))
+			}
+		}
+		return out
+	default:
+		return []alternative{newAlternative([]Expression{expr})}
+	}
+}
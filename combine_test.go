@@ -0,0 +1,75 @@
+package spdx
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCombine(t *testing.T) {
+	tests := []struct {
+		exprs []string
+		op    Operator
+		want  string
+	}{
+		{[]string{"MIT"}, OperatorAnd, "MIT"},
+		{[]string{"MIT", "Apache-2.0", "mit"}, OperatorAnd, "MIT AND Apache-2.0"},
+		{[]string{"MIT AND ISC", "ISC AND Zlib"}, OperatorAnd, "MIT AND ISC AND Zlib"},
+		{[]string{"MIT OR ISC", "Apache-2.0"}, OperatorAnd, "(MIT OR ISC) AND Apache-2.0"},
+		{[]string{"MIT OR ISC", "Apache-2.0", "ISC"}, OperatorOr, "MIT OR ISC OR Apache-2.0"},
+		{[]string{"MIT AND ISC", "Apache-2.0"}, OperatorOr, "(MIT AND ISC) OR Apache-2.0"},
+		{[]string{"NOASSERTION", "MIT"}, OperatorAnd, "MIT"},
+		{[]string{"NONE", "NOASSERTION"}, OperatorAnd, "NOASSERTION"},
+		{[]string{"Apache 2", "MIT License"}, OperatorOr, "Apache-2.0 OR MIT"},
+	}
+
+	for _, tt := range tests {
+		got, err := Combine(tt.exprs, tt.op)
+		if err != nil {
+			t.Errorf("Combine(%q, %s) error = %v", tt.exprs, tt.op, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Combine(%q, %s) = %q, want %q", tt.exprs, tt.op, got, tt.want)
+		}
+	}
+
+	if _, err := Combine(nil, OperatorAnd); err != ErrEmptyExpression {
+		t.Errorf("Combine(nil) error = %v, want ErrEmptyExpression", err)
+	}
+	if _, err := Combine([]string{"MIT"}, "WITH"); err == nil {
+		t.Error("Combine with an invalid operator should fail")
+	}
+	if _, err := Combine([]string{"MIT", "MIT AND"}, OperatorAnd); err == nil {
+		t.Error("Combine with an invalid expression should fail")
+	}
+}
+
+func TestIntersect(t *testing.T) {
+	tests := []struct {
+		a, b, want string
+	}{
+		{"MIT", "MIT", "MIT"},
+		{"MIT OR Apache-2.0", "Apache-2.0 OR GPL-2.0-only", "Apache-2.0"},
+		{"MIT OR Apache-2.0 OR ISC", "ISC OR MIT", "MIT OR ISC"},
+		{"MIT", "MIT AND ISC", "MIT AND ISC"},
+		{"MIT OR ISC", "MIT AND ISC", "MIT AND ISC"},
+		{"(MIT AND ISC) OR Apache-2.0", "ISC AND MIT", "MIT AND ISC"},
+		{"MIT OR (ISC AND Zlib)", "Zlib", "ISC AND Zlib"},
+	}
+
+	for _, tt := range tests {
+		got, err := Intersect(tt.a, tt.b)
+		if err != nil {
+			t.Errorf("Intersect(%q, %q) error = %v", tt.a, tt.b, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Intersect(%q, %q) = %q, want %q", tt.a, tt.b, got, tt.want)
+		}
+	}
+
+	_, err := Intersect("MIT", "Apache-2.0")
+	if !errors.Is(err, ErrNoCommonAlternative) {
+		t.Errorf("Intersect(MIT, Apache-2.0) error = %v, want ErrNoCommonAlternative", err)
+	}
+}
@@ -0,0 +1,133 @@
+// Package compat measures how closely this module's normalization agrees
+// with other license libraries, by running their test corpora and
+// reporting the agreement rate and every divergence.
+//
+// Two corpora are built in: SPDXCorrect, the expectations of
+// spdx-correct.js, and LicenseExpression, expressions from the Python
+// license-expression library. Users migrating from another library can
+// export its expectations as JSON and load them with LoadCorpus.
+//
+// Example:
+//
+//	report := compat.Run(compat.SPDXCorrect)
+//	fmt.Printf("%.1f%% agreement\n", report.Agreement*100)
+//	for _, d := range report.Divergences {
+//		fmt.Println(d.Input, d.Want, d.Got)
+//	}
+package compat
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/git-pkgs/spdx"
+)
+
+// Mode selects what a corpus checks.
+type Mode string
+
+const (
+	// ModeNormalize compares spdx.Normalize output with the expected
+	// identifier.
+	ModeNormalize Mode = "normalize"
+	// ModeExpression compares spdx.Parse output with the expected
+	// expression. Both are compared in canonical form, so redundant
+	// parentheses and operator case don't count as divergences.
+	ModeExpression Mode = "expression"
+)
+
+// Case is one input and the output the other library gives for it.
+type Case struct {
+	Input string `json:"input"`
+	Want  string `json:"want"`
+}
+
+// Corpus is a set of cases taken from another library.
+type Corpus struct {
+	Name   string `json:"name"`
+	Source string `json:"source"` // where the cases come from
+	Mode   Mode   `json:"mode"`
+	Cases  []Case `json:"cases"`
+}
+
+// Divergence is a case where this module's output differs.
+type Divergence struct {
+	Input string `json:"input"`
+	Want  string `json:"want"`
+	Got   string `json:"got,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// Report summarizes a run. It marshals to JSON for diffing across
+// versions.
+type Report struct {
+	Corpus      string       `json:"corpus"`
+	Total       int          `json:"total"`
+	Agreed      int          `json:"agreed"`
+	Agreement   float64      `json:"agreement"` // Agreed / Total, from 0 to 1
+	Divergences []Divergence `json:"divergences"`
+}
+
+// Run checks every case in c against the package defaults.
+func Run(c Corpus) Report {
+	report := Report{Corpus: c.Name, Total: len(c.Cases), Divergences: []Divergence{}}
+	for _, tc := range c.Cases {
+		got, err := run(c.Mode, tc.Input)
+		if err == nil && got == canonical(c.Mode, tc.Want) {
+			report.Agreed++
+			continue
+		}
+		d := Divergence{Input: tc.Input, Want: tc.Want, Got: got}
+		if err != nil {
+			d.Error = err.Error()
+		}
+		report.Divergences = append(report.Divergences, d)
+	}
+	if report.Total > 0 {
+		report.Agreement = float64(report.Agreed) / float64(report.Total)
+	}
+	return report
+}
+
+// run returns this module's output for input.
+func run(mode Mode, input string) (string, error) {
+	if mode == ModeExpression {
+		expr, err := spdx.Parse(input)
+		if err != nil {
+			return "", err
+		}
+		return expr.String(), nil
+	}
+	return spdx.Normalize(input)
+}
+
+// canonical returns want in the form run produces, so equivalent
+// expressions compare equal.
+func canonical(mode Mode, want string) string {
+	if mode != ModeExpression {
+		return want
+	}
+	expr, err := spdx.ParseStrict(want)
+	if err != nil {
+		return want
+	}
+	return expr.String()
+}
+
+// LoadCorpus reads a corpus from JSON in the form Corpus marshals to. A
+// missing mode means ModeNormalize.
+func LoadCorpus(r io.Reader) (Corpus, error) {
+	var c Corpus
+	if err := json.NewDecoder(r).Decode(&c); err != nil {
+		return Corpus{}, fmt.Errorf("decoding corpus: %w", err)
+	}
+	switch c.Mode {
+	case "":
+		c.Mode = ModeNormalize
+	case ModeNormalize, ModeExpression:
+	default:
+		return Corpus{}, fmt.Errorf("unknown corpus mode %q", c.Mode)
+	}
+	return c, nil
+}
@@ -0,0 +1,89 @@
+package compat
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRunSPDXCorrect(t *testing.T) {
+	report := Run(SPDXCorrect)
+	if report.Total != len(SPDXCorrect.Cases) || report.Total == 0 {
+		t.Fatalf("Total = %d, want %d", report.Total, len(SPDXCorrect.Cases))
+	}
+	if report.Agreed+len(report.Divergences) != report.Total {
+		t.Errorf("Agreed %d + divergences %d != total %d", report.Agreed, len(report.Divergences), report.Total)
+	}
+	for _, d := range report.Divergences {
+		t.Errorf("Normalize(%q) = %q (%s), spdx-correct gives %q", d.Input, d.Got, d.Error, d.Want)
+	}
+}
+
+func TestRunLicenseExpression(t *testing.T) {
+	report := Run(LicenseExpression)
+	for _, d := range report.Divergences {
+		t.Errorf("Parse(%q) = %q (%s), license-expression gives %q", d.Input, d.Got, d.Error, d.Want)
+	}
+}
+
+func TestRunReportsDivergences(t *testing.T) {
+	report := Run(Corpus{
+		Name: "test",
+		Mode: ModeExpression,
+		Cases: []Case{
+			{"mit or apache 2", "(MIT) OR Apache-2.0"},
+			{"mit", "Apache-2.0"},
+			{"MIT OR", "MIT"},
+		},
+	})
+	if report.Agreed != 1 || len(report.Divergences) != 2 {
+		t.Fatalf("report = %+v, want 1 agreed and 2 divergences", report)
+	}
+	if d := report.Divergences[0]; d.Got != "MIT" || d.Error != "" {
+		t.Errorf("first divergence = %+v", d)
+	}
+	if d := report.Divergences[1]; d.Got != "" || d.Error == "" {
+		t.Errorf("second divergence = %+v, want an error", d)
+	}
+	if want := 1.0 / 3; report.Agreement != want {
+		t.Errorf("Agreement = %v, want %v", report.Agreement, want)
+	}
+
+	out, err := json.Marshal(report)
+	if err != nil || !strings.Contains(string(out), `"agreement":0.333`) {
+		t.Errorf("Marshal = %s, %v", out, err)
+	}
+}
+
+func TestRunEmpty(t *testing.T) {
+	report := Run(Corpus{Name: "empty"})
+	if report.Agreement != 0 || report.Divergences == nil {
+		t.Errorf("report = %+v, want zero agreement and an empty divergence list", report)
+	}
+}
+
+func TestLoadCorpus(t *testing.T) {
+	c, err := LoadCorpus(strings.NewReader(`{"name": "x", "cases": [{"input": "Apache 2", "want": "Apache-2.0"}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Mode != ModeNormalize || len(c.Cases) != 1 {
+		t.Errorf("corpus = %+v", c)
+	}
+	if report := Run(c); report.Agreed != 1 {
+		t.Errorf("report = %+v", report)
+	}
+
+	if _, err := LoadCorpus(strings.NewReader(`{"mode": "fuzzy"}`)); err == nil {
+		t.Error("an unknown mode should fail")
+	}
+	if _, err := LoadCorpus(strings.NewReader(`{`)); err == nil {
+		t.Error("invalid JSON should fail")
+	}
+}
+
+func BenchmarkSPDXCorrect(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Run(SPDXCorrect)
+	}
+}
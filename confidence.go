@@ -0,0 +1,34 @@
+package spdx
+
+import "errors"
+
+// ErrLowConfidence is returned when Normalize has a guess for a license
+// string but it scores below Options.MinConfidence.
+var ErrLowConfidence = errors.New("license guess below confidence threshold")
+
+// Confidence scores for each normalization stage, from 0 to 1. A guess
+// scores the confidence of the first stage that matched it. Compare them
+// with Options.MinConfidence; for example, a threshold of
+// ConfidenceTransform rejects the transposition and substring heuristics.
+const (
+	// ConfidenceExact is an SPDX identifier or deprecated identifier in
+	// any letter case, with or without a trailing +.
+	ConfidenceExact = 1.0
+	// ConfidenceFrequency is a string resolved by Options.Frequencies.
+	ConfidenceFrequency = 0.95
+	// ConfidenceTransform is a string that matched after mechanical
+	// rewrites, such as "Apache 2" or "Licensed under MIT".
+	ConfidenceTransform = 0.9
+	// ConfidenceTransposition is a string that matched after a known
+	// misspelling or long name was replaced, such as "MTI" or "GNU
+	// General Public License v3".
+	ConfidenceTransposition = 0.75
+	// ConfidencePublicDomain is a bare public domain statement, such as
+	// "Public Domain", mapped by Options.PublicDomain. It is a guess at
+	// which dedication was meant, so set MinConfidence above it to have
+	// such statements reviewed rather than normalized.
+	ConfidencePublicDomain = 0.6
+	// ConfidenceLastResort is a string that contained a known license
+	// name somewhere inside it, such as "GNU" or "BSD".
+	ConfidenceLastResort = 0.5
+)
@@ -0,0 +1,69 @@
+package spdx
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGuessLicenseConfidence(t *testing.T) {
+	cfg := loadConfig()
+	tests := []struct {
+		input string
+		want  float64
+	}{
+		{"MIT", ConfidenceExact},
+		{"mit", ConfidenceExact},
+		{"GPL-2.0+", ConfidenceExact},
+		{"Apache 2", ConfidenceTransform},
+		{"Licensed under MIT", ConfidenceTransform},
+		{"MTI", ConfidenceTransposition},
+		{"GNU General Public License v3", ConfidenceTransposition},
+		{"GNU", ConfidenceLastResort},
+		{"BSD", ConfidenceLastResort},
+	}
+
+	for _, tt := range tests {
+		if _, got := guessLicense(tt.input, cfg); got != tt.want {
+			t.Errorf("guessLicense(%q) confidence = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestMinConfidence(t *testing.T) {
+	withOptions(t, Options{MinConfidence: ConfidenceTransform})
+
+	accepted := map[string]string{
+		"MIT":      "MIT",
+		"Apache 2": "Apache-2.0",
+		"GPL v3":   "GPL-3.0-or-later",
+	}
+	for input, want := range accepted {
+		if got, err := Normalize(input); err != nil || got != want {
+			t.Errorf("Normalize(%q) = %q, %v, want %q", input, got, err, want)
+		}
+	}
+
+	for _, input := range []string{"GNU", "MTI", "BSD"} {
+		_, err := Normalize(input)
+		if !errors.Is(err, ErrLowConfidence) {
+			t.Errorf("Normalize(%q) error = %v, want ErrLowConfidence", input, err)
+		}
+		if ErrorCode(err) != CodeLowConfidence {
+			t.Errorf("ErrorCode(Normalize(%q)) = %q, want %q", input, ErrorCode(err), CodeLowConfidence)
+		}
+	}
+	if _, err := Normalize("FAKEYLICENSE"); !errors.Is(err, ErrInvalidLicense) {
+		t.Errorf("Normalize(FAKEYLICENSE) error = %v, want ErrInvalidLicense", err)
+	}
+
+	if _, err := Parse("MIT OR GNU"); !errors.Is(err, ErrLowConfidence) {
+		t.Errorf("Parse(MIT OR GNU) error = %v, want ErrLowConfidence", err)
+	}
+	if _, err := Parse("MIT OR FAKEYLICENSE"); !errors.Is(err, ErrInvalidLicenseID) {
+		t.Errorf("Parse(MIT OR FAKEYLICENSE) error = %v, want ErrInvalidLicenseID", err)
+	}
+	expr, err := Parse("Apache 2 OR MIT")
+	if err != nil || expr.String() != "Apache-2.0 OR MIT" {
+		t.Errorf("Parse(Apache 2 OR MIT) = %v, %v", expr, err)
+	}
+}
@@ -0,0 +1,165 @@
+// Package conformance checks an SPDX expression parser against a corpus of
+// grammar cases.
+//
+// The corpus follows the license expression grammar in Annex D of the SPDX
+// specification: operator precedence, WITH exceptions and AdditionRefs,
+// the + operator, LicenseRef and DocumentRef references, case-insensitive
+// identifiers, and the malformed inputs a parser must reject. Users who embed a modified
+// license list, or wrap the parser, can run it to confirm grammar behavior
+// is unchanged.
+//
+// The cases are written by hand from the Annex D examples and grammar
+// rules. They are not the official SPDX expression test corpus or the
+// cases from the Python license-expression library, neither of which is
+// vendored here, so passing them is a smaller guarantee than passing
+// those suites.
+package conformance
+
+import (
+	"fmt"
+
+	"github.com/git-pkgs/spdx"
+)
+
+// ParseFunc parses an expression, like spdx.ParseStrict.
+type ParseFunc func(string) (spdx.Expression, error)
+
+// Case is a single grammar conformance case.
+type Case struct {
+	Name  string // short description
+	Input string // expression to parse
+	Valid bool   // whether the input must parse
+	Want  string // expected canonical String() for valid inputs
+}
+
+// Result is the outcome of running one Case.
+type Result struct {
+	Case
+	Got  string // String() of the parsed expression, if it parsed
+	Err  error  // parse error, if any
+	Pass bool
+}
+
+// Report summarizes a conformance run.
+type Report struct {
+	Results []Result
+	Passed  int
+	Failed  int
+}
+
+// Failures returns the results that did not pass.
+func (r Report) Failures() []Result {
+	var failed []Result
+	for _, res := range r.Results {
+		if !res.Pass {
+			failed = append(failed, res)
+		}
+	}
+	return failed
+}
+
+// Cases is the built-in conformance corpus, written by hand from Annex D.
+var Cases = []Case{
+	// Simple expressions
+	{"single license", "MIT", true, "MIT"},
+	{"case-insensitive id", "mit", true, "MIT"},
+	{"mixed case id", "apache-2.0", true, "Apache-2.0"},
+	{"or-later operator", "GPL-2.0+", true, "GPL-2.0+"},
+	{"license ref", "LicenseRef-23", true, "LicenseRef-23"},
+	{"document ref", "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2", true, "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2"},
+	{"none", "NONE", true, "NONE"},
+	{"noassertion", "NOASSERTION", true, "NOASSERTION"},
+
+	// Compound expressions from Annex D
+	{"disjunction", "LGPL-2.1-only OR MIT", true, "LGPL-2.1-only OR MIT"},
+	{"three-way disjunction", "LGPL-2.1-only OR MIT OR BSD-3-Clause", true, "LGPL-2.1-only OR MIT OR BSD-3-Clause"},
+	{"conjunction", "LGPL-2.1-only AND MIT", true, "LGPL-2.1-only AND MIT"},
+	{"three-way conjunction", "LGPL-2.1-only AND MIT AND BSD-2-Clause", true, "LGPL-2.1-only AND MIT AND BSD-2-Clause"},
+	{"exception", "GPL-2.0-or-later WITH Bison-exception-2.2", true, "GPL-2.0-or-later WITH Bison-exception-2.2"},
+	{"exception with plus", "GPL-2.0+ WITH Bison-exception-2.2", true, "GPL-2.0+ WITH Bison-exception-2.2"},
+	{"addition ref", "Apache-2.0 WITH AdditionRef-commons-clause", true, "Apache-2.0 WITH AdditionRef-commons-clause"},
+
+	// Precedence: WITH binds tighter than AND, AND tighter than OR
+	{"and binds tighter than or", "LGPL-2.1-only OR BSD-3-Clause AND MIT", true, "LGPL-2.1-only OR (BSD-3-Clause AND MIT)"},
+	{"and before or", "MIT AND LGPL-2.1-or-later OR BSD-3-Clause", true, "(MIT AND LGPL-2.1-or-later) OR BSD-3-Clause"},
+	{"parentheses override precedence", "MIT AND (LGPL-2.1-or-later OR BSD-3-Clause)", true, "MIT AND (LGPL-2.1-or-later OR BSD-3-Clause)"},
+	{"redundant parentheses", "((MIT))", true, "MIT"},
+	{"grouped or", "(MIT OR Apache-2.0) AND BSD-3-Clause", true, "(MIT OR Apache-2.0) AND BSD-3-Clause"},
+	{"with inside or", "MIT OR GPL-2.0-only WITH Classpath-exception-2.0", true, "MIT OR (GPL-2.0-only WITH Classpath-exception-2.0)"},
+	{"with inside and", "MIT AND GPL-2.0-only WITH Classpath-exception-2.0", true, "MIT AND GPL-2.0-only WITH Classpath-exception-2.0"},
+	{"extra whitespace", "  MIT   OR\tApache-2.0 ", true, "MIT OR Apache-2.0"},
+	{"no space around parens", "(MIT)AND(Apache-2.0)", true, "MIT AND Apache-2.0"},
+
+	// Malformed expressions
+	{"empty", "", false, ""},
+	{"whitespace only", "   ", false, ""},
+	{"unknown id", "FAKEYLICENSE", false, ""},
+	{"dangling and", "MIT AND", false, ""},
+	{"leading or", "OR MIT", false, ""},
+	{"double operator", "MIT OR OR Apache-2.0", false, ""},
+	{"missing close paren", "(MIT OR Apache-2.0", false, ""},
+	{"missing open paren", "MIT OR Apache-2.0)", false, ""},
+	{"empty parens", "()", false, ""},
+	{"dangling with", "GPL-2.0-only WITH", false, ""},
+	{"license as exception", "GPL-2.0-only WITH MIT", false, ""},
+	{"license ref as exception", "MIT WITH LicenseRef-x", false, ""},
+	{"with on group", "(MIT OR Apache-2.0) WITH Classpath-exception-2.0", false, ""},
+	{"double plus", "GPL-2.0++", false, ""},
+	{"adjacent licenses", "MIT Apache-2.0", false, ""},
+}
+
+// RunConformance runs every case in Cases against parse and reports the
+// results. Pass spdx.ParseStrict to check this package's own parser.
+//
+// Example:
+//
+//	report := conformance.RunConformance(spdx.ParseStrict)
+//	for _, f := range report.Failures() {
+//		fmt.Println(f.Name, f.Input, f.Err)
+//	}
+func RunConformance(parse ParseFunc) Report {
+	return Run(parse, Cases)
+}
+
+// Run runs the given cases against parse.
+func Run(parse ParseFunc, cases []Case) Report {
+	report := Report{Results: make([]Result, 0, len(cases))}
+
+	for _, c := range cases {
+		res := Result{Case: c}
+		expr, err := parse(c.Input)
+		res.Err = err
+		if err == nil {
+			res.Got = expr.String()
+		}
+
+		if c.Valid {
+			res.Pass = err == nil && res.Got == c.Want
+		} else {
+			res.Pass = err != nil
+		}
+
+		if res.Pass {
+			report.Passed++
+		} else {
+			report.Failed++
+		}
+		report.Results = append(report.Results, res)
+	}
+
+	return report
+}
+
+// String describes a failing result for test output.
+func (r Result) String() string {
+	switch {
+	case r.Pass:
+		return fmt.Sprintf("%s: ok", r.Name)
+	case r.Valid && r.Err != nil:
+		return fmt.Sprintf("%s: %q failed to parse: %v", r.Name, r.Input, r.Err)
+	case r.Valid:
+		return fmt.Sprintf("%s: %q = %q, want %q", r.Name, r.Input, r.Got, r.Want)
+	default:
+		return fmt.Sprintf("%s: %q parsed as %q, want error", r.Name, r.Input, r.Got)
+	}
+}
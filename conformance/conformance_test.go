@@ -0,0 +1,34 @@
+package conformance
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/git-pkgs/spdx"
+)
+
+func TestRunConformanceStrict(t *testing.T) {
+	report := RunConformance(spdx.ParseStrict)
+	for _, f := range report.Failures() {
+		t.Error(f)
+	}
+	if report.Passed != len(Cases) {
+		t.Errorf("passed %d of %d cases", report.Passed, len(Cases))
+	}
+}
+
+func TestRunDetectsFailures(t *testing.T) {
+	broken := func(string) (spdx.Expression, error) {
+		return nil, errors.New("always fails")
+	}
+	report := Run(broken, []Case{
+		{"valid", "MIT", true, "MIT"},
+		{"invalid", "MIT AND", false, ""},
+	})
+	if report.Passed != 1 || report.Failed != 1 {
+		t.Errorf("Passed=%d Failed=%d, want 1/1", report.Passed, report.Failed)
+	}
+	if len(report.Failures()) != 1 || report.Failures()[0].Name != "valid" {
+		t.Errorf("Failures() = %v", report.Failures())
+	}
+}
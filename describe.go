@@ -0,0 +1,119 @@
+package spdx
+
+import "strings"
+
+// Describe renders an expression as an English sentence for end users.
+// License names come from DisplayName, and WITH exceptions are named
+// inline with the license they modify.
+//
+// Example:
+//
+//	Describe("MIT OR Apache-2.0")
+//	// "You may choose either the MIT License or the Apache License 2.0."
+//
+//	Describe("MIT AND GPL-2.0-only WITH Classpath-exception-2.0")
+//	// "You must comply with both the MIT License and the GNU General Public
+//	// License v2.0 only with the Classpath exception 2.0."
+func Describe(expression string) (string, error) {
+	expr, err := Parse(expression)
+	if err != nil {
+		return "", err
+	}
+
+	switch e := expr.(type) {
+	case *OrExpression:
+		return "You may choose " + describePhrase(e) + ".", nil
+	case *AndExpression:
+		return "You must comply with " + describePhrase(e) + ".", nil
+	case *SpecialValue:
+		if e.Value == "NONE" {
+			return "No license is granted.", nil
+		}
+		return "The license has not been determined.", nil
+	default:
+		return "Licensed under " + describePhrase(e) + ".", nil
+	}
+}
+
+// describePhrase renders an expression as a noun phrase.
+func describePhrase(expr Expression) string {
+	switch e := expr.(type) {
+	case *License:
+		return describeLicense(e)
+	case *LicenseRef:
+		return "a custom license (" + e.String() + ")"
+	case *SpecialValue:
+		if e.Value == "NONE" {
+			return "no license"
+		}
+		return "an undetermined license"
+	case *AndExpression:
+		return describeList(flattenAnd(e), "and", "both", "all of")
+	case *OrExpression:
+		return describeList(flattenOr(e), "or", "either", "any one of")
+	default:
+		return expr.String()
+	}
+}
+
+// describeLicense names a license, its + operator and its exception.
+func describeLicense(l *License) string {
+	s := describeName(l.ID)
+	if l.Plus {
+		s += " or any later version"
+	}
+	if l.Exception != "" {
+		s += " with " + describeName(l.Exception)
+	}
+	return s
+}
+
+// describeName returns "the <full name>" for identifiers with name data,
+// and the bare identifier otherwise.
+func describeName(id string) string {
+	n, canonical := lookupName(id)
+	if n.full == "" {
+		return canonical
+	}
+	return "the " + strings.TrimPrefix(n.full, "The ")
+}
+
+// describeList joins phrases as "either A or B" for two items and
+// "any one of A, B, or C" for more.
+func describeList(items []Expression, conj, pair, many string) string {
+	phrases := make([]string, len(items))
+	for i, item := range items {
+		phrases[i] = describePhrase(item)
+	}
+	if len(phrases) == 2 {
+		return pair + " " + phrases[0] + " " + conj + " " + phrases[1]
+	}
+	last := len(phrases) - 1
+	return many + " " + strings.Join(phrases[:last], ", ") + ", " + conj + " " + phrases[last]
+}
+
+// flattenAnd returns the operands of a chain of AND expressions.
+func flattenAnd(e *AndExpression) []Expression {
+	var out []Expression
+	for _, side := range []Expression{e.Left, e.Right} {
+		if and, ok := side.(*AndExpression); ok {
+			out = append(out, flattenAnd(and)...)
+		} else {
+			out = append(out, side)
+		}
+	}
+	return out
+}
+
+// flattenOr returns the alternatives of a chain of OR expressions.
+func flattenOr(e *OrExpression) []Expression {
+	var out []Expression
+	for _, side := range []Expression{e.Left, e.Right} {
+		if or, ok := side.(*OrExpression); ok {
+			out = append(out, flattenOr(or)...)
+		} else {
+			out = append(out, side)
+		}
+	}
+	return out
+}
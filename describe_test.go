@@ -0,0 +1,37 @@
+package spdx
+
+import "testing"
+
+func TestDescribe(t *testing.T) {
+	tests := map[string]string{
+		"MIT":                         "Licensed under the MIT License.",
+		"Unlicense":                   "Licensed under the Unlicense.",
+		"Glide":                       "Licensed under Glide.",
+		"Apache-2.0+":                 "Licensed under the Apache License 2.0 or any later version.",
+		"LicenseRef-acme":             "Licensed under a custom license (LicenseRef-acme).",
+		"NONE":                        "No license is granted.",
+		"NOASSERTION":                 "The license has not been determined.",
+		"MIT OR Apache-2.0":           "You may choose either the MIT License or the Apache License 2.0.",
+		"MIT OR Apache-2.0 OR ISC":    "You may choose any one of the MIT License, the Apache License 2.0, or the ISC License.",
+		"MIT AND ISC":                 "You must comply with both the MIT License and the ISC License.",
+		"MIT AND ISC AND Zlib":        "You must comply with all of the MIT License, the ISC License, and the zlib License.",
+		"(MIT AND ISC) OR Apache-2.0": "You may choose either both the MIT License and the ISC License or the Apache License 2.0.",
+		"MIT OR GPL-2.0-only WITH Classpath-exception-2.0": "You may choose either the MIT License or the GNU General Public License v2.0 only with the Classpath exception 2.0.",
+	}
+
+	for input, want := range tests {
+		t.Run(input, func(t *testing.T) {
+			got, err := Describe(input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != want {
+				t.Errorf("Describe(%q) =\n  %q\nwant\n  %q", input, got, want)
+			}
+		})
+	}
+
+	if _, err := Describe("MIT AND"); err == nil {
+		t.Error("Describe should fail for invalid input")
+	}
+}
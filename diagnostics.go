@@ -0,0 +1,87 @@
+package spdx
+
+import "errors"
+
+// Code is a stable identifier for an error this package can return.
+// Codes never change meaning once released, so tooling can suppress or
+// route specific failures without matching on error messages.
+type Code string
+
+// Diagnostic codes. E0xx are normalization errors, E1xx are expression
+// parse errors, E2xx are errors from file, template and editing helpers and
+// E3xx are license data errors.
+const (
+	CodeInvalidLicense Code = "E001" // string could not be normalized to a license
+	CodeLowConfidence  Code = "E002" // best guess is below the confidence threshold
+	CodeProprietary    Code = "E003" // string describes proprietary terms, not a license
+	CodeUnresolvedURL  Code = "E004" // URL names no license that could be resolved
+
+	CodeEmptyExpression     Code = "E101" // expression is empty
+	CodeUnexpectedToken     Code = "E102" // token not valid at this position
+	CodeUnbalancedParens    Code = "E103" // missing or extra parenthesis
+	CodeInvalidLicenseID    Code = "E104" // unknown license identifier
+	CodeInvalidException    Code = "E105" // unknown exception identifier after WITH
+	CodeMissingOperand      Code = "E106" // operator without an operand
+	CodeInvalidSpecialValue Code = "E107" // NONE or NOASSERTION combined with other terms
+	CodeNoAssertion         Code = "E108" // NOASSERTION rejected by Options.NoAssertion
+	CodeInvalidJSON         Code = "E109" // JSON does not describe an expression tree
+
+	CodeUnsupportedFile      Code = "E201" // no comment style known for a file
+	CodeNoTemplate           Code = "E202" // no license template available
+	CodeMissingTemplateField Code = "E203" // template parameter not provided
+	CodeNotAlternative       Code = "E204" // license to remove is not an OR alternative
+	CodeNoAllowedAlternative Code = "E205" // policy forbids every alternative
+	CodeNoCommonAlternative  Code = "E206" // expressions share no acceptable alternative
+	CodeNoHeader             Code = "E207" // file has no SPDX-License-Identifier header
+
+	CodeDigestMismatch Code = "E301" // license data does not match the expected digest
+	CodeInvalidDigest  Code = "E302" // expected digest is malformed or unsupported
+)
+
+// diagnosticCodes maps sentinel errors to their codes.
+var diagnosticCodes = []struct {
+	err  error
+	code Code
+}{
+	{ErrProprietary, CodeProprietary}, // before ErrInvalidLicense, which it also matches
+	{ErrInvalidLicense, CodeInvalidLicense},
+	{ErrLowConfidence, CodeLowConfidence},
+	{ErrUnresolvedURL, CodeUnresolvedURL},
+	{ErrEmptyExpression, CodeEmptyExpression},
+	{ErrUnexpectedToken, CodeUnexpectedToken},
+	{ErrUnbalancedParens, CodeUnbalancedParens},
+	{ErrInvalidLicenseID, CodeInvalidLicenseID},
+	{ErrInvalidException, CodeInvalidException},
+	{ErrMissingOperand, CodeMissingOperand},
+	{ErrInvalidSpecialValue, CodeInvalidSpecialValue},
+	{ErrNoAssertion, CodeNoAssertion},
+	{ErrInvalidJSON, CodeInvalidJSON},
+	{ErrUnsupportedFile, CodeUnsupportedFile},
+	{ErrNoTemplate, CodeNoTemplate},
+	{ErrMissingTemplateField, CodeMissingTemplateField},
+	{ErrNotAlternative, CodeNotAlternative},
+	{ErrNoAllowedAlternative, CodeNoAllowedAlternative},
+	{ErrNoCommonAlternative, CodeNoCommonAlternative},
+	{ErrNoHeader, CodeNoHeader},
+	{ErrDigestMismatch, CodeDigestMismatch},
+	{ErrInvalidDigest, CodeInvalidDigest},
+}
+
+// ErrorCode returns the stable code for an error returned by this package,
+// or an empty Code if the error did not come from this package.
+//
+// Example:
+//
+//	_, err := Parse("MIT OR")
+//	ErrorCode(err)  // CodeMissingOperand ("E106")
+func ErrorCode(err error) Code {
+	if err == nil {
+		return ""
+	}
+	for _, d := range diagnosticCodes {
+		if errors.Is(err, d.err) {
+			return d.code
+		}
+	}
+	return ""
+}
@@ -0,0 +1,55 @@
+package spdx
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		expr string
+		want Code
+	}{
+		{"", CodeEmptyExpression},
+		{"MIT OR", CodeMissingOperand},
+		{"(MIT", CodeUnbalancedParens},
+		{"MIT )", CodeUnexpectedToken},
+		{"FAKEYLICENSE", CodeInvalidLicenseID},
+		{"GPL-2.0-only WITH FAKE-exception", CodeInvalidException},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseStrict(tt.expr)
+		if got := ErrorCode(err); got != tt.want {
+			t.Errorf("ErrorCode(ParseStrict(%q)) = %q, want %q (err: %v)", tt.expr, got, tt.want, err)
+		}
+	}
+
+	_, err := Normalize("UNKNOWN-LICENSE")
+	if got := ErrorCode(err); got != CodeInvalidLicense {
+		t.Errorf("ErrorCode(Normalize error) = %q, want %q", got, CodeInvalidLicense)
+	}
+
+	wrapped := fmt.Errorf("reading manifest: %w", &LicenseError{License: "X", Err: ErrInvalidLicenseID})
+	if got := ErrorCode(wrapped); got != CodeInvalidLicenseID {
+		t.Errorf("ErrorCode(wrapped) = %q, want %q", got, CodeInvalidLicenseID)
+	}
+
+	if got := ErrorCode(nil); got != "" {
+		t.Errorf("ErrorCode(nil) = %q, want empty", got)
+	}
+	if got := ErrorCode(errors.New("other")); got != "" {
+		t.Errorf("ErrorCode(foreign error) = %q, want empty", got)
+	}
+}
+
+func TestErrorCodesUnique(t *testing.T) {
+	seen := make(map[Code]bool)
+	for _, d := range diagnosticCodes {
+		if seen[d.code] {
+			t.Errorf("duplicate diagnostic code %q", d.code)
+		}
+		seen[d.code] = true
+	}
+}
@@ -0,0 +1,128 @@
+package spdx
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"slices"
+	"strings"
+)
+
+var (
+	// ErrDigestMismatch is returned when license data does not match the
+	// digest the caller expected.
+	ErrDigestMismatch = errors.New("license data digest mismatch")
+	// ErrInvalidDigest is returned when an expected digest is malformed or
+	// uses an unsupported algorithm.
+	ErrInvalidDigest = errors.New("invalid digest")
+)
+
+// embeddedSource is the Source of the data compiled into the package.
+const embeddedSource = "embedded"
+
+// VerifyDigest checks b against digest, written as "sha256:<hex>" or
+// "sha512:<hex>". Use it on downloaded license lists and scancode dumps
+// before passing them to Reload.
+//
+// Example:
+//
+//	scancode, _ := os.ReadFile("licenses.json")
+//	err := VerifyDigest(scancode, "sha256:9f86d0...")
+func VerifyDigest(b []byte, digest string) error {
+	algo, want, ok := strings.Cut(digest, ":")
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrInvalidDigest, digest)
+	}
+
+	var h hash.Hash
+	switch strings.ToLower(algo) {
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return fmt.Errorf("%w: unsupported algorithm %s", ErrInvalidDigest, algo)
+	}
+
+	wantSum, err := hex.DecodeString(want)
+	if err != nil || len(wantSum) != h.Size() {
+		return fmt.Errorf("%w: %s", ErrInvalidDigest, digest)
+	}
+
+	h.Write(b)
+	if got := h.Sum(nil); !slices.Equal(got, wantSum) {
+		return fmt.Errorf("%w: got %s:%x", ErrDigestMismatch, strings.ToLower(algo), got)
+	}
+	return nil
+}
+
+// Digest returns the SHA-256 digest of the data as "sha256:<hex>". It
+// covers the identifier lists, in any order, and the scancode bytes, but
+// not Source or Checksum, so the same data from two sources has the same
+// digest.
+//
+// Example:
+//
+//	EmbeddedLicenseData().Digest()  // "sha256:..."
+func (d LicenseData) Digest() string {
+	sum := sha256.Sum256(d.canonical())
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// canonical returns the bytes Digest and Checksum are computed over.
+func (d LicenseData) canonical() []byte {
+	var b strings.Builder
+	for _, section := range []struct {
+		name string
+		ids  []string
+	}{
+		{"licenses", d.Licenses},
+		{"deprecated", d.Deprecated},
+		{"exceptions", d.Exceptions},
+	} {
+		ids := slices.Clone(section.ids)
+		slices.Sort(ids)
+		fmt.Fprintf(&b, "%s %d\n", section.name, len(ids))
+		for _, id := range ids {
+			b.WriteString(id)
+			b.WriteByte('\n')
+		}
+	}
+	fmt.Fprintf(&b, "scancode %d\n", len(d.Scancode))
+	b.Write(d.Scancode)
+	return []byte(b.String())
+}
+
+// DataVersion identifies the license data in use.
+type DataVersion struct {
+	Source string // LicenseData.Source, or "embedded" for the built-in data
+	Digest string // LicenseData.Digest of the data, including merged fields
+}
+
+// String returns the version as "source@digest".
+func (v DataVersion) String() string {
+	return v.Source + "@" + v.Digest
+}
+
+// LicenseListVersion reports which license data is loaded: where it came
+// from and its digest. Two processes reporting the same digest normalize
+// and categorize with identical data, which is what supply-chain audits
+// usually need to record.
+//
+// Example:
+//
+//	LicenseListVersion().String()  // "embedded@sha256:..."
+func LicenseListVersion() DataVersion {
+	return loadConfig().registry().version()
+}
+
+// version returns the DataVersion of r's data, hashing it on first use.
+func (r *registry) version() DataVersion {
+	r.versionOnce.Do(func() {
+		r.dataVersion = DataVersion{Source: r.data.Source, Digest: r.data.Digest()}
+	})
+	return r.dataVersion
+}
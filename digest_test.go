@@ -0,0 +1,86 @@
+package spdx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestVerifyDigest(t *testing.T) {
+	data := []byte("test")
+	tests := []struct {
+		digest string
+		want   error
+	}{
+		{"sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", nil},
+		{"SHA256:9F86D081884C7D659A2FEAA0C55AD015A3BF4F1B2B0B822CD15D6C15B0F00A08", nil},
+		{"sha512:ee26b0dd4af7e749aa1a8ee3c10ae9923f618980772e473f8819a5d4940e0db27ac185f8a0e1d5f84f88bc887fd67b143732c304cc5fa9ad8e6f57f50028a8ff", nil},
+		{"sha256:0000000000000000000000000000000000000000000000000000000000000000", ErrDigestMismatch},
+		{"sha256:9f86d081", ErrInvalidDigest},
+		{"sha256:not-hex", ErrInvalidDigest},
+		{"md5:098f6bcd4621d373cade4e832627b4f6", ErrInvalidDigest},
+		{"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", ErrInvalidDigest},
+	}
+
+	for _, tt := range tests {
+		err := VerifyDigest(data, tt.digest)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("VerifyDigest(%q) = %v, want %v", tt.digest, err, tt.want)
+		}
+	}
+}
+
+func TestLicenseDataDigest(t *testing.T) {
+	a := LicenseData{Licenses: []string{"MIT", "Apache-2.0"}, Scancode: []byte("[]")}
+	b := LicenseData{Licenses: []string{"Apache-2.0", "MIT"}, Scancode: []byte("[]"), Source: "elsewhere"}
+	if a.Digest() != b.Digest() {
+		t.Error("Digest should not depend on identifier order or Source")
+	}
+	if !strings.HasPrefix(a.Digest(), "sha256:") {
+		t.Errorf("Digest() = %q, want sha256: prefix", a.Digest())
+	}
+
+	// Moving an identifier between lists changes the digest
+	c := LicenseData{Licenses: []string{"MIT"}, Deprecated: []string{"Apache-2.0"}, Scancode: []byte("[]")}
+	if a.Digest() == c.Digest() {
+		t.Error("Digest should distinguish licenses from deprecated identifiers")
+	}
+}
+
+func TestReloadChecksum(t *testing.T) {
+	data := LicenseData{Licenses: []string{"MIT", "Acme-1.0"}, Source: "test"}
+
+	bad := data
+	bad.Checksum = LicenseData{Licenses: []string{"MIT"}}.Digest()
+	if err := Reload(bad); !errors.Is(err, ErrDigestMismatch) {
+		t.Fatalf("Reload with wrong checksum = %v, want ErrDigestMismatch", err)
+	}
+	if ValidLicense("Acme-1.0") {
+		t.Error("a rejected Reload should leave the data unchanged")
+	}
+
+	data.Checksum = data.Digest()
+	withLicenseData(t, data)
+	if !ValidLicense("Acme-1.0") {
+		t.Error("Reload with matching checksum should load the data")
+	}
+}
+
+func TestLicenseListVersion(t *testing.T) {
+	v := LicenseListVersion()
+	if v.Source != "embedded" || v.Digest != EmbeddedLicenseData().Digest() {
+		t.Errorf("LicenseListVersion() = %v, want embedded data", v)
+	}
+
+	withLicenseData(t, LicenseData{Licenses: []string{"MIT"}, Source: "https://example.com/licenses.json"})
+	v = LicenseListVersion()
+	if v.Source != "https://example.com/licenses.json" {
+		t.Errorf("Source = %q", v.Source)
+	}
+	if v.Digest != CurrentLicenseData().Digest() || v.Digest == EmbeddedLicenseData().Digest() {
+		t.Errorf("Digest = %q, want digest of reloaded data", v.Digest)
+	}
+	if !strings.HasPrefix(v.String(), "https://example.com/licenses.json@sha256:") {
+		t.Errorf("String() = %q", v.String())
+	}
+}
@@ -0,0 +1,158 @@
+package spdx
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotAlternative is returned by RemoveAlternative when the license is
+// not one of several OR alternatives in the expression.
+var ErrNotAlternative = errors.New("license is not an alternative in the expression")
+
+// AddRequirement returns expression AND license, keeping the result
+// simplified: a requirement already present is not repeated, and an OR
+// group that offers the new requirement as an alternative is dropped, since
+// requiring the license already satisfies it. license may itself be an
+// expression.
+//
+// Example:
+//
+//	AddRequirement("MIT", "Apache-2.0")                // "MIT AND Apache-2.0"
+//	AddRequirement("MIT AND Apache-2.0", "MIT")        // "MIT AND Apache-2.0"
+//	AddRequirement("ISC AND (MIT OR Apache-2.0)", "MIT")  // "ISC AND MIT"
+func AddRequirement(expression, license string) (string, error) {
+	expr, err := Parse(expression)
+	if err != nil {
+		return "", err
+	}
+	req, err := Parse(license)
+	if err != nil {
+		return "", err
+	}
+
+	if _, ok := req.(*SpecialValue); ok {
+		return "", ErrInvalidSpecialValue
+	}
+	if _, ok := expr.(*SpecialValue); ok {
+		return req.String(), nil
+	}
+
+	current := andOperands(expr)
+	for _, r := range andOperands(req) {
+		key := r.String()
+		if containsExpr(current, key) {
+			continue
+		}
+		kept := current[:0:0]
+		for _, c := range current {
+			if or, ok := c.(*OrExpression); ok && containsExpr(flattenOr(or), key) {
+				continue
+			}
+			kept = append(kept, c)
+		}
+		current = append(kept, r)
+	}
+	return joinAnd(current).String(), nil
+}
+
+// RemoveAlternative removes license wherever it appears as an OR
+// alternative, collapsing groups left with a single option. It returns
+// ErrNotAlternative if the license is not an alternative anywhere, for
+// example because it is required by an AND or is the whole expression.
+//
+// Example:
+//
+//	RemoveAlternative("MIT OR GPL-3.0-only", "GPL-3.0-only")   // "MIT"
+//	RemoveAlternative("ISC AND (MIT OR Apache-2.0)", "MIT")  // "ISC AND Apache-2.0"
+//	RemoveAlternative("MIT AND ISC", "MIT")                  // error: ErrNotAlternative
+func RemoveAlternative(expression, license string) (string, error) {
+	expr, err := Parse(expression)
+	if err != nil {
+		return "", err
+	}
+	target, err := Parse(license)
+	if err != nil {
+		return "", err
+	}
+
+	result, removed := removeAlternative(expr, target.String())
+	if !removed {
+		return "", fmt.Errorf("%w: %s", ErrNotAlternative, target)
+	}
+	return result.String(), nil
+}
+
+// removeAlternative drops OR operands whose canonical form is key, and
+// reports whether anything was removed.
+func removeAlternative(expr Expression, key string) (Expression, bool) {
+	switch e := expr.(type) {
+	case *AndExpression:
+		ops := flattenAnd(e)
+		removed := false
+		for i, op := range ops {
+			var r bool
+			ops[i], r = removeAlternative(op, key)
+			removed = removed || r
+		}
+		return joinAnd(ops), removed
+
+	case *OrExpression:
+		alts := flattenOr(e)
+		var kept []Expression
+		for _, alt := range alts {
+			if alt.String() != key {
+				kept = append(kept, alt)
+			}
+		}
+		removed := len(kept) < len(alts)
+		if len(kept) == 0 {
+			// Every alternative is the license, so it is required
+			kept, removed = alts, false
+		}
+		for i, alt := range kept {
+			var r bool
+			kept[i], r = removeAlternative(alt, key)
+			removed = removed || r
+		}
+		return joinOr(kept), removed
+
+	default:
+		return expr, false
+	}
+}
+
+// andOperands returns the operands of an AND chain, or expr itself.
+func andOperands(expr Expression) []Expression {
+	if and, ok := expr.(*AndExpression); ok {
+		return flattenAnd(and)
+	}
+	return []Expression{expr}
+}
+
+// containsExpr reports whether any item's canonical form is key.
+func containsExpr(items []Expression, key string) bool {
+	for _, item := range items {
+		if item.String() == key {
+			return true
+		}
+	}
+	return false
+}
+
+// joinAnd combines operands into a left-nested AND chain.
+func joinAnd(items []Expression) Expression {
+	result := items[0]
+	for _, item := range items[1:] {
+		result = &AndExpression{Left: result, Right: item}
+	}
+	return result
+}
+
+// joinOr combines alternatives into a left-nested OR chain.
+func joinOr(items []Expression) Expression {
+	result := items[0]
+	for _, item := range items[1:] {
+		result = &OrExpression{Left: result, Right: item}
+	}
+	return result
+}
@@ -0,0 +1,77 @@
+package spdx
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAddRequirement(t *testing.T) {
+	tests := []struct {
+		expr, license, want string
+	}{
+		{"MIT", "Apache-2.0", "MIT AND Apache-2.0"},
+		{"MIT AND Apache-2.0", "MIT", "MIT AND Apache-2.0"},
+		{"MIT OR Apache-2.0", "ISC", "(MIT OR Apache-2.0) AND ISC"},
+		{"MIT OR Apache-2.0", "MIT", "MIT"},
+		{"ISC AND (MIT OR Apache-2.0)", "MIT", "ISC AND MIT"},
+		{"MIT", "ISC AND Zlib", "MIT AND ISC AND Zlib"},
+		{"NOASSERTION", "MIT", "MIT"},
+		{"mit", "apache 2", "MIT AND Apache-2.0"},
+	}
+
+	for _, tt := range tests {
+		got, err := AddRequirement(tt.expr, tt.license)
+		if err != nil {
+			t.Errorf("AddRequirement(%q, %q) error = %v", tt.expr, tt.license, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("AddRequirement(%q, %q) = %q, want %q", tt.expr, tt.license, got, tt.want)
+		}
+		if !Valid(got) {
+			t.Errorf("AddRequirement(%q, %q) = %q is not valid", tt.expr, tt.license, got)
+		}
+	}
+
+	if _, err := AddRequirement("MIT", "NONE"); !errors.Is(err, ErrInvalidSpecialValue) {
+		t.Errorf("AddRequirement(MIT, NONE) error = %v, want ErrInvalidSpecialValue", err)
+	}
+}
+
+func TestRemoveAlternative(t *testing.T) {
+	tests := []struct {
+		expr, license, want string
+	}{
+		{"MIT OR GPL-3.0-only", "GPL-3.0-only", "MIT"},
+		{"MIT OR Apache-2.0 OR ISC", "Apache-2.0", "MIT OR ISC"},
+		{"ISC AND (MIT OR Apache-2.0)", "MIT", "ISC AND Apache-2.0"},
+		{"(MIT AND ISC) OR Apache-2.0", "Apache-2.0", "MIT AND ISC"},
+		{"(MIT AND ISC) OR Apache-2.0", "MIT AND ISC", "Apache-2.0"},
+		{"MIT OR GPL-2.0-only WITH Classpath-exception-2.0", "GPL-2.0-only WITH Classpath-exception-2.0", "MIT"},
+	}
+
+	for _, tt := range tests {
+		got, err := RemoveAlternative(tt.expr, tt.license)
+		if err != nil {
+			t.Errorf("RemoveAlternative(%q, %q) error = %v", tt.expr, tt.license, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("RemoveAlternative(%q, %q) = %q, want %q", tt.expr, tt.license, got, tt.want)
+		}
+	}
+
+	for _, tt := range []struct{ expr, license string }{
+		{"MIT", "MIT"},
+		{"MIT AND ISC", "MIT"},
+		{"MIT OR ISC", "Apache-2.0"},
+	} {
+		_, err := RemoveAlternative(tt.expr, tt.license)
+		if !errors.Is(err, ErrNotAlternative) {
+			t.Errorf("RemoveAlternative(%q, %q) error = %v, want ErrNotAlternative", tt.expr, tt.license, err)
+		}
+		if ErrorCode(err) != CodeNotAlternative {
+			t.Errorf("ErrorCode = %q, want %q", ErrorCode(err), CodeNotAlternative)
+		}
+	}
+}
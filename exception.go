@@ -0,0 +1,212 @@
+package spdx
+
+import (
+	"slices"
+	"strings"
+)
+
+// exceptionTraits holds curated metadata for a license exception. Entries
+// are keyed by lowercase SPDX exception identifier.
+type exceptionTraits struct {
+	description string   // what the exception permits
+	modifies    []string // licenses it is written for, without -only/-or-later
+}
+
+var exceptionTraitData = map[string]exceptionTraits{
+	"autoconf-exception-2.0": {
+		description: "Permits distributing configure scripts generated by Autoconf under any terms",
+		modifies:    []string{"GPL-2.0"},
+	},
+	"autoconf-exception-3.0": {
+		description: "Permits distributing configure scripts generated by Autoconf under any terms",
+		modifies:    []string{"GPL-3.0"},
+	},
+	"bison-exception-2.2": {
+		description: "Permits distributing parsers generated from the Bison skeleton under any terms",
+		modifies:    []string{"GPL-2.0", "GPL-3.0"},
+	},
+	"classpath-exception-2.0": {
+		description: "Permits linking the library with independent modules without applying the GPL to them",
+		modifies:    []string{"GPL-2.0"},
+	},
+	"ecos-exception-2.0": {
+		description: "Permits linking eCos with applications without applying the GPL to them",
+		modifies:    []string{"GPL-2.0"},
+	},
+	"font-exception-2.0": {
+		description: "Permits embedding the font in documents without applying the GPL to them",
+		modifies:    []string{"GPL-2.0", "GPL-3.0"},
+	},
+	"freertos-exception-2.0": {
+		description: "Permits linking FreeRTOS with applications that use it only through its API",
+		modifies:    []string{"GPL-2.0"},
+	},
+	"gcc-exception-2.0": {
+		description: "Permits linking the runtime library into programs without applying the GPL to them",
+		modifies:    []string{"GPL-2.0"},
+	},
+	"gcc-exception-3.1": {
+		description: "Permits distributing programs compiled with GCC and linked to its runtime library under any terms",
+		modifies:    []string{"GPL-3.0"},
+	},
+	"lgpl-3.0-linking-exception": {
+		description: "Permits static linking and distributing combined works without the LGPL relinking requirements",
+		modifies:    []string{"LGPL-3.0"},
+	},
+	"libtool-exception": {
+		description: "Permits distributing the ltmain.sh script as part of a package under that package's terms",
+		modifies:    []string{"GPL-2.0", "GPL-3.0"},
+	},
+	"linux-syscall-note": {
+		description: "States that user programs using kernel system calls are not derived works of the kernel",
+		modifies:    []string{"GPL-2.0"},
+	},
+	"llvm-exception": {
+		description: "Waives attribution for compiled object code and resolves GPLv2 compatibility",
+		modifies:    []string{"Apache-2.0"},
+	},
+	"openjdk-assembly-exception-1.0": {
+		description: "Permits linking OpenJDK with independent modules without applying the GPL to them",
+		modifies:    []string{"GPL-2.0"},
+	},
+	"qt-gpl-exception-1.0": {
+		description: "Permits distributing code generated by Qt tools under any terms",
+		modifies:    []string{"GPL-3.0"},
+	},
+	"qt-lgpl-exception-1.1": {
+		description: "Permits using Qt header templates and inline functions without LGPL object code requirements",
+		modifies:    []string{"LGPL-2.1"},
+	},
+	"swift-exception": {
+		description: "Waives attribution for compiled Swift runtime code",
+		modifies:    []string{"Apache-2.0"},
+	},
+	"u-boot-exception-2.0": {
+		description: "Permits standalone applications that use U-Boot only through its jump table",
+		modifies:    []string{"GPL-2.0"},
+	},
+	"universal-foss-exception-1.0": {
+		description: "Permits combining the software with works under any OSI-approved license",
+		modifies:    []string{"GPL-2.0"},
+	},
+	"wxwindows-exception-3.1": {
+		description: "Permits distributing binaries of works based on the library under the user's own terms",
+		modifies:    []string{"LGPL-2.0"},
+	},
+}
+
+// categoryRestrictiveness orders categories from least to most restrictive
+// for applying exceptions. Categories not listed are never substituted.
+var categoryRestrictiveness = map[Category]int{
+	CategoryPublicDomain:    0,
+	CategoryPermissive:      1,
+	CategoryCopyleftLimited: 2,
+	CategoryCopyleft:        3,
+}
+
+// lookupExceptionTraits returns the curated metadata for an exception.
+func lookupExceptionTraits(exception string) exceptionTraits {
+	return exceptionTraitData[strings.ToLower(exception)]
+}
+
+// baseLicenseID strips the +, -only and -or-later suffixes from a license ID.
+func baseLicenseID(license string) string {
+	license = strings.TrimSuffix(license, "+")
+	license = strings.TrimSuffix(license, "-only")
+	return strings.TrimSuffix(license, "-or-later")
+}
+
+// exceptionApplies reports whether an exception is written for a license.
+// Exceptions without curated data are assumed to apply.
+func exceptionApplies(license, exception string) bool {
+	modifies := lookupExceptionTraits(exception).modifies
+	if len(modifies) == 0 {
+		return true
+	}
+	base := baseLicenseID(license)
+	return slices.ContainsFunc(modifies, func(m string) bool {
+		return strings.EqualFold(m, base)
+	})
+}
+
+// EffectiveCategory returns the category of "license WITH exception". When
+// the exception is written for that license and its category is less
+// restrictive, the exception's category wins; otherwise the license's own
+// category is returned. An empty exception returns LicenseCategory(license).
+// The riders DetectRiders reports, such as AdditionRefCommonsClause, work the
+// other way: they make the category more restrictive.
+//
+// Example:
+//
+//	EffectiveCategory("GPL-2.0-only", "Classpath-exception-2.0")  // CategoryCopyleftLimited
+//	EffectiveCategory("Apache-2.0", "LLVM-exception")             // CategoryPermissive
+//	EffectiveCategory("MIT", "Classpath-exception-2.0")           // CategoryPermissive
+func EffectiveCategory(license, exception string) Category {
+	return effectiveCategory(loadConfig().registry(), license, exception)
+}
+
+// effectiveCategory implements EffectiveCategory against reg.
+func effectiveCategory(reg *registry, license, exception string) Category {
+	base := reg.category(license)
+	if rider, ok := riderCategory(exception); ok {
+		if riderRestrictiveness[rider] > riderRestrictiveness[base] {
+			return rider
+		}
+		return base
+	}
+	if exception == "" || !exceptionApplies(license, exception) {
+		return base
+	}
+
+	exc := reg.category(exception)
+	baseRank, ok := categoryRestrictiveness[base]
+	if !ok {
+		return base
+	}
+	if excRank, ok := categoryRestrictiveness[exc]; ok && excRank < baseRank {
+		return exc
+	}
+	return base
+}
+
+// treeCategories returns the category of each license in an expression,
+// read from its parsed tree, and the EffectiveCategory of licenses with an
+// exception when applyExceptions is set. LicenseRefs other than
+// LicenseRefPublicDomain and the riders are CategoryUnknown. NONE is
+// skipped, and so is NOASSERTION unless Options.NoAssertion is
+// NoAssertionUnknown.
+func treeCategories(expression string, cfg *config, applyExceptions bool) ([]Category, error) {
+	expr, err := parseStrict(expression, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	reg := cfg.registry()
+	var licenses []string
+	var cats []Category
+	Walk(expr, func(e Expression) bool {
+		switch n := e.(type) {
+		case *License:
+			id := n.ID
+			if n.Plus {
+				id += "+"
+			}
+			licenses = append(licenses, id)
+			if applyExceptions {
+				cats = append(cats, effectiveCategory(reg, id, n.Exception))
+			} else {
+				cats = append(cats, reg.category(id))
+			}
+		case *LicenseRef:
+			licenses = append(licenses, n.String())
+			cats = append(cats, licenseRefCategory(n))
+		case *SpecialValue:
+			if n.IsNoAssertion() && cfg.opts.NoAssertion == NoAssertionUnknown {
+				licenses = append(licenses, n.Value)
+				cats = append(cats, CategoryUnknown)
+			}
+		}
+		return true
+	})
+	return sortByLicense(licenses, cats, cfg.opts.Order), nil
+}
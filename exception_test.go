@@ -0,0 +1,104 @@
+package spdx
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestEffectiveCategory(t *testing.T) {
+	tests := []struct {
+		license, exception string
+		want               Category
+	}{
+		{"GPL-2.0-only", "Classpath-exception-2.0", CategoryCopyleftLimited},
+		{"GPL-2.0-or-later", "Classpath-exception-2.0", CategoryCopyleftLimited},
+		{"GPL-2.0+", "Classpath-exception-2.0", CategoryCopyleftLimited},
+		{"GPL-3.0-or-later", "GCC-exception-3.1", CategoryCopyleftLimited},
+		{"Apache-2.0", "LLVM-exception", CategoryPermissive},
+		{"GPL-2.0-only", "Linux-syscall-note", CategoryCopyleftLimited},
+		{"GPL-2.0-only", "", CategoryCopyleft},
+		// Exception not written for the license
+		{"GPL-3.0-only", "Classpath-exception-2.0", CategoryCopyleft},
+		// Never more restrictive than the license itself
+		{"MIT", "Classpath-exception-2.0", CategoryPermissive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.license+" WITH "+tt.exception, func(t *testing.T) {
+			if got := EffectiveCategory(tt.license, tt.exception); got != tt.want {
+				t.Errorf("EffectiveCategory(%q, %q) = %q, want %q", tt.license, tt.exception, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExceptionInfo(t *testing.T) {
+	info := GetLicenseInfo("Classpath-exception-2.0")
+	if info == nil {
+		t.Fatal("GetLicenseInfo(Classpath-exception-2.0) = nil")
+	}
+	if !info.IsException {
+		t.Error("IsException should be true")
+	}
+	if info.Description == "" {
+		t.Error("Description should be set")
+	}
+	if !slices.Equal(info.Modifies, []string{"GPL-2.0"}) {
+		t.Errorf("Modifies = %v, want [GPL-2.0]", info.Modifies)
+	}
+
+	if info := GetLicenseInfo("MIT"); info.Description != "" || info.Modifies != nil {
+		t.Errorf("MIT should have no exception metadata, got %q %v", info.Description, info.Modifies)
+	}
+}
+
+func TestExceptionTraitDataKeys(t *testing.T) {
+	for id, traits := range exceptionTraitData {
+		if lookupException(id) == "" {
+			t.Errorf("exceptionTraitData key %q is not an SPDX exception", id)
+		}
+		for _, lic := range traits.modifies {
+			if LicenseCategory(lic) == CategoryUnknown {
+				t.Errorf("%s modifies unknown license %q", id, lic)
+			}
+		}
+	}
+}
+
+func TestApplyExceptions(t *testing.T) {
+	const expr = "GPL-2.0-only WITH Classpath-exception-2.0"
+
+	cats, err := ExpressionCategories(expr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(cats, []Category{CategoryCopyleft}) {
+		t.Errorf("default ExpressionCategories(%q) = %v, want [Copyleft]", expr, cats)
+	}
+	if !HasCopyleft("MIT AND " + expr) {
+		t.Errorf("default HasCopyleft(MIT AND %s) = false", expr)
+	}
+
+	withOptions(t, Options{ApplyExceptions: true})
+
+	cats, err = ExpressionCategories(expr + " OR MIT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []Category{CategoryCopyleftLimited, CategoryPermissive}; !slices.Equal(cats, want) {
+		t.Errorf("ExpressionCategories = %v, want %v", cats, want)
+	}
+
+	if !HasCopyleft(expr) {
+		t.Error("Copyleft Limited still counts for HasCopyleft")
+	}
+	if !IsFullyPermissive("Apache-2.0 WITH LLVM-exception AND MIT") {
+		t.Error("Apache-2.0 WITH LLVM-exception AND MIT should be fully permissive")
+	}
+	if IsFullyPermissive("GPL-3.0-only WITH Classpath-exception-2.0") {
+		t.Error("Classpath-exception-2.0 does not apply to GPL-3.0")
+	}
+	if _, err := ExpressionCategories("MIT AND"); err == nil {
+		t.Error("invalid expression should return an error")
+	}
+}
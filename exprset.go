@@ -0,0 +1,183 @@
+package spdx
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+)
+
+// ExpressionSet is a set of distinct expressions, such as the expressions
+// found across all files of a package. Members are parsed and normalized
+// with Parse, and expressions offering the same alternatives are the same
+// member whatever their operand order or grouping, so "MIT OR Apache-2.0"
+// and "apache-2.0 or mit" are added once. The first form added is kept.
+// It marshals to JSON as a sorted list of expression strings. The zero
+// value is an empty set ready to use. An ExpressionSet is not safe for
+// concurrent use.
+//
+// Example:
+//
+//	var s ExpressionSet
+//	s.Add("MIT OR Apache-2.0")
+//	s.Add("apache 2 or mit")         // false, nil: already present
+//	s.Add("GPL-2.0-only")
+//	s.Len()                          // 2
+//	s.Contains("Apache-2.0 OR MIT")  // true
+//	s.Strings()                      // ["GPL-2.0-only", "MIT OR Apache-2.0"]
+type ExpressionSet struct {
+	members map[string]Expression // equivalenceKey -> first expression added
+}
+
+// NewExpressionSet returns a set of the given expressions. It fails on
+// the first expression that does not parse.
+func NewExpressionSet(exprs ...string) (*ExpressionSet, error) {
+	s := &ExpressionSet{}
+	for _, expr := range exprs {
+		if _, err := s.Add(expr); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Add parses expression and adds it to the set, reporting whether it was
+// new.
+func (s *ExpressionSet) Add(expression string) (bool, error) {
+	expr, err := Parse(expression)
+	if err != nil {
+		return false, err
+	}
+	return s.AddExpression(expr), nil
+}
+
+// AddExpression adds a parsed expression to the set, reporting whether it
+// was new.
+func (s *ExpressionSet) AddExpression(expr Expression) bool {
+	key := equivalenceKey(expr)
+	if _, ok := s.members[key]; ok {
+		return false
+	}
+	if s.members == nil {
+		s.members = make(map[string]Expression)
+	}
+	s.members[key] = expr
+	return true
+}
+
+// Remove removes the member equivalent to expression, reporting whether
+// there was one.
+func (s *ExpressionSet) Remove(expression string) bool {
+	expr, err := Parse(expression)
+	if err != nil {
+		return false
+	}
+	key := equivalenceKey(expr)
+	if _, ok := s.members[key]; !ok {
+		return false
+	}
+	delete(s.members, key)
+	return true
+}
+
+// Contains reports whether the set has a member equivalent to expression.
+// An expression that does not parse is never contained.
+func (s *ExpressionSet) Contains(expression string) bool {
+	expr, err := Parse(expression)
+	return err == nil && s.ContainsExpression(expr)
+}
+
+// ContainsExpression reports whether the set has a member equivalent to
+// expr.
+func (s *ExpressionSet) ContainsExpression(expr Expression) bool {
+	_, ok := s.members[equivalenceKey(expr)]
+	return ok
+}
+
+// Len returns the number of members.
+func (s *ExpressionSet) Len() int {
+	return len(s.members)
+}
+
+// Subset reports whether every member of s is also in other.
+func (s *ExpressionSet) Subset(other *ExpressionSet) bool {
+	for key := range s.members {
+		if _, ok := other.members[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal reports whether s and other have the same members.
+func (s *ExpressionSet) Equal(other *ExpressionSet) bool {
+	return s.Len() == other.Len() && s.Subset(other)
+}
+
+// Merge adds the members of other to s. Members of s keep their form.
+func (s *ExpressionSet) Merge(other *ExpressionSet) {
+	for _, expr := range other.members {
+		s.AddExpression(expr)
+	}
+}
+
+// Union returns a new set with the members of both sets.
+func (s *ExpressionSet) Union(other *ExpressionSet) *ExpressionSet {
+	u := &ExpressionSet{}
+	u.Merge(s)
+	u.Merge(other)
+	return u
+}
+
+// Intersection returns a new set with the members of s that are also in
+// other.
+func (s *ExpressionSet) Intersection(other *ExpressionSet) *ExpressionSet {
+	i := &ExpressionSet{}
+	for key, expr := range s.members {
+		if _, ok := other.members[key]; ok {
+			i.AddExpression(expr)
+		}
+	}
+	return i
+}
+
+// Expressions returns the members, sorted by their string form.
+func (s *ExpressionSet) Expressions() []Expression {
+	exprs := make([]Expression, 0, len(s.members))
+	for _, expr := range s.members {
+		exprs = append(exprs, expr)
+	}
+	slices.SortFunc(exprs, func(a, b Expression) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	return exprs
+}
+
+// Strings returns the string form of the members, sorted.
+func (s *ExpressionSet) Strings() []string {
+	strs := make([]string, 0, len(s.members))
+	for _, expr := range s.members {
+		strs = append(strs, expr.String())
+	}
+	slices.Sort(strs)
+	return strs
+}
+
+// MarshalJSON encodes the set as a sorted list of expression strings.
+func (s *ExpressionSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Strings())
+}
+
+// UnmarshalJSON replaces the set with the expressions in a JSON list of
+// strings.
+func (s *ExpressionSet) UnmarshalJSON(data []byte) error {
+	var exprs []string
+	if err := json.Unmarshal(data, &exprs); err != nil {
+		return err
+	}
+	set, err := NewExpressionSet(exprs...)
+	if err != nil {
+		return err
+	}
+	*s = *set
+	return nil
+}
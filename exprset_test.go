@@ -0,0 +1,121 @@
+package spdx
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestExpressionSet(t *testing.T) {
+	var s ExpressionSet
+	adds := []struct {
+		input string
+		added bool
+	}{
+		{"MIT OR Apache-2.0", true},
+		{"apache 2 or mit", false},
+		{"(Apache-2.0 OR MIT)", false},
+		{"GPL-2.0-only", true},
+		{"MIT AND ISC", true},
+		{"ISC AND MIT", false},
+		{"MIT", true},
+	}
+	for _, a := range adds {
+		added, err := s.Add(a.input)
+		if err != nil || added != a.added {
+			t.Errorf("Add(%q) = %v, %v; want %v", a.input, added, err, a.added)
+		}
+	}
+	if _, err := s.Add("MIT OR"); err == nil {
+		t.Error("Add of an invalid expression should fail")
+	}
+
+	want := []string{"GPL-2.0-only", "MIT", "MIT AND ISC", "MIT OR Apache-2.0"}
+	if got := s.Strings(); !slices.Equal(got, want) {
+		t.Errorf("Strings() = %v, want %v", got, want)
+	}
+	if s.Len() != len(want) || len(s.Expressions()) != len(want) {
+		t.Errorf("Len() = %d, Expressions() has %d; want %d", s.Len(), len(s.Expressions()), len(want))
+	}
+	for _, input := range []string{"Apache-2.0 OR MIT", "gpl-2.0-only", "ISC AND MIT"} {
+		if !s.Contains(input) {
+			t.Errorf("Contains(%q) = false", input)
+		}
+	}
+	for _, input := range []string{"Apache-2.0", "MIT OR ISC", "MIT AND"} {
+		if s.Contains(input) {
+			t.Errorf("Contains(%q) = true", input)
+		}
+	}
+
+	if !s.Remove("ISC AND MIT") || s.Remove("ISC AND MIT") || s.Contains("MIT AND ISC") {
+		t.Error("Remove should drop an equivalent member once")
+	}
+}
+
+func TestExpressionSetOperations(t *testing.T) {
+	a, err := NewExpressionSet("MIT", "Apache-2.0 OR MIT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := NewExpressionSet("mit", "MIT OR Apache-2.0", "ISC")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !a.Subset(b) || b.Subset(a) {
+		t.Errorf("Subset: a⊆b = %v, b⊆a = %v; want true, false", a.Subset(b), b.Subset(a))
+	}
+	if a.Equal(b) {
+		t.Error("Equal of different sets = true")
+	}
+	if got := a.Intersection(b).Strings(); !slices.Equal(got, []string{"Apache-2.0 OR MIT", "MIT"}) {
+		t.Errorf("Intersection = %v", got)
+	}
+	u := a.Union(b)
+	if got := u.Strings(); !slices.Equal(got, []string{"Apache-2.0 OR MIT", "ISC", "MIT"}) {
+		t.Errorf("Union = %v", got)
+	}
+	if a.Len() != 2 {
+		t.Errorf("Union changed its receiver: %v", a.Strings())
+	}
+
+	a.Merge(b)
+	if !a.Equal(u) {
+		t.Errorf("Merge = %v, want %v", a.Strings(), u.Strings())
+	}
+
+	var empty ExpressionSet
+	if !empty.Subset(a) || empty.Len() != 0 || empty.Contains("MIT") {
+		t.Error("zero ExpressionSet should be an empty set")
+	}
+
+	if _, err := NewExpressionSet("MIT", "AND"); err == nil {
+		t.Error("NewExpressionSet with an invalid expression should fail")
+	}
+}
+
+func TestExpressionSetJSON(t *testing.T) {
+	s, err := NewExpressionSet("MIT OR Apache-2.0", "GPL-2.0-only", "mit or apache-2.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `["GPL-2.0-only","MIT OR Apache-2.0"]`; string(b) != want {
+		t.Errorf("json.Marshal = %s, want %s", b, want)
+	}
+
+	var back ExpressionSet
+	if err := json.Unmarshal([]byte(`["apache 2 or mit", "GPL-2.0-only", "MIT OR Apache-2.0"]`), &back); err != nil {
+		t.Fatal(err)
+	}
+	if !back.Equal(s) {
+		t.Errorf("json.Unmarshal = %v, want %v", back.Strings(), s.Strings())
+	}
+	if err := json.Unmarshal([]byte(`["MIT OR"]`), &back); err == nil {
+		t.Error("json.Unmarshal of an invalid expression should fail")
+	}
+}
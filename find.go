@@ -0,0 +1,169 @@
+package spdx
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Match is an SPDX expression found in text. Start and End are byte
+// offsets, so text[Start:End] == Text.
+type Match struct {
+	Start, End int
+	Text       string     // the expression as written
+	Expression Expression // the parsed expression
+}
+
+// reLicenseCue matches words that show a line is talking about licensing.
+var reLicenseCue = regexp.MustCompile(`(?i)licen[cs]e`)
+
+// FindExpressions locates valid SPDX expressions in prose, such as README
+// text, doc comments or CI logs, and returns them in order of position.
+// Only canonical identifiers count, in their canonical case, joined by
+// AND, OR and WITH in any case; informal names are not matched. Each
+// match is the longest valid expression at its position.
+//
+// Identifiers made only of letters, such as MIT or Ruby, are also product
+// names and ordinary words, so on their own they are only reported on
+// lines that mention a license.
+//
+// Example:
+//
+//	FindExpressions("Dual licensed under MIT or Apache-2.0, see below.")
+//	// [{Start: 20, End: 37, Text: "MIT or Apache-2.0", ...}]
+//	FindExpressions("Bindings for Ruby and Python")
+//	// none: no license is mentioned
+func FindExpressions(text string) []Match {
+	reg := loadConfig().registry()
+	tokens := scanExpressionTokens(text)
+
+	var matches []Match
+	for i := 0; i < len(tokens); {
+		if !tokens[i].canStart(reg) {
+			i++
+			continue
+		}
+		end := i + 1
+		for end < len(tokens) && tokens[end].joined && tokens[end].expressionLike(reg) {
+			end++
+		}
+
+		// Take the longest prefix of the run that parses
+		found := false
+		for j := end; j > i; j-- {
+			if tokens[j-1].isOperator() {
+				continue
+			}
+			start, stop := tokens[i].start, tokens[j-1].end
+			expr, err := ParseStrict(text[start:stop])
+			if err != nil {
+				continue
+			}
+			m := Match{Start: start, End: stop, Text: text[start:stop], Expression: expr}
+			if j-i > 1 || !isPlainWord(m.Text) || reLicenseCue.MatchString(lineAround(text, start, stop)) {
+				matches = append(matches, m)
+			}
+			i = j
+			found = true
+			break
+		}
+		if !found {
+			i++
+		}
+	}
+	return matches
+}
+
+// exprToken is a word or parenthesis in text scanned by FindExpressions.
+type exprToken struct {
+	value      string
+	start, end int
+	joined     bool // only whitespace separates it from the previous token
+}
+
+// scanExpressionTokens splits text into parentheses and words made of
+// the characters license IDs use. Sentence punctuation after a word is
+// dropped.
+func scanExpressionTokens(text string) []exprToken {
+	var tokens []exprToken
+	joined := true
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == '(' || c == ')':
+			tokens = append(tokens, exprToken{value: text[i : i+1], start: i, end: i + 1, joined: joined})
+			joined = true
+			i++
+		case isIDByte(c):
+			j := i
+			for j < len(text) && isIDByte(text[j]) {
+				j++
+			}
+			word := strings.TrimRight(text[i:j], ".:")
+			if word != "" {
+				tokens = append(tokens, exprToken{value: word, start: i, end: i + len(word), joined: joined})
+			}
+			joined = word == text[i:j]
+			i = j
+		default:
+			if c != ' ' && c != '\t' && c != '\n' && c != '\r' {
+				joined = false
+			}
+			i++
+		}
+	}
+	return tokens
+}
+
+func isIDByte(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		c == '-' || c == '.' || c == '+' || c == ':'
+}
+
+func (t exprToken) isOperator() bool {
+	switch strings.ToUpper(t.value) {
+	case "AND", "OR", "WITH":
+		return true
+	}
+	return false
+}
+
+// canStart reports whether an expression can begin at t.
+func (t exprToken) canStart(reg *registry) bool {
+	return t.value == "(" || t.isLicense(reg)
+}
+
+// expressionLike reports whether t can appear inside an expression.
+func (t exprToken) expressionLike(reg *registry) bool {
+	return t.value == "(" || t.value == ")" || t.isOperator() || t.isLicense(reg) ||
+		reg.lookupException(t.value) == t.value
+}
+
+// isLicense reports whether t is a canonical license ID, optionally with
+// a trailing +, or a LicenseRef.
+func (t exprToken) isLicense(reg *registry) bool {
+	if reg.isCanonicalLicense(strings.TrimSuffix(t.value, "+")) {
+		return true
+	}
+	return strings.HasPrefix(t.value, "LicenseRef-") || strings.HasPrefix(t.value, "DocumentRef-")
+}
+
+// isPlainWord reports whether s is made only of letters.
+func isPlainWord(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// lineAround returns the line of text containing text[start:end].
+func lineAround(text string, start, end int) string {
+	lineStart := strings.LastIndexByte(text[:start], '\n') + 1
+	lineEnd := strings.IndexByte(text[end:], '\n')
+	if lineEnd < 0 {
+		return text[lineStart:]
+	}
+	return text[lineStart : end+lineEnd]
+}
@@ -0,0 +1,56 @@
+package spdx
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFindExpressions(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"Dual licensed under MIT or Apache-2.0, see below.", []string{"MIT or Apache-2.0"}},
+		{"SPDX-License-Identifier: (GPL-2.0-only WITH Linux-syscall-note) OR BSD-3-Clause", []string{"(GPL-2.0-only WITH Linux-syscall-note) OR BSD-3-Clause"}},
+		{"This project is GPL-3.0-or-later. Docs are CC-BY-4.0.", []string{"GPL-3.0-or-later", "CC-BY-4.0"}},
+		{"Licensed under the Apache-2.0 license and MIT and the rest.", []string{"Apache-2.0", "MIT"}},
+		{"uses GPL-2.0+ code (see MIT)", []string{"GPL-2.0+"}},
+		{"Bindings for Ruby and Python", nil},
+		{"License: LicenseRef-Proprietary", []string{"LicenseRef-Proprietary"}},
+		{"apache-2.0 and mit", nil},
+		{"", nil},
+	}
+
+	for _, tt := range tests {
+		var got []string
+		for _, m := range FindExpressions(tt.text) {
+			got = append(got, m.Text)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("FindExpressions(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestFindExpressionsMatch(t *testing.T) {
+	text := "Dual licensed under MIT or Apache-2.0, see below."
+	matches := FindExpressions(text)
+	if len(matches) != 1 {
+		t.Fatalf("got %d matches, want 1", len(matches))
+	}
+	m := matches[0]
+	if m.Start != 20 || m.End != 37 || text[m.Start:m.End] != m.Text {
+		t.Errorf("offsets = [%d:%d], want [20:37] covering %q", m.Start, m.End, m.Text)
+	}
+	if m.Expression.String() != "MIT OR Apache-2.0" {
+		t.Errorf("Expression = %v, want MIT OR Apache-2.0", m.Expression)
+	}
+}
+
+func TestFindExpressionsLicenseCue(t *testing.T) {
+	text := "Written in Ruby.\nLicense: Ruby"
+	matches := FindExpressions(text)
+	if len(matches) != 1 || matches[0].Start != 26 {
+		t.Errorf("FindExpressions(%q) = %+v, want only the Ruby on the license line", text, matches)
+	}
+}
@@ -0,0 +1,223 @@
+package spdx
+
+import (
+	"slices"
+	"strings"
+)
+
+// versionLineages lists license families whose "or any later version"
+// clause reaches each later version in the list, oldest first. The LGPL
+// 2.0 and 2.1 allow relicensing under any later LGPL, and AGPL-1.0 came
+// from Affero rather than the FSF, so it is not a version of AGPL-3.0.
+var versionLineages = [][]string{
+	{"GPL-1.0", "GPL-2.0", "GPL-3.0"},
+	{"LGPL-2.0", "LGPL-2.1", "LGPL-3.0"},
+	{"GFDL-1.1", "GFDL-1.2", "GFDL-1.3"},
+}
+
+// versionOf reports the lineage and version index of a license, and
+// whether it is an -or-later form. Licenses outside the lineages, and
+// deprecated IDs like GPL-2.0 that don't say which form they are, report
+// false.
+func versionOf(l *License) (lineage, index int, orLater, ok bool) {
+	base, orLater := strings.CutSuffix(l.ID, "-or-later")
+	if !orLater {
+		var only bool
+		if base, only = strings.CutSuffix(l.ID, "-only"); !only && !l.Plus {
+			return 0, 0, false, false
+		}
+	}
+	for i, lin := range versionLineages {
+		if index := slices.Index(lin, base); index >= 0 {
+			return i, index, orLater || l.Plus, true
+		}
+	}
+	return 0, 0, false, false
+}
+
+// FoldVersionRange collapses OR alternatives that list every version of a
+// license from one version up to the newest into the -or-later form of
+// the oldest, so "GPL-2.0-only OR GPL-3.0-only" becomes GPL-2.0-or-later.
+// Alternatives already covered by an -or-later form are dropped. The
+// folded form also admits versions not yet published, so fold only
+// expressions where that is what was meant, such as ones aggregated from
+// several sources. Alternatives with different exceptions are not folded
+// together.
+//
+// Example:
+//
+//	FoldVersionRange("GPL-2.0-only OR GPL-3.0-only")
+//	// "GPL-2.0-or-later", nil
+//
+//	FoldVersionRange("MIT AND (LGPL-2.1-only OR LGPL-3.0-or-later)")
+//	// "MIT AND LGPL-2.1-or-later", nil
+//
+//	FoldVersionRange("GPL-1.0-only OR GPL-3.0-only")
+//	// "GPL-1.0-only OR GPL-3.0-only", nil: GPL-2.0 is missing
+func FoldVersionRange(expression string) (string, error) {
+	expr, err := Parse(expression)
+	if err != nil {
+		return "", err
+	}
+	folded := foldVersions(expr)
+	if cfg := loadConfig(); cfg.opts.Logger != nil {
+		cfg.debug("spdx: folded version range", "expression", expr.String(), "result", folded.String())
+	}
+	return folded.String(), nil
+}
+
+// foldVersions implements FoldVersionRange on a parsed expression.
+func foldVersions(expr Expression) Expression {
+	switch e := expr.(type) {
+	case *AndExpression:
+		ops := flattenAnd(e)
+		for i, op := range ops {
+			ops[i] = foldVersions(op)
+		}
+		return joinAnd(ops)
+	case *OrExpression:
+		alts := flattenOr(e)
+		for i, alt := range alts {
+			alts[i] = foldVersions(alt)
+		}
+		return joinOr(foldAlternatives(alts))
+	default:
+		return expr
+	}
+}
+
+// foldAlternatives folds the version ranges among OR alternatives.
+func foldAlternatives(alts []Expression) []Expression {
+	type group struct {
+		lineage   int
+		exception string
+	}
+	type member struct {
+		group
+		index   int
+		orLater bool
+	}
+
+	members := make([]*member, len(alts)) // nil for alternatives outside the lineages
+	covered := make(map[group][]bool)     // versions each group's alternatives cover
+	for i, alt := range alts {
+		l, ok := alt.(*License)
+		if !ok {
+			continue
+		}
+		lineage, index, orLater, ok := versionOf(l)
+		if !ok {
+			continue
+		}
+		m := &member{group{lineage, l.Exception}, index, orLater}
+		members[i] = m
+		n := len(versionLineages[lineage])
+		if covered[m.group] == nil {
+			covered[m.group] = make([]bool, n)
+		}
+		last := index
+		if orLater {
+			last = n - 1
+		}
+		for v := index; v <= last; v++ {
+			covered[m.group][v] = true
+		}
+	}
+
+	// Each group folds from the oldest version from which every newer one
+	// is covered, if that replaces more than one alternative or an
+	// -or-later one; a lone -only of the newest version is not widened
+	from := make(map[group]int)
+	for g, versions := range covered {
+		start := len(versions)
+		for start > 0 && versions[start-1] {
+			start--
+		}
+		replaced, widened := 0, false
+		for _, m := range members {
+			if m != nil && m.group == g && m.index >= start {
+				replaced++
+				widened = widened || m.orLater
+			}
+		}
+		if replaced > 1 || widened {
+			from[g] = start
+		}
+	}
+
+	result := make([]Expression, 0, len(alts))
+	emitted := make(map[group]bool)
+	for i, alt := range alts {
+		m := members[i]
+		if m == nil {
+			result = append(result, alt)
+			continue
+		}
+		start, ok := from[m.group]
+		if !ok || m.index < start {
+			result = append(result, alt)
+			continue
+		}
+		if !emitted[m.group] {
+			emitted[m.group] = true
+			id := versionLineages[m.lineage][start] + "-or-later"
+			result = append(result, &License{ID: id, Exception: m.exception})
+		}
+	}
+	return result
+}
+
+// ExpandVersionRange is the inverse of FoldVersionRange: it rewrites the
+// -or-later form of a license with newer published versions as an OR of
+// the -only form of each version, so "GPL-2.0-or-later" becomes
+// "GPL-2.0-only OR GPL-3.0-only". The newest version's -or-later form is
+// kept, since there is nothing to list for it.
+//
+// Example:
+//
+//	ExpandVersionRange("GPL-2.0-or-later")
+//	// "GPL-2.0-only OR GPL-3.0-only", nil
+//
+//	ExpandVersionRange("MIT AND LGPL-2.1+")
+//	// "MIT AND (LGPL-2.1-only OR LGPL-3.0-only)", nil
+func ExpandVersionRange(expression string) (string, error) {
+	expr, err := Parse(expression)
+	if err != nil {
+		return "", err
+	}
+	expanded := expandVersions(expr)
+	if cfg := loadConfig(); cfg.opts.Logger != nil {
+		cfg.debug("spdx: expanded version range", "expression", expr.String(), "result", expanded.String())
+	}
+	return expanded.String(), nil
+}
+
+// expandVersions implements ExpandVersionRange on a parsed expression.
+func expandVersions(expr Expression) Expression {
+	switch e := expr.(type) {
+	case *AndExpression:
+		ops := flattenAnd(e)
+		for i, op := range ops {
+			ops[i] = expandVersions(op)
+		}
+		return joinAnd(ops)
+	case *OrExpression:
+		alts := flattenOr(e)
+		for i, alt := range alts {
+			alts[i] = expandVersions(alt)
+		}
+		return joinOr(alts)
+	case *License:
+		lineage, index, orLater, ok := versionOf(e)
+		if !ok || !orLater || index == len(versionLineages[lineage])-1 {
+			return e
+		}
+		versions := make([]Expression, 0, len(versionLineages[lineage])-index)
+		for _, v := range versionLineages[lineage][index:] {
+			versions = append(versions, &License{ID: v + "-only", Exception: e.Exception})
+		}
+		return joinOr(versions)
+	default:
+		return expr
+	}
+}
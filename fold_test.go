@@ -0,0 +1,79 @@
+package spdx
+
+import "testing"
+
+func TestFoldVersionRange(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"GPL-2.0-only OR GPL-3.0-only", "GPL-2.0-or-later"},
+		{"GPL-1.0-only OR GPL-2.0-only OR GPL-3.0-only", "GPL-1.0-or-later"},
+		{"GPL-3.0-only OR GPL-2.0-only", "GPL-2.0-or-later"},
+		{"GPL-2.0-only OR GPL-3.0-or-later", "GPL-2.0-or-later"},
+		{"GPL-2.0-or-later OR GPL-3.0-only", "GPL-2.0-or-later"},
+		{"GPL-2.0+ OR GPL-3.0-only", "GPL-2.0-or-later"},
+		{"MIT OR LGPL-2.1-only OR LGPL-3.0-only", "MIT OR LGPL-2.1-or-later"},
+		{"MIT AND (LGPL-2.1-only OR LGPL-3.0-or-later)", "MIT AND LGPL-2.1-or-later"},
+		{"GPL-1.0-only OR GPL-2.0-only OR GPL-3.0-or-later", "GPL-1.0-or-later"},
+		{"GFDL-1.2-only OR GFDL-1.3-only", "GFDL-1.2-or-later"},
+		{"GPL-2.0-only WITH Classpath-exception-2.0 OR GPL-3.0-only WITH Classpath-exception-2.0", "GPL-2.0-or-later WITH Classpath-exception-2.0"},
+
+		// Nothing to fold
+		{"GPL-1.0-only OR GPL-3.0-only", "GPL-1.0-only OR GPL-3.0-only"},
+		{"GPL-3.0-only", "GPL-3.0-only"},
+		{"GPL-3.0-only OR MIT", "GPL-3.0-only OR MIT"},
+		{"GPL-2.0-only AND GPL-3.0-only", "GPL-2.0-only AND GPL-3.0-only"},
+		{"GPL-2.0-only OR LGPL-3.0-only", "GPL-2.0-only OR LGPL-3.0-only"},
+		{"GPL-2.0-only WITH Classpath-exception-2.0 OR GPL-3.0-only", "(GPL-2.0-only WITH Classpath-exception-2.0) OR GPL-3.0-only"},
+		{"AGPL-1.0-only OR AGPL-3.0-only", "AGPL-1.0-only OR AGPL-3.0-only"},
+		{"GPL-3.0-or-later", "GPL-3.0-or-later"},
+	}
+
+	for _, tt := range tests {
+		got, err := FoldVersionRange(tt.input)
+		if err != nil || got != tt.want {
+			t.Errorf("FoldVersionRange(%q) = %q, %v; want %q", tt.input, got, err, tt.want)
+		}
+	}
+
+	if _, err := FoldVersionRange("MIT OR"); err == nil {
+		t.Error("FoldVersionRange of an invalid expression should fail")
+	}
+}
+
+func TestExpandVersionRange(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"GPL-2.0-or-later", "GPL-2.0-only OR GPL-3.0-only"},
+		{"GPL-1.0+", "GPL-1.0-only OR GPL-2.0-only OR GPL-3.0-only"},
+		{"MIT AND LGPL-2.1+", "MIT AND (LGPL-2.1-only OR LGPL-3.0-only)"},
+		{"MIT OR GPL-2.0-or-later", "MIT OR GPL-2.0-only OR GPL-3.0-only"},
+		{"GPL-2.0-or-later WITH Classpath-exception-2.0", "(GPL-2.0-only WITH Classpath-exception-2.0) OR (GPL-3.0-only WITH Classpath-exception-2.0)"},
+		{"GPL-3.0-or-later", "GPL-3.0-or-later"},
+		{"GPL-2.0-only", "GPL-2.0-only"},
+		{"Apache-2.0", "Apache-2.0"},
+	}
+
+	for _, tt := range tests {
+		got, err := ExpandVersionRange(tt.input)
+		if err != nil || got != tt.want {
+			t.Errorf("ExpandVersionRange(%q) = %q, %v; want %q", tt.input, got, err, tt.want)
+			continue
+		}
+		if folded, err := FoldVersionRange(got); err != nil || folded != mustNormalize(t, tt.input) {
+			t.Errorf("FoldVersionRange(%q) = %q, %v; want the original %q back", got, folded, err, tt.input)
+		}
+	}
+}
+
+func mustNormalize(t *testing.T, expression string) string {
+	t.Helper()
+	expr, err := Parse(expression)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return expr.String()
+}
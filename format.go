@@ -0,0 +1,112 @@
+package spdx
+
+import "strings"
+
+// LetterCase selects the letter case Format writes a part of an
+// expression in.
+type LetterCase int
+
+const (
+	// CaseCanonical writes identifiers as the SPDX list spells them and
+	// operators in upper case, as String does. This is the default.
+	CaseCanonical LetterCase = iota
+	// CaseLower writes lower case.
+	CaseLower
+	// CaseUpper writes upper case.
+	CaseUpper
+)
+
+// apply returns s in the letter case c.
+func (c LetterCase) apply(s string) string {
+	switch c {
+	case CaseLower:
+		return strings.ToLower(s)
+	case CaseUpper:
+		return strings.ToUpper(s)
+	default:
+		return s
+	}
+}
+
+// FormatOptions controls how Format writes an expression. The zero value
+// gives the canonical form String returns.
+type FormatOptions struct {
+	// Operators is the case of AND, OR and WITH.
+	Operators LetterCase
+	// IDs is the case of license and exception identifiers, LicenseRefs
+	// and DocumentRefs, and NONE and NOASSERTION.
+	IDs LetterCase
+}
+
+// Format writes expr with the given letter case for operators and
+// identifiers, for tools that expect something other than the canonical
+// form. Parentheses and the + operator are the same as in String, so the
+// output parses back to the same expression, apart from the case of the
+// user-defined part of LicenseRefs.
+//
+// Example:
+//
+//	expr, _ := Parse("MIT OR Apache-2.0")
+//	Format(expr, FormatOptions{Operators: CaseLower, IDs: CaseLower})
+//	// "mit or apache-2.0"
+//
+//	Format(expr, FormatOptions{Operators: CaseLower})
+//	// "MIT or Apache-2.0"
+func Format(expr Expression, opts FormatOptions) string {
+	if opts == (FormatOptions{}) {
+		return expr.String()
+	}
+	var b strings.Builder
+	formatExpr(&b, expr, opts)
+	return b.String()
+}
+
+// formatExpr writes expr to b, parenthesizing operands as String does.
+func formatExpr(b *strings.Builder, expr Expression, opts FormatOptions) {
+	switch e := expr.(type) {
+	case *License:
+		b.WriteString(opts.IDs.apply(e.ID))
+		if e.Plus {
+			b.WriteByte('+')
+		}
+		if e.Exception != "" {
+			b.WriteString(" " + opts.Operators.apply("WITH") + " ")
+			b.WriteString(opts.IDs.apply(e.Exception))
+		}
+	case *AndExpression:
+		_, leftOr := e.Left.(*OrExpression)
+		_, rightOr := e.Right.(*OrExpression)
+		formatOperand(b, e.Left, leftOr, opts)
+		b.WriteString(" " + opts.Operators.apply("AND") + " ")
+		formatOperand(b, e.Right, rightOr, opts)
+	case *OrExpression:
+		formatOperand(b, e.Left, groupedInOr(e.Left), opts)
+		b.WriteString(" " + opts.Operators.apply("OR") + " ")
+		formatOperand(b, e.Right, groupedInOr(e.Right), opts)
+	default:
+		b.WriteString(opts.IDs.apply(expr.String()))
+	}
+}
+
+// formatOperand writes an operand, in parentheses if paren is set.
+func formatOperand(b *strings.Builder, expr Expression, paren bool, opts FormatOptions) {
+	if paren {
+		b.WriteByte('(')
+	}
+	formatExpr(b, expr, opts)
+	if paren {
+		b.WriteByte(')')
+	}
+}
+
+// groupedInOr reports whether String parenthesizes expr as an operand of
+// OR: AND expressions and licenses with an exception.
+func groupedInOr(expr Expression) bool {
+	switch e := expr.(type) {
+	case *AndExpression:
+		return true
+	case *License:
+		return e.Exception != ""
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+package spdx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	lower := FormatOptions{Operators: CaseLower, IDs: CaseLower}
+	tests := []struct {
+		input string
+		opts  FormatOptions
+		want  string
+	}{
+		{"MIT OR Apache-2.0", lower, "mit or apache-2.0"},
+		{"MIT OR Apache-2.0", FormatOptions{Operators: CaseLower}, "MIT or Apache-2.0"},
+		{"MIT OR Apache-2.0", FormatOptions{IDs: CaseLower}, "mit OR apache-2.0"},
+		{"MIT OR Apache-2.0", FormatOptions{IDs: CaseUpper}, "MIT OR APACHE-2.0"},
+		{"(MIT OR ISC) AND BSD-3-Clause", lower, "(mit or isc) and bsd-3-clause"},
+		{"GPL-2.0+ WITH Classpath-exception-2.0 OR MIT", lower, "(gpl-2.0+ with classpath-exception-2.0) or mit"},
+		{"MIT AND ISC OR Apache-2.0", FormatOptions{Operators: CaseLower}, "(MIT and ISC) or Apache-2.0"},
+		{"LicenseRef-Foo OR DocumentRef-doc:LicenseRef-Bar", lower, "licenseref-foo or documentref-doc:licenseref-bar"},
+		{"NOASSERTION", lower, "noassertion"},
+	}
+
+	for _, tt := range tests {
+		expr, err := ParseStrict(tt.input)
+		if err != nil {
+			t.Fatalf("ParseStrict(%q): %v", tt.input, err)
+		}
+		got := Format(expr, tt.opts)
+		if got != tt.want {
+			t.Errorf("Format(%q, %+v) = %q, want %q", tt.input, tt.opts, got, tt.want)
+		}
+		back, err := ParseStrict(got)
+		if err != nil || !strings.EqualFold(back.String(), expr.String()) {
+			t.Errorf("ParseStrict(%q) = %v, %v; want %s back, up to case", got, back, err, expr)
+		}
+	}
+}
+
+func TestFormatCanonical(t *testing.T) {
+	for _, input := range []string{
+		"MIT",
+		"MIT OR Apache-2.0 AND ISC",
+		"(MIT OR ISC) AND (Apache-2.0 OR BSD-3-Clause)",
+		"GPL-2.0-only WITH Classpath-exception-2.0 OR MIT",
+		"MIT OR GPL-2.0-only WITH Classpath-exception-2.0",
+		"LicenseRef-Foo AND (MIT OR GPL-3.0+)",
+	} {
+		expr, err := ParseStrict(input)
+		if err != nil {
+			t.Fatalf("ParseStrict(%q): %v", input, err)
+		}
+		// CaseUpper on operators is canonical too, but takes the formatting path
+		if got := Format(expr, FormatOptions{Operators: CaseUpper}); got != expr.String() {
+			t.Errorf("Format(%q) = %q, want String() %q", input, got, expr.String())
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package spdx
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// FrequencyTable records how often informal license strings meant each
+// SPDX identifier in an ecosystem, for example that bare "BSD" in npm
+// package metadata has mostly meant BSD-3-Clause. Keys are matched
+// case-insensitively.
+type FrequencyTable map[string]map[string]int
+
+// LoadFrequencyTable reads a FrequencyTable from JSON of the form
+// {"BSD": {"BSD-3-Clause": 9120, "BSD-2-Clause": 1480}}. Target
+// identifiers must be valid SPDX license identifiers.
+//
+// Example:
+//
+//	f, _ := os.Open("npm-frequencies.json")
+//	table, err := spdx.LoadFrequencyTable(f)
+//	spdx.SetDefaultOptions(spdx.Options{Frequencies: table})
+//	spdx.Normalize("BSD")  // "BSD-3-Clause"
+func LoadFrequencyTable(r io.Reader) (FrequencyTable, error) {
+	var raw map[string]map[string]int
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	table := make(FrequencyTable, len(raw))
+	for key, targets := range raw {
+		counts := make(map[string]int, len(targets))
+		for id, n := range targets {
+			canonical := lookupLicense(id)
+			if canonical == "" {
+				return nil, fmt.Errorf("%w: %s", ErrInvalidLicenseID, id)
+			}
+			counts[canonical] += n
+		}
+		table[strings.ToLower(strings.TrimSpace(key))] = counts
+	}
+	return table, nil
+}
+
+// likeliest returns the most frequent target for an informal string, or
+// "" if the table has no entry for it. Ties go to the smaller identifier
+// so the choice is stable.
+func (t FrequencyTable) likeliest(s string) string {
+	counts, ok := t[strings.ToLower(s)]
+	if !ok {
+		return ""
+	}
+	best, bestN := "", 0
+	for id, n := range counts {
+		if n > bestN || (n == bestN && id < best) {
+			best, bestN = id, n
+		}
+	}
+	return best
+}
@@ -0,0 +1,70 @@
+package spdx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+const npmFrequencies = `{
+	"BSD": {"BSD-3-Clause": 9120, "BSD-2-Clause": 1480},
+	"GPL": {"GPL-2.0": 700, "GPL-3.0-or-later": 500},
+	"Tie": {"MIT": 5, "ISC": 5}
+}`
+
+func TestLoadFrequencyTable(t *testing.T) {
+	table, err := LoadFrequencyTable(strings.NewReader(npmFrequencies))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := table.likeliest("bsd"); got != "BSD-3-Clause" {
+		t.Errorf("likeliest(bsd) = %q, want BSD-3-Clause", got)
+	}
+	if got := table.likeliest("tie"); got != "ISC" {
+		t.Errorf("likeliest(tie) = %q, want ISC (smaller ID on a tie)", got)
+	}
+	if got := table.likeliest("Apache"); got != "" {
+		t.Errorf("likeliest(Apache) = %q, want empty", got)
+	}
+
+	_, err = LoadFrequencyTable(strings.NewReader(`{"X": {"NOT-A-LICENSE": 1}}`))
+	if !errors.Is(err, ErrInvalidLicenseID) {
+		t.Errorf("invalid target error = %v, want ErrInvalidLicenseID", err)
+	}
+	if _, err := LoadFrequencyTable(strings.NewReader(`not json`)); err == nil {
+		t.Error("invalid JSON should fail")
+	}
+}
+
+func TestNormalizeWithFrequencies(t *testing.T) {
+	if got, _ := Normalize("BSD"); got != "BSD-2-Clause" {
+		t.Fatalf("default Normalize(BSD) = %q, want BSD-2-Clause", got)
+	}
+
+	table, err := LoadFrequencyTable(strings.NewReader(npmFrequencies))
+	if err != nil {
+		t.Fatal(err)
+	}
+	withOptions(t, Options{Frequencies: table})
+
+	tests := map[string]string{
+		"BSD":          "BSD-3-Clause",
+		"bsd":          "BSD-3-Clause",
+		"GPL":          "GPL-2.0-only", // GPL policy still applies
+		"BSD-2-Clause": "BSD-2-Clause", // valid IDs are never overridden
+		"Apache 2":     "Apache-2.0",
+	}
+	for input, want := range tests {
+		if got, _ := Normalize(input); got != want {
+			t.Errorf("Normalize(%q) = %q, want %q", input, got, want)
+		}
+	}
+
+	got, err := NormalizeExpression("BSD OR MIT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "BSD-3-Clause OR MIT" {
+		t.Errorf("NormalizeExpression(BSD OR MIT) = %q", got)
+	}
+}
@@ -0,0 +1,401 @@
+package spdx
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// ErrUnsupportedFile is returned when no comment style is known for a file.
+var ErrUnsupportedFile = errors.New("unsupported file type")
+
+// spdxTag is the SPDX short-form identifier tag used in source headers.
+const spdxTag = "SPDX-License-Identifier:"
+
+// headerScanLines is how many lines from the top of a file are searched
+// for an existing SPDX-License-Identifier header.
+const headerScanLines = 20
+
+// commentStyle describes how to write a single-line comment in a language,
+// and the other comment syntax it accepts, which ReadHeader reads.
+type commentStyle struct {
+	prefix string // line comment marker, or block comment opener
+	suffix string // block comment closer; empty for line comments
+
+	lines  []string    // further line comment markers
+	blocks [][2]string // further block comment openers and closers
+}
+
+var (
+	slashComment   = commentStyle{prefix: "//", blocks: [][2]string{{"/*", "*/"}}}
+	hashComment    = commentStyle{prefix: "#"}
+	dashComment    = commentStyle{prefix: "--"}
+	semiComment    = commentStyle{prefix: ";;", lines: []string{";"}}
+	pctComment     = commentStyle{prefix: "%"}
+	cComment       = commentStyle{prefix: "/*", suffix: "*/"}
+	htmlComment    = commentStyle{prefix: "<!--", suffix: "-->"}
+	pythonComment  = commentStyle{prefix: "#", blocks: [][2]string{{`"""`, `"""`}, {"'''", "'''"}}}
+	rubyComment    = commentStyle{prefix: "#", blocks: [][2]string{{"=begin", "=end"}}}
+	cmakeComment   = commentStyle{prefix: "#", blocks: [][2]string{{"#[[", "]]"}}}
+	juliaComment   = commentStyle{prefix: "#", blocks: [][2]string{{"#=", "=#"}}}
+	psComment      = commentStyle{prefix: "#", blocks: [][2]string{{"<#", "#>"}}}
+	hclComment     = commentStyle{prefix: "#", lines: []string{"//"}, blocks: [][2]string{{"/*", "*/"}}}
+	nixComment     = commentStyle{prefix: "#", blocks: [][2]string{{"/*", "*/"}}}
+	phpComment     = commentStyle{prefix: "//", lines: []string{"#"}, blocks: [][2]string{{"/*", "*/"}}}
+	sqlComment     = commentStyle{prefix: "--", blocks: [][2]string{{"/*", "*/"}}}
+	luaComment     = commentStyle{prefix: "--", blocks: [][2]string{{"--[[", "]]"}}}
+	haskellComment = commentStyle{prefix: "--", blocks: [][2]string{{"{-", "-}"}}}
+)
+
+// commentStylesByExt maps lowercase file extensions to comment styles.
+var commentStylesByExt = map[string]commentStyle{
+	".go": slashComment, ".c": slashComment, ".h": slashComment,
+	".cc": slashComment, ".cpp": slashComment, ".cxx": slashComment,
+	".hh": slashComment, ".hpp": slashComment, ".java": slashComment,
+	".js": slashComment, ".mjs": slashComment, ".cjs": slashComment,
+	".jsx": slashComment, ".ts": slashComment, ".tsx": slashComment,
+	".rs": slashComment, ".swift": slashComment, ".kt": slashComment,
+	".kts": slashComment, ".scala": slashComment, ".cs": slashComment,
+	".dart": slashComment, ".groovy": slashComment, ".gradle": slashComment,
+	".proto": slashComment, ".zig": slashComment, ".m": slashComment,
+	".php": phpComment,
+
+	".py": pythonComment, ".pyi": pythonComment, ".pyw": pythonComment,
+	".rb": rubyComment, ".sh": hashComment,
+	".bash": hashComment, ".zsh": hashComment, ".pl": hashComment,
+	".pm": hashComment, ".r": hashComment, ".yaml": hashComment,
+	".yml": hashComment, ".toml": hashComment, ".cmake": cmakeComment,
+	".mk": hashComment, ".tf": hclComment, ".nix": nixComment,
+	".ex": hashComment, ".exs": hashComment, ".jl": juliaComment,
+	".ps1": psComment, ".dockerfile": hashComment,
+
+	".sql": sqlComment, ".lua": luaComment, ".hs": haskellComment,
+	".elm": haskellComment, ".ada": dashComment, ".adb": dashComment,
+
+	".lisp": semiComment, ".el": semiComment, ".clj": semiComment,
+	".cljs": semiComment, ".scm": semiComment,
+
+	".tex": pctComment, ".erl": pctComment, ".hrl": pctComment,
+
+	".css": cComment, ".scss": slashComment, ".less": slashComment,
+
+	".html": htmlComment, ".htm": htmlComment, ".xml": htmlComment,
+	".svg": htmlComment, ".md": htmlComment, ".vue": htmlComment,
+}
+
+// commentStylesByName maps well-known extensionless file names to comment styles.
+var commentStylesByName = map[string]commentStyle{
+	"Makefile":       hashComment,
+	"GNUmakefile":    hashComment,
+	"Dockerfile":     hashComment,
+	"Containerfile":  hashComment,
+	"CMakeLists.txt": cmakeComment,
+	"Gemfile":        rubyComment,
+	"Rakefile":       rubyComment,
+	"BUILD":          hashComment,
+	"BUILD.bazel":    hashComment,
+}
+
+// reCodingCookie matches a Python/Ruby source encoding declaration, which
+// must stay on the first or second line of the file.
+var reCodingCookie = regexp.MustCompile(`^[ \t\f]*#.*?coding[:=]`)
+
+func commentStyleFor(filename string) (commentStyle, bool) {
+	base := filepath.Base(filename)
+	if style, ok := commentStylesByName[base]; ok {
+		return style, true
+	}
+	// Dockerfile.dev, Containerfile.release
+	if name, _, ok := strings.Cut(base, "."); ok && (name == "Dockerfile" || name == "Containerfile") {
+		return hashComment, true
+	}
+	style, ok := commentStylesByExt[strings.ToLower(filepath.Ext(base))]
+	return style, ok
+}
+
+// InsertHeader returns src with an SPDX-License-Identifier header for the
+// given expression. The comment syntax is chosen from the file name.
+//
+// If the leading comments of the file already have an
+// SPDX-License-Identifier line near the top, its expression is replaced in
+// place; a tag in code or a string literal is not a header. Otherwise a new header is inserted at the
+// top of the file, after any shebang, XML declaration, PHP open tag or
+// source encoding declaration, which must stay first. Other content such as
+// Go build constraints is left untouched.
+//
+// The expression is normalized with Parse before it is written.
+//
+// Example:
+//
+//	InsertHeader("main.go", src, "Apache 2")
+//	// prepends "// SPDX-License-Identifier: Apache-2.0"
+//
+//	InsertHeader("run.sh", []byte("#!/bin/sh\necho hi\n"), "MIT")
+//	// "#!/bin/sh\n# SPDX-License-Identifier: MIT\n\necho hi\n"
+func InsertHeader(filename string, src []byte, expression string) ([]byte, error) {
+	expr, err := Parse(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	style, ok := commentStyleFor(filename)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFile, filename)
+	}
+
+	id := expr.String()
+	text := string(src)
+
+	newline := "\n"
+	if strings.Contains(text, "\r\n") {
+		newline = "\r\n"
+	}
+
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	// Rewrite an existing header in place. Only tags in the leading
+	// comments count, not ones in string literals or code.
+	for _, c := range style.leadingComments(text) {
+		if c.line > headerScanLines || !strings.Contains(c.text, spdxTag) {
+			continue
+		}
+		i := c.line - 1
+		line := lines[i]
+		idx := strings.Index(line, spdxTag)
+		if idx == -1 {
+			continue
+		}
+
+		rest := line[idx+len(spdxTag):]
+		end := len(strings.TrimRight(rest, "\r\n"))
+		tail := rest[end:]
+		if style.suffix != "" {
+			if j := strings.Index(rest, style.suffix); j != -1 {
+				end = j
+				tail = " " + rest[j:]
+			}
+		}
+
+		if strings.TrimSpace(rest[:end]) == id {
+			return src, nil
+		}
+
+		lines[i] = line[:idx] + spdxTag + " " + id + tail
+		return []byte(strings.Join(lines, "")), nil
+	}
+
+	// Keep lines that must stay at the top of the file
+	p := 0
+	if p < len(lines) && strings.HasPrefix(lines[p], "#!") {
+		p++
+	}
+	if p < len(lines) && (strings.HasPrefix(lines[p], "<?xml") || strings.HasPrefix(lines[p], "<?php")) {
+		p++
+	}
+	if p < len(lines) && p < 2 && reCodingCookie.MatchString(lines[p]) {
+		p++
+	}
+	if p > 0 && !strings.HasSuffix(lines[p-1], "\n") {
+		lines[p-1] += newline
+	}
+
+	header := style.prefix + " " + spdxTag + " " + id
+	if style.suffix != "" {
+		header += " " + style.suffix
+	}
+	header += newline
+	if p < len(lines) && strings.TrimSpace(lines[p]) != "" {
+		header += newline
+	}
+
+	var b strings.Builder
+	b.Grow(len(text) + len(header))
+	for _, line := range lines[:p] {
+		b.WriteString(line)
+	}
+	b.WriteString(header)
+	for _, line := range lines[p:] {
+		b.WriteString(line)
+	}
+	return []byte(b.String()), nil
+}
+
+// ErrNoHeader is returned by ReadHeader for a file without an
+// SPDX-License-Identifier tag in its leading comments.
+var ErrNoHeader = errors.New("no SPDX header")
+
+// Header is the SPDX-License-Identifier header of a source file.
+type Header struct {
+	// Expression is the expression of the tags, joined with AND if there
+	// are several, as each covers the file.
+	Expression Expression
+	Tags       []HeaderTag
+}
+
+// HeaderTag is one SPDX-License-Identifier tag in a file's header.
+type HeaderTag struct {
+	Line       int    // 1-based line the tag starts on
+	Text       string // the expression as written, joined across lines
+	Expression Expression
+}
+
+// ReadHeader returns the SPDX-License-Identifier tags in the comments at
+// the top of a file. The comment syntax is chosen from the file name, as
+// for InsertHeader, and includes the block comments of the language, such
+// as Python docstrings, HTML comments, Haskell {- -}, Lua --[[ ]] and
+// CMake #[[ ]] blocks. Only the leading comments count: the scan stops at
+// the first line of code, so a tag in a string literal or in the body of
+// the file is not read. A shebang, XML declaration, PHP open tag or
+// doctype before the comments is skipped.
+//
+// An expression that ends with an operator or an open parenthesis
+// continues on the next comment line. Tags are normalized as Parse does;
+// one that does not parse fails with its line number and the parse error.
+// A file without a tag fails with ErrNoHeader.
+//
+// Example:
+//
+//	h, err := ReadHeader("tool.py", []byte("#!/usr/bin/env python3\n\"\"\"\nSPDX-License-Identifier: MIT OR\n    Apache-2.0\n\"\"\"\nimport os\n"))
+//	h.Expression.String() // "MIT OR Apache-2.0"
+//	h.Tags[0].Line        // 3
+func ReadHeader(filename string, src []byte) (*Header, error) {
+	style, ok := commentStyleFor(filename)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFile, filename)
+	}
+
+	cfg := loadConfig()
+	comments := style.leadingComments(string(src))
+	h := &Header{}
+	var exprs []Expression
+	seen := make(map[string]bool)
+	for i := 0; i < len(comments); i++ {
+		_, text, ok := strings.Cut(comments[i].text, spdxTag)
+		if !ok {
+			continue
+		}
+		tag := HeaderTag{Line: comments[i].line, Text: strings.TrimSpace(text)}
+		for continuesOnNextLine(tag.Text) && i+1 < len(comments) && comments[i+1].line == comments[i].line+1 &&
+			comments[i+1].text != "" && !strings.Contains(comments[i+1].text, spdxTag) {
+			i++
+			tag.Text += " " + comments[i].text
+		}
+
+		expr, err := parseConfig(tag.Text, false, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", filename, tag.Line, err)
+		}
+		tag.Expression = expr
+		h.Tags = append(h.Tags, tag)
+		if s := expr.String(); !seen[s] {
+			seen[s] = true
+			exprs = append(exprs, expr)
+		}
+	}
+	if len(h.Tags) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNoHeader, filename)
+	}
+	h.Expression = joinAnd(exprs)
+	return h, nil
+}
+
+// commentLine is the text of a comment on one line of a file.
+type commentLine struct {
+	line int // 1-based
+	text string
+}
+
+// leadingComments returns the text of the comments at the top of text,
+// one per line, with comment markers and the "*" that decorates the lines
+// of C-style block comments removed.
+func (s commentStyle) leadingComments(text string) []commentLine {
+	lines := strings.Split(text, "\n")
+	var comments []commentLine
+	add := func(i int, line string) {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "*/") {
+			line = strings.TrimSpace(strings.TrimLeft(line, "*"))
+		}
+		comments = append(comments, commentLine{line: i + 1, text: line})
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(strings.TrimSuffix(lines[i], "\r"))
+		switch {
+		case line == "",
+			i == 0 && strings.HasPrefix(line, "#!"),
+			strings.HasPrefix(line, "<?xml"), strings.HasPrefix(line, "<?php"),
+			strings.HasPrefix(strings.ToUpper(line), "<!DOCTYPE"):
+			continue
+		}
+
+		if open, close, ok := s.blockAt(line); ok {
+			rest := line[len(open):]
+			for {
+				if j := strings.Index(rest, close); j >= 0 {
+					add(i, rest[:j])
+					break
+				}
+				add(i, rest)
+				if i++; i == len(lines) {
+					break
+				}
+				rest = strings.TrimSuffix(lines[i], "\r")
+			}
+			continue
+		}
+		if marker, ok := s.lineAt(line); ok {
+			for strings.HasPrefix(line, marker) {
+				line = line[len(marker):]
+			}
+			add(i, line)
+			continue
+		}
+		break
+	}
+	return comments
+}
+
+// blockAt returns the block comment line opens, if any.
+func (s commentStyle) blockAt(line string) (open, close string, ok bool) {
+	if s.suffix != "" && strings.HasPrefix(line, s.prefix) {
+		return s.prefix, s.suffix, true
+	}
+	for _, b := range s.blocks {
+		if strings.HasPrefix(line, b[0]) {
+			return b[0], b[1], true
+		}
+		// A docstring with a string prefix, as in r"""
+		if (b[0][0] == '"' || b[0][0] == '\'') && line != "" && strings.ContainsRune("rRuU", rune(line[0])) && strings.HasPrefix(line[1:], b[0]) {
+			return line[:1+len(b[0])], b[1], true
+		}
+	}
+	return "", "", false
+}
+
+// lineAt returns the line comment marker line starts with, if any.
+func (s commentStyle) lineAt(line string) (string, bool) {
+	if s.suffix == "" && strings.HasPrefix(line, s.prefix) {
+		return s.prefix, true
+	}
+	for _, marker := range s.lines {
+		if strings.HasPrefix(line, marker) {
+			return marker, true
+		}
+	}
+	return "", false
+}
+
+// reContinued matches an expression cut off after an operator.
+var reContinued = regexp.MustCompile(`(?i)(?:^|\s)(?:AND|OR|WITH)$`)
+
+// continuesOnNextLine reports whether a tag's expression is incomplete on
+// its line: it ends with an operator or leaves a parenthesis open.
+func continuesOnNextLine(expr string) bool {
+	return reContinued.MatchString(expr) || strings.Count(expr, "(") > strings.Count(expr, ")")
+}
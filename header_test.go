@@ -0,0 +1,225 @@
+package spdx
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestInsertHeader(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		src      string
+		expr     string
+		want     string
+	}{
+		{
+			name:     "go file",
+			filename: "main.go",
+			src:      "package main\n",
+			expr:     "MIT",
+			want:     "// SPDX-License-Identifier: MIT\n\npackage main\n",
+		},
+		{
+			name:     "normalizes expression",
+			filename: "lib.rs",
+			src:      "fn main() {}\n",
+			expr:     "Apache 2 OR MIT License",
+			want:     "// SPDX-License-Identifier: Apache-2.0 OR MIT\n\nfn main() {}\n",
+		},
+		{
+			name:     "go build constraint left in place",
+			filename: "unix.go",
+			src:      "//go:build unix\n\npackage main\n",
+			expr:     "MIT",
+			want:     "// SPDX-License-Identifier: MIT\n\n//go:build unix\n\npackage main\n",
+		},
+		{
+			name:     "shebang preserved",
+			filename: "run.sh",
+			src:      "#!/bin/sh\necho hi\n",
+			expr:     "MIT",
+			want:     "#!/bin/sh\n# SPDX-License-Identifier: MIT\n\necho hi\n",
+		},
+		{
+			name:     "shebang and coding cookie preserved",
+			filename: "tool.py",
+			src:      "#!/usr/bin/env python\n# -*- coding: utf-8 -*-\nimport os\n",
+			expr:     "BSD-3-Clause",
+			want:     "#!/usr/bin/env python\n# -*- coding: utf-8 -*-\n# SPDX-License-Identifier: BSD-3-Clause\n\nimport os\n",
+		},
+		{
+			name:     "xml declaration preserved",
+			filename: "pom.xml",
+			src:      "<?xml version=\"1.0\"?>\n<project/>\n",
+			expr:     "Apache-2.0",
+			want:     "<?xml version=\"1.0\"?>\n<!-- SPDX-License-Identifier: Apache-2.0 -->\n\n<project/>\n",
+		},
+		{
+			name:     "extensionless file name",
+			filename: "build/Dockerfile",
+			src:      "FROM scratch\n",
+			expr:     "MIT",
+			want:     "# SPDX-License-Identifier: MIT\n\nFROM scratch\n",
+		},
+		{
+			name:     "empty file",
+			filename: "a.sql",
+			src:      "",
+			expr:     "MIT",
+			want:     "-- SPDX-License-Identifier: MIT\n",
+		},
+		{
+			name:     "crlf line endings",
+			filename: "a.c",
+			src:      "int x;\r\n",
+			expr:     "MIT",
+			want:     "// SPDX-License-Identifier: MIT\r\n\r\nint x;\r\n",
+		},
+		{
+			name:     "rewrites existing line comment",
+			filename: "main.go",
+			src:      "// Copyright 2024 Example\n// SPDX-License-Identifier: GPL-2.0-only\n\npackage main\n",
+			expr:     "MIT",
+			want:     "// Copyright 2024 Example\n// SPDX-License-Identifier: MIT\n\npackage main\n",
+		},
+		{
+			name:     "rewrites existing block comment",
+			filename: "style.css",
+			src:      "/* SPDX-License-Identifier: GPL-2.0-only */\nbody {}\n",
+			expr:     "MIT",
+			want:     "/* SPDX-License-Identifier: MIT */\nbody {}\n",
+		},
+		{
+			name:     "tag in a string literal is not a header",
+			filename: "header.go",
+			src:      "package spdx\n\nconst spdxTag = \"SPDX-License-Identifier:\"\n",
+			expr:     "MIT",
+			want:     "// SPDX-License-Identifier: MIT\n\npackage spdx\n\nconst spdxTag = \"SPDX-License-Identifier:\"\n",
+		},
+		{
+			name:     "tag after a comment in code is not a header",
+			filename: "tool.py",
+			src:      "# Tool\nTAG = 'SPDX-License-Identifier: GPL-2.0-only'\n",
+			expr:     "MIT",
+			want:     "# SPDX-License-Identifier: MIT\n\n# Tool\nTAG = 'SPDX-License-Identifier: GPL-2.0-only'\n",
+		},
+		{
+			name:     "unchanged when already correct",
+			filename: "main.go",
+			src:      "// SPDX-License-Identifier: MIT\npackage main\n",
+			expr:     "mit",
+			want:     "// SPDX-License-Identifier: MIT\npackage main\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := InsertHeader(tt.filename, []byte(tt.src), tt.expr)
+			if err != nil {
+				t.Fatalf("InsertHeader error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("InsertHeader(%q) =\n%q\nwant\n%q", tt.filename, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInsertHeaderErrors(t *testing.T) {
+	if _, err := InsertHeader("data.bin", []byte("x"), "MIT"); !errors.Is(err, ErrUnsupportedFile) {
+		t.Errorf("InsertHeader(data.bin) error = %v, want ErrUnsupportedFile", err)
+	}
+	if _, err := InsertHeader("main.go", []byte("x"), "FAKEYLICENSE"); err == nil {
+		t.Error("InsertHeader with invalid expression should fail")
+	}
+}
+
+func TestReadHeader(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		src      string
+		want     string
+		lines    []int
+	}{
+		{"go line comment", "main.go", "// SPDX-License-Identifier: MIT\n\npackage main\n", "MIT", []int{1}},
+		{"go after build constraint", "main.go", "//go:build linux\n\n// Copyright 2024 Acme\n// SPDX-License-Identifier: Apache-2.0\n\npackage main\n", "Apache-2.0", []int{4}},
+		{"c block", "lib.c", "/*\n * Copyright 2024 Acme\n *\n * SPDX-License-Identifier: GPL-2.0-only\n */\n#include <stdio.h>\n", "GPL-2.0-only", []int{4}},
+		{"c one-line block", "lib.h", "/* SPDX-License-Identifier: BSD-3-Clause */\n", "BSD-3-Clause", []int{1}},
+		{"css", "style.css", "/* SPDX-License-Identifier: MIT */\nbody {}\n", "MIT", []int{1}},
+		{"python docstring", "tool.py", "#!/usr/bin/env python3\n\"\"\"\nSPDX-License-Identifier: MIT OR\n    Apache-2.0\n\"\"\"\nimport os\n", "MIT OR Apache-2.0", []int{3}},
+		{"python raw docstring", "tool.py", "r'''Tool.\n\nSPDX-License-Identifier: ISC\n'''\n", "ISC", []int{3}},
+		{"python coding and hash", "tool.py", "# -*- coding: utf-8 -*-\n# SPDX-License-Identifier: 0BSD\nimport os\n", "0BSD", []int{2}},
+		{"html", "index.html", "<!DOCTYPE html>\n<!--\n  SPDX-License-Identifier: CC-BY-4.0\n-->\n<html>\n", "CC-BY-4.0", []int{3}},
+		{"xml", "pom.xml", "<?xml version=\"1.0\"?>\n<!-- SPDX-License-Identifier: Apache-2.0 -->\n<project/>\n", "Apache-2.0", []int{2}},
+		{"haskell block", "Main.hs", "{-# LANGUAGE OverloadedStrings #-}\n{-\nSPDX-License-Identifier: BSD-2-Clause\n-}\nmodule Main where\n", "BSD-2-Clause", []int{3}},
+		{"haskell line", "Main.hs", "-- SPDX-License-Identifier: MIT\nmodule Main where\n", "MIT", []int{1}},
+		{"lua block", "init.lua", "--[[\n  SPDX-License-Identifier: MIT\n]]\nlocal M = {}\n", "MIT", []int{2}},
+		{"sql", "schema.sql", "/*\n SPDX-License-Identifier: PostgreSQL\n*/\n-- tables\nCREATE TABLE t ();\n", "PostgreSQL", []int{2}},
+		{"cmake block", "CMakeLists.txt", "#[[\nSPDX-License-Identifier: BSD-3-Clause\n]]\ncmake_minimum_required(VERSION 3.20)\n", "BSD-3-Clause", []int{2}},
+		{"dockerfile", "Dockerfile.dev", "# syntax=docker/dockerfile:1\n# SPDX-License-Identifier: Apache-2.0\nFROM alpine\n", "Apache-2.0", []int{2}},
+		{"continued in parentheses", "main.go", "// SPDX-License-Identifier: (MIT OR\n//   Apache-2.0) AND ISC\npackage main\n", "(MIT OR Apache-2.0) AND ISC", []int{1}},
+		{"several tags", "main.rs", "// SPDX-License-Identifier: MIT\n// SPDX-License-Identifier: Apache-2.0\n// SPDX-License-Identifier: MIT\nfn main() {}\n", "MIT AND Apache-2.0", []int{1, 2, 3}},
+		{"normalizes", "run.sh", "#!/bin/sh\n# SPDX-License-Identifier: Apache 2\n", "Apache-2.0", []int{2}},
+		{"crlf", "main.go", "/*\r\n * SPDX-License-Identifier: MIT\r\n */\r\npackage main\r\n", "MIT", []int{2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := ReadHeader(tt.filename, []byte(tt.src))
+			if err != nil {
+				t.Fatalf("ReadHeader: %v", err)
+			}
+			if got := h.Expression.String(); got != tt.want {
+				t.Errorf("Expression = %q, want %q", got, tt.want)
+			}
+			var lines []int
+			for _, tag := range h.Tags {
+				lines = append(lines, tag.Line)
+			}
+			if !slices.Equal(lines, tt.lines) {
+				t.Errorf("tag lines = %v, want %v", lines, tt.lines)
+			}
+		})
+	}
+}
+
+func TestReadHeaderErrors(t *testing.T) {
+	tests := []struct {
+		filename string
+		src      string
+		err      error
+	}{
+		{"data.bin", "// SPDX-License-Identifier: MIT\n", ErrUnsupportedFile},
+		{"main.go", "package main\n", ErrNoHeader},
+		// Not a comment, or past the first line of code
+		{"main.go", "package main\n\nconst tag = \"SPDX-License-Identifier: MIT\"\n", ErrNoHeader},
+		{"main.go", "package main\n// SPDX-License-Identifier: MIT\n", ErrNoHeader},
+		{"README.md", "# Title\n<!-- SPDX-License-Identifier: MIT -->\n", ErrNoHeader},
+		{"tool.py", "import os\n\"\"\"SPDX-License-Identifier: MIT\"\"\"\n", ErrNoHeader},
+		{"main.go", "// SPDX-License-Identifier: FAKEYLICENSE\npackage main\n", ErrInvalidLicenseID},
+		{"main.go", "// SPDX-License-Identifier: MIT OR\npackage main\n", ErrMissingOperand},
+	}
+	for _, tt := range tests {
+		if _, err := ReadHeader(tt.filename, []byte(tt.src)); !errors.Is(err, tt.err) {
+			t.Errorf("ReadHeader(%s, %q) error = %v, want %v", tt.filename, tt.src, err, tt.err)
+		}
+	}
+	if _, err := ReadHeader("main.go", []byte("package main\n")); ErrorCode(err) != CodeNoHeader {
+		t.Errorf("ErrorCode = %q, want %q", ErrorCode(err), CodeNoHeader)
+	}
+}
+
+func TestReadHeaderRoundTrip(t *testing.T) {
+	for _, filename := range []string{"main.go", "tool.py", "init.lua", "Main.hs", "schema.sql", "style.css", "index.html", "Dockerfile", "CMakeLists.txt"} {
+		out, err := InsertHeader(filename, []byte("x\n"), "MIT OR Apache-2.0")
+		if err != nil {
+			t.Fatalf("InsertHeader(%s): %v", filename, err)
+		}
+		h, err := ReadHeader(filename, out)
+		if err != nil || h.Expression.String() != "MIT OR Apache-2.0" {
+			t.Errorf("ReadHeader(%s, %q) = %v, %v", filename, out, h, err)
+		}
+	}
+}
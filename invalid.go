@@ -0,0 +1,215 @@
+package spdx
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Reason explains why an expression is not valid SPDX, in words meant for
+// the person who wrote it, such as a package publisher whose upload was
+// rejected.
+type Reason struct {
+	Code       Code   // diagnostic code, such as CodeUnbalancedParens
+	Position   int    // 1-based byte position of the problem, or 0 if unknown
+	Token      string // the offending token, if any
+	Message    string // plain-language explanation
+	Suggestion string // a valid expression the input probably meant, if any
+}
+
+func (r Reason) String() string {
+	return r.Message
+}
+
+// InvalidReason reports why expression is not a valid SPDX expression, and
+// false if it is valid. It checks the same rules as Valid, and the Message
+// names the first problem and where it is: an unknown identifier, an
+// unbalanced parenthesis, an operator with nothing on one side. When the
+// problem is an identifier that Parse can normalize, the Suggestion holds
+// the repaired expression.
+//
+// Example:
+//
+//	r, _ := InvalidReason("(MIT OR Apache-2.0")
+//	r.Code     // CodeUnbalancedParens
+//	r.Message  // `The "(" at position 1 is never closed.`
+//
+//	r, _ = InvalidReason("MIT OR Apache2")
+//	r.Message  // `Unknown license identifier "Apache2" at position 8. Did you mean "MIT OR Apache-2.0"?`
+//
+//	InvalidReason("MIT OR Apache-2.0")  // Reason{}, false
+func InvalidReason(expression string) (Reason, bool) {
+	cfg := loadConfig()
+	_, err := parseStrict(expression, cfg)
+	if err == nil {
+		return Reason{}, false
+	}
+
+	r := explainInvalid(expression, err, cfg)
+	if r.Code == CodeInvalidLicenseID || r.Code == CodeInvalidException {
+		if r.Suggestion = suggestExpression(expression, cfg); r.Suggestion != "" {
+			r.Message += fmt.Sprintf(" Did you mean %q?", r.Suggestion)
+		}
+	}
+	return r, true
+}
+
+// suggestExpression returns the expression Parse makes of s, or empty if
+// Parse fails, leaves s unchanged, or only gets there by the substring
+// guesses of the last resort stage, which are too loose to offer as a
+// correction.
+func suggestExpression(s string, cfg *config) string {
+	rec := normalizeRecord(s, cfg)
+	if rec.Output == "" || rec.Output == s || rec.Confidence < ConfidenceTransposition {
+		return ""
+	}
+	return rec.Output
+}
+
+// posToken is a lexer token with its byte offset in the input.
+type posToken struct {
+	token
+	offset int
+}
+
+// scanTokens tokenizes s, recording where each token starts. The last
+// token is always tokenEOF, at the end of s.
+func scanTokens(s string) ([]posToken, error) {
+	l := newLexer(s)
+	var toks []posToken
+	for {
+		tok, err := l.next()
+		if err != nil {
+			return toks, err
+		}
+		// Keywords are upper-cased, so their value is as long as the input
+		toks = append(toks, posToken{tok, l.pos - len(tok.value)})
+		if tok.typ == tokenEOF {
+			return toks, nil
+		}
+	}
+}
+
+// explainInvalid walks the tokens of an expression that failed to parse
+// with err and describes the first thing wrong with it.
+func explainInvalid(expression string, err error, cfg *config) Reason {
+	if strings.TrimSpace(expression) == "" {
+		return Reason{Code: CodeEmptyExpression, Message: "The license expression is empty."}
+	}
+
+	reason := func(code Code, t posToken, format string, args ...any) Reason {
+		return Reason{Code: code, Position: t.offset + 1, Token: t.value, Message: fmt.Sprintf(format, args...)}
+	}
+
+	toks, lexErr := scanTokens(expression)
+	if lexErr == nil {
+		reg := cfg.registry()
+		var open []posToken   // unclosed "(" tokens
+		var prev posToken     // token before the current one
+		expectOperand := true // an operand must come next
+		afterLicense := false // the last operand was a plain license, which WITH and + can follow
+
+		for i := 0; i < len(toks); i++ {
+			t := toks[i]
+			if expectOperand {
+				switch t.typ {
+				case tokenLicense:
+					upper := strings.ToUpper(t.value)
+					if upper != "NONE" && upper != "NOASSERTION" && reg.lookupLicense(t.value) == "" {
+						if reg.lookupException(t.value) != "" {
+							return reason(CodeInvalidLicenseID, t, "%q at position %d is a license exception, not a license; it belongs after WITH, as in \"GPL-2.0-only WITH %s\".", t.value, t.offset+1, t.value)
+						}
+						return reason(CodeInvalidLicenseID, t, "Unknown license identifier %q at position %d.", t.value, t.offset+1)
+					}
+					expectOperand, afterLicense = false, upper != "NONE" && upper != "NOASSERTION"
+				case tokenLicenseRef, tokenDocumentRef:
+					expectOperand, afterLicense = false, false
+				case tokenOpenParen:
+					open = append(open, t)
+				case tokenCloseParen:
+					if prev.typ == tokenOpenParen && i > 0 {
+						return reason(CodeMissingOperand, prev, "The parentheses at position %d are empty.", prev.offset+1)
+					}
+					if i > 0 {
+						return reason(CodeMissingOperand, prev, "%s at position %d has no license after it.", prev.value, prev.offset+1)
+					}
+					return reason(CodeUnbalancedParens, t, "The \")\" at position %d has no matching \"(\".", t.offset+1)
+				case tokenAnd, tokenOr:
+					if i == 0 || prev.typ == tokenOpenParen {
+						return reason(CodeMissingOperand, t, "%s at position %d has no license before it.", t.value, t.offset+1)
+					}
+					return reason(CodeMissingOperand, prev, "%s at position %d is followed by %s with no license between them.", prev.value, prev.offset+1, t.value)
+				case tokenWith:
+					return reason(CodeUnexpectedToken, t, "WITH at position %d must follow a license, as in \"GPL-2.0-only WITH Classpath-exception-2.0\".", t.offset+1)
+				case tokenPlus:
+					return reason(CodeUnexpectedToken, t, "The \"+\" at position %d must directly follow a license identifier, as in \"GPL-2.0+\".", t.offset+1)
+				case tokenEOF:
+					if prev.typ == tokenOpenParen {
+						return reason(CodeUnbalancedParens, prev, "The \"(\" at position %d is never closed.", prev.offset+1)
+					}
+					return reason(CodeMissingOperand, prev, "The expression ends with %s at position %d, which needs a license after it.", prev.value, prev.offset+1)
+				}
+				prev = t
+				continue
+			}
+
+			switch t.typ {
+			case tokenAnd, tokenOr:
+				expectOperand = true
+			case tokenWith:
+				if !afterLicense {
+					return reason(CodeUnexpectedToken, t, "WITH at position %d can only follow a single license, not a LicenseRef, a parenthesized expression or another exception.", t.offset+1)
+				}
+				next := toks[i+1]
+				if next.typ == tokenLicenseRef {
+					afterLicense = false
+					t = next
+					i++
+					break
+				}
+				if next.typ != tokenLicense {
+					return reason(CodeMissingOperand, t, "WITH at position %d needs a license exception after it, such as Classpath-exception-2.0.", t.offset+1)
+				}
+				if reg.lookupException(next.value) == "" {
+					if reg.lookupLicense(next.value) != "" {
+						return reason(CodeInvalidException, next, "%q at position %d is a license, not a license exception; combine licenses with AND or OR instead of WITH.", next.value, next.offset+1)
+					}
+					return reason(CodeInvalidException, next, "Unknown license exception %q at position %d.", next.value, next.offset+1)
+				}
+				afterLicense = false
+				t = next
+				i++
+			case tokenPlus:
+				if !afterLicense || prev.typ == tokenPlus {
+					return reason(CodeUnexpectedToken, t, "The \"+\" at position %d must directly follow a license identifier, as in \"GPL-2.0+\".", t.offset+1)
+				}
+			case tokenCloseParen:
+				if len(open) == 0 {
+					return reason(CodeUnbalancedParens, t, "The \")\" at position %d has no matching \"(\".", t.offset+1)
+				}
+				open = open[:len(open)-1]
+				afterLicense = false
+			case tokenEOF:
+				if len(open) > 0 {
+					first := open[len(open)-1]
+					return reason(CodeUnbalancedParens, first, "The \"(\" at position %d is never closed.", first.offset+1)
+				}
+			default:
+				return reason(CodeUnexpectedToken, t, "%q at position %d needs AND or OR between it and %q before it.", t.value, t.offset+1, prev.value)
+			}
+			prev = t
+		}
+	}
+
+	if errors.Is(err, ErrNoAssertion) {
+		r := Reason{Code: CodeNoAssertion, Token: "NOASSERTION", Message: "NOASSERTION is not accepted here; name the license, or use NONE if there is none."}
+		for _, t := range toks {
+			if strings.EqualFold(t.value, "NOASSERTION") {
+				r.Position = t.offset + 1
+				break
+			}
+		}
+		return r
+	}
+	return Reason{Code: ErrorCode(err), Message: err.Error()}
+}
@@ -0,0 +1,93 @@
+package spdx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInvalidReason(t *testing.T) {
+	tests := []struct {
+		input    string
+		code     Code
+		position int
+		token    string
+		message  string
+	}{
+		{"", CodeEmptyExpression, 0, "", "The license expression is empty."},
+		{"  ", CodeEmptyExpression, 0, "", "The license expression is empty."},
+		{"(MIT OR Apache-2.0", CodeUnbalancedParens, 1, "(", `The "(" at position 1 is never closed.`},
+		{"MIT OR Apache-2.0)", CodeUnbalancedParens, 18, ")", `The ")" at position 18 has no matching "(".`},
+		{"MIT AND (BSD-3-Clause OR (ISC)", CodeUnbalancedParens, 9, "(", `The "(" at position 9 is never closed.`},
+		{"MIT (", CodeUnexpectedToken, 5, "(", `"(" at position 5 needs AND or OR between it and "MIT" before it.`},
+		{"MIT ()", CodeUnexpectedToken, 5, "(", ""},
+		{"MIT AND ()", CodeMissingOperand, 9, "(", "The parentheses at position 9 are empty."},
+		{"FAKEYLICENSE", CodeInvalidLicenseID, 1, "FAKEYLICENSE", `Unknown license identifier "FAKEYLICENSE" at position 1.`},
+		{"MIT OR Apache2", CodeInvalidLicenseID, 8, "Apache2", `Unknown license identifier "Apache2" at position 8. Did you mean "MIT OR Apache-2.0"?`},
+		{"Classpath-exception-2.0", CodeInvalidLicenseID, 1, "Classpath-exception-2.0", ""},
+		{"MIT OR", CodeMissingOperand, 5, "OR", "The expression ends with OR at position 5, which needs a license after it."},
+		{"AND MIT", CodeMissingOperand, 1, "AND", "AND at position 1 has no license before it."},
+		{"(OR MIT)", CodeMissingOperand, 2, "OR", ""},
+		{"MIT AND OR ISC", CodeMissingOperand, 5, "AND", "AND at position 5 is followed by OR with no license between them."},
+		{"(MIT OR)", CodeMissingOperand, 6, "OR", "OR at position 6 has no license after it."},
+		{"MIT ISC", CodeUnexpectedToken, 5, "ISC", `"ISC" at position 5 needs AND or OR between it and "MIT" before it.`},
+		{"GPL-2.0-only WITH", CodeMissingOperand, 14, "WITH", ""},
+		{"GPL-2.0-only WITH Fake-exception", CodeInvalidException, 19, "Fake-exception", `Unknown license exception "Fake-exception" at position 19.`},
+		{"GPL-2.0-only WITH MIT", CodeInvalidException, 19, "MIT", ""},
+		{"(MIT OR ISC) WITH Classpath-exception-2.0", CodeUnexpectedToken, 14, "WITH", ""},
+		{"LicenseRef-foo WITH Classpath-exception-2.0", CodeUnexpectedToken, 16, "WITH", ""},
+		{"WITH MIT", CodeUnexpectedToken, 1, "WITH", ""},
+		{"+ MIT", CodeUnexpectedToken, 1, "+", ""},
+		{"(MIT)+", CodeUnexpectedToken, 6, "+", ""},
+	}
+
+	for _, tt := range tests {
+		r, invalid := InvalidReason(tt.input)
+		if !invalid {
+			t.Errorf("InvalidReason(%q) reported a valid expression", tt.input)
+			continue
+		}
+		if r.Code != tt.code || r.Position != tt.position || r.Token != tt.token {
+			t.Errorf("InvalidReason(%q) = %s at %d (%q), want %s at %d (%q): %s", tt.input, r.Code, r.Position, r.Token, tt.code, tt.position, tt.token, r.Message)
+		}
+		if tt.message != "" && r.Message != tt.message {
+			t.Errorf("InvalidReason(%q).Message = %q, want %q", tt.input, r.Message, tt.message)
+		}
+		if Valid(tt.input) {
+			t.Errorf("Valid(%q) = true, but InvalidReason explained it", tt.input)
+		}
+	}
+}
+
+func TestInvalidReasonValid(t *testing.T) {
+	for _, input := range []string{
+		"MIT",
+		" MIT OR Apache-2.0 ",
+		"GPL-2.0+ WITH Classpath-exception-2.0",
+		"(MIT AND ISC) OR LicenseRef-foo",
+		"DocumentRef-x:LicenseRef-y",
+		"NOASSERTION",
+		"mit and gpl-2.0",
+	} {
+		if r, invalid := InvalidReason(input); invalid {
+			t.Errorf("InvalidReason(%q) = %q, want valid", input, r.Message)
+		}
+	}
+}
+
+func TestInvalidReasonSuggestion(t *testing.T) {
+	r, _ := InvalidReason("Apache 2 OR MIT License")
+	if r.Suggestion != "Apache-2.0 OR MIT" || !strings.HasSuffix(r.Message, `Did you mean "Apache-2.0 OR MIT"?`) {
+		t.Errorf("InvalidReason suggestion = %q, message %q", r.Suggestion, r.Message)
+	}
+	if r, _ := InvalidReason("(MIT"); r.Suggestion != "" && r.Suggestion != "MIT" {
+		t.Errorf("InvalidReason((MIT) suggestion = %q", r.Suggestion)
+	}
+}
+
+func TestInvalidReasonNoAssertion(t *testing.T) {
+	withOptions(t, Options{NoAssertion: NoAssertionFail})
+	r, invalid := InvalidReason("MIT OR NOASSERTION")
+	if !invalid || r.Code != CodeNoAssertion || r.Position != 8 {
+		t.Errorf("InvalidReason(MIT OR NOASSERTION) = %+v, %v", r, invalid)
+	}
+}
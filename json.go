@@ -0,0 +1,205 @@
+package spdx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidJSON is returned by UnmarshalExpression and the UnmarshalJSON
+// methods of the node types for JSON that does not describe an expression
+// tree, or a node of the wrong type.
+var ErrInvalidJSON = errors.New("invalid expression JSON")
+
+// Node types in the JSON form of an expression.
+const (
+	jsonLicense    = "license"
+	jsonLicenseRef = "license-ref"
+	jsonAnd        = "and"
+	jsonOr         = "or"
+	jsonSpecial    = "special"
+)
+
+// jsonNode is the JSON form of one node of an expression tree. Only the
+// fields of the node's type are set.
+type jsonNode struct {
+	Type        string            `json:"type"`
+	ID          string            `json:"id,omitempty"`           // license
+	Plus        bool              `json:"plus,omitempty"`         // license
+	Exception   string            `json:"exception,omitempty"`    // license
+	DocumentRef string            `json:"document_ref,omitempty"` // license-ref
+	LicenseRef  string            `json:"license_ref,omitempty"`  // license-ref
+	Value       string            `json:"value,omitempty"`        // special
+	Operands    []json.RawMessage `json:"operands,omitempty"`     // and, or
+	Annotations Annotations       `json:"annotations,omitempty"`
+}
+
+func (l *License) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonNode{Type: jsonLicense, ID: l.ID, Plus: l.Plus, Exception: l.Exception, Annotations: l.Annotations})
+}
+
+func (l *LicenseRef) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonNode{Type: jsonLicenseRef, DocumentRef: l.DocumentRef, LicenseRef: l.LicenseRef, Annotations: l.Annotations})
+}
+
+func (e *AndExpression) MarshalJSON() ([]byte, error) {
+	return marshalOperator(jsonAnd, e.Left, e.Right, e.Annotations)
+}
+
+func (e *OrExpression) MarshalJSON() ([]byte, error) {
+	return marshalOperator(jsonOr, e.Left, e.Right, e.Annotations)
+}
+
+func (s *SpecialValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonNode{Type: jsonSpecial, Value: s.Value, Annotations: s.Annotations})
+}
+
+// marshalOperator marshals an AND or OR node.
+func marshalOperator(typ string, left, right Expression, annotations Annotations) ([]byte, error) {
+	node := jsonNode{Type: typ, Annotations: annotations}
+	for _, op := range []Expression{left, right} {
+		b, err := json.Marshal(op)
+		if err != nil {
+			return nil, err
+		}
+		node.Operands = append(node.Operands, b)
+	}
+	return json.Marshal(node)
+}
+
+// MarshalExpression returns the JSON form of expr: a tree of nodes with a
+// "type" of "license", "license-ref", "and", "or" or "special", their
+// fields, and any Annotations. Unlike the string form, it keeps
+// annotations.
+//
+// Example:
+//
+//	expr, _ := Parse("MIT OR Apache-2.0")
+//	Annotate(expr, "ticket", "LEGAL-42")
+//	b, _ := MarshalExpression(expr)
+//	// {"type":"or","operands":[{"type":"license","id":"MIT"},
+//	//  {"type":"license","id":"Apache-2.0"}],"annotations":{"ticket":"LEGAL-42"}}
+func MarshalExpression(expr Expression) ([]byte, error) {
+	return json.Marshal(expr)
+}
+
+// UnmarshalExpression parses the JSON form from MarshalExpression back
+// into a tree. License and exception identifiers are checked against the
+// license data and canonicalized as ParseStrict does. An "and" or "or"
+// node with more than two operands is read as a chain.
+func UnmarshalExpression(data []byte) (Expression, error) {
+	return unmarshalNode(data, loadConfig().registry())
+}
+
+// UnmarshalJSON reads the JSON form of a "license" node, with the checks
+// of UnmarshalExpression. Use UnmarshalExpression for a tree whose root
+// type isn't known, such as an Expression field.
+func (l *License) UnmarshalJSON(data []byte) error {
+	return unmarshalInto(data, jsonLicense, l)
+}
+
+// UnmarshalJSON reads the JSON form of a "license-ref" node.
+func (l *LicenseRef) UnmarshalJSON(data []byte) error {
+	return unmarshalInto(data, jsonLicenseRef, l)
+}
+
+// UnmarshalJSON reads the JSON form of an "and" node and its operands.
+func (e *AndExpression) UnmarshalJSON(data []byte) error {
+	return unmarshalInto(data, jsonAnd, e)
+}
+
+// UnmarshalJSON reads the JSON form of an "or" node and its operands.
+func (e *OrExpression) UnmarshalJSON(data []byte) error {
+	return unmarshalInto(data, jsonOr, e)
+}
+
+// UnmarshalJSON reads the JSON form of a "special" node.
+func (s *SpecialValue) UnmarshalJSON(data []byte) error {
+	return unmarshalInto(data, jsonSpecial, s)
+}
+
+// unmarshalInto decodes a node that must have type typ and stores it in
+// dst, a pointer to the node struct of that type.
+func unmarshalInto[T any](data []byte, typ string, dst *T) error {
+	var head struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+	if head.Type != typ {
+		return fmt.Errorf("%w: node type %q, want %q", ErrInvalidJSON, head.Type, typ)
+	}
+	expr, err := unmarshalNode(data, loadConfig().registry())
+	if err != nil {
+		return err
+	}
+	*dst = *any(expr).(*T)
+	return nil
+}
+
+// unmarshalNode decodes one node and its operands.
+func unmarshalNode(data []byte, reg *registry) (Expression, error) {
+	var node jsonNode
+	if err := json.Unmarshal(data, &node); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidJSON, err)
+	}
+
+	switch node.Type {
+	case jsonLicense:
+		id := reg.lookupLicense(node.ID)
+		if id == "" {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidLicenseID, node.ID)
+		}
+		l := &License{ID: id, Plus: node.Plus, Annotations: node.Annotations}
+		if isAdditionRef(node.Exception) {
+			l.Exception = node.Exception
+		} else if node.Exception != "" {
+			if l.Exception = reg.lookupException(node.Exception); l.Exception == "" {
+				return nil, fmt.Errorf("%w: %s", ErrInvalidException, node.Exception)
+			}
+		}
+		return l, nil
+
+	case jsonLicenseRef:
+		if node.LicenseRef == "" {
+			return nil, fmt.Errorf("%w: license-ref without license_ref", ErrInvalidJSON)
+		}
+		return &LicenseRef{DocumentRef: node.DocumentRef, LicenseRef: node.LicenseRef, Annotations: node.Annotations}, nil
+
+	case jsonSpecial:
+		value := strings.ToUpper(node.Value)
+		if value != "NONE" && value != "NOASSERTION" {
+			return nil, fmt.Errorf("%w: special value %q", ErrInvalidJSON, node.Value)
+		}
+		return &SpecialValue{Value: value, Annotations: node.Annotations}, nil
+
+	case jsonAnd, jsonOr:
+		if len(node.Operands) < 2 {
+			return nil, fmt.Errorf("%w: %s with %d operands", ErrMissingOperand, node.Type, len(node.Operands))
+		}
+		ops := make([]Expression, len(node.Operands))
+		for i, raw := range node.Operands {
+			op, err := unmarshalNode(raw, reg)
+			if err != nil {
+				return nil, err
+			}
+			ops[i] = op
+		}
+		var expr Expression
+		if node.Type == jsonAnd {
+			and := joinAnd(ops).(*AndExpression)
+			and.Annotations = node.Annotations
+			expr = and
+		} else {
+			or := joinOr(ops).(*OrExpression)
+			or.Annotations = node.Annotations
+			expr = or
+		}
+		return expr, nil
+
+	default:
+		return nil, fmt.Errorf("%w: unknown node type %q", ErrInvalidJSON, node.Type)
+	}
+}
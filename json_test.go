@@ -0,0 +1,164 @@
+package spdx
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestMarshalExpression(t *testing.T) {
+	expr, err := Parse("MIT OR Apache-2.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	Annotate(expr, "ticket", "LEGAL-42")
+
+	b, err := MarshalExpression(expr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"type":"or","operands":[{"type":"license","id":"MIT"},{"type":"license","id":"Apache-2.0"}],"annotations":{"ticket":"LEGAL-42"}}`
+	if string(b) != want {
+		t.Errorf("MarshalExpression = %s\nwant %s", b, want)
+	}
+}
+
+func TestExpressionJSONRoundTrip(t *testing.T) {
+	for _, input := range []string{
+		"MIT",
+		"GPL-2.0+",
+		"GPL-2.0-only WITH Classpath-exception-2.0",
+		"MIT OR Apache-2.0 AND ISC",
+		"(MIT OR ISC) AND (Apache-2.0 OR BSD-3-Clause)",
+		"LicenseRef-Foo OR DocumentRef-doc:LicenseRef-Bar",
+		"NONE",
+		"NOASSERTION",
+	} {
+		expr, err := ParseStrict(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		Annotate(expr, "note", "root of "+input)
+
+		b, err := json.Marshal(expr)
+		if err != nil {
+			t.Fatalf("json.Marshal(%q): %v", input, err)
+		}
+		back, err := UnmarshalExpression(b)
+		if err != nil {
+			t.Fatalf("UnmarshalExpression(%s): %v", b, err)
+		}
+		if back.String() != expr.String() {
+			t.Errorf("round trip of %q = %q", input, back.String())
+		}
+		if got := AnnotationsOf(back)["note"]; got != "root of "+input {
+			t.Errorf("round trip of %q lost annotations: %v", input, AnnotationsOf(back))
+		}
+	}
+}
+
+func TestUnmarshalExpression(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+		err   error
+	}{
+		{`{"type":"license","id":"mit"}`, "MIT", nil},
+		{`{"type":"and","operands":[{"type":"license","id":"MIT"},{"type":"license","id":"ISC"},{"type":"license","id":"0BSD"}]}`, "MIT AND ISC AND 0BSD", nil},
+		{`{"type":"special","value":"none"}`, "NONE", nil},
+		{`{"type":"license","id":"FAKEYLICENSE"}`, "", ErrInvalidLicenseID},
+		{`{"type":"license","id":"MIT","exception":"Fake-exception"}`, "", ErrInvalidException},
+		{`{"type":"or","operands":[{"type":"license","id":"MIT"}]}`, "", ErrMissingOperand},
+		{`{"type":"xor"}`, "", ErrInvalidJSON},
+		{`{"type":"license-ref"}`, "", ErrInvalidJSON},
+		{`{"type":"special","value":"ALL"}`, "", ErrInvalidJSON},
+		{`[1, 2]`, "", ErrInvalidJSON},
+	}
+
+	for _, tt := range tests {
+		expr, err := UnmarshalExpression([]byte(tt.input))
+		if tt.err != nil {
+			if !errors.Is(err, tt.err) {
+				t.Errorf("UnmarshalExpression(%s) error = %v, want %v", tt.input, err, tt.err)
+			}
+			continue
+		}
+		if err != nil || expr.String() != tt.want {
+			t.Errorf("UnmarshalExpression(%s) = %v, %v; want %s", tt.input, expr, err, tt.want)
+		}
+	}
+	if _, err := UnmarshalExpression([]byte(`{"type":"xor"}`)); ErrorCode(err) != CodeInvalidJSON {
+		t.Errorf("ErrorCode = %q, want %q", ErrorCode(err), CodeInvalidJSON)
+	}
+}
+
+func TestNodeUnmarshalJSON(t *testing.T) {
+	var doc struct {
+		Declared  *OrExpression `json:"declared"`
+		Concluded *License      `json:"concluded"`
+		Refs      []*LicenseRef `json:"refs"`
+		Missing   *SpecialValue `json:"missing"`
+		Combined  AndExpression `json:"combined"`
+	}
+	input := `{
+		"declared": {"type":"or","operands":[{"type":"license","id":"mit"},{"type":"license","id":"Apache-2.0"}],"annotations":{"source":"package.json"}},
+		"concluded": {"type":"license","id":"GPL-2.0-only","exception":"classpath-exception-2.0"},
+		"refs": [{"type":"license-ref","license_ref":"Internal"},{"type":"license-ref","document_ref":"doc","license_ref":"Other"}],
+		"missing": {"type":"special","value":"NOASSERTION"},
+		"combined": {"type":"and","operands":[{"type":"license","id":"MIT"},{"type":"license","id":"ISC"},{"type":"license","id":"0BSD"}]}
+	}`
+	if err := json.Unmarshal([]byte(input), &doc); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+
+	for _, tt := range []struct {
+		got  Expression
+		want string
+	}{
+		{doc.Declared, "MIT OR Apache-2.0"},
+		{doc.Concluded, "GPL-2.0-only WITH Classpath-exception-2.0"},
+		{doc.Refs[0], "LicenseRef-Internal"},
+		{doc.Refs[1], "DocumentRef-doc:LicenseRef-Other"},
+		{doc.Missing, "NOASSERTION"},
+		{&doc.Combined, "MIT AND ISC AND 0BSD"},
+	} {
+		if tt.got.String() != tt.want {
+			t.Errorf("got %q, want %q", tt.got.String(), tt.want)
+		}
+	}
+	if doc.Declared.Annotations["source"] != "package.json" {
+		t.Errorf("annotations = %v", doc.Declared.Annotations)
+	}
+
+	// The marshaled form reads back into the same type
+	b, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+	var back struct {
+		Declared *OrExpression `json:"declared"`
+	}
+	if err := json.Unmarshal(b, &back); err != nil || back.Declared.String() != "MIT OR Apache-2.0" {
+		t.Errorf("round trip = %v, %v", back.Declared, err)
+	}
+}
+
+func TestNodeUnmarshalJSONErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		dst   any
+		err   error
+	}{
+		{`{"type":"or","operands":[{"type":"license","id":"MIT"},{"type":"license","id":"ISC"}]}`, new(License), ErrInvalidJSON},
+		{`{"type":"license","id":"MIT"}`, new(AndExpression), ErrInvalidJSON},
+		{`{"type":"license","id":"FAKEYLICENSE"}`, new(License), ErrInvalidLicenseID},
+		{`{"type":"and","operands":[{"type":"license","id":"MIT"}]}`, new(AndExpression), ErrMissingOperand},
+		{`{"type":"special","value":"ALL"}`, new(SpecialValue), ErrInvalidJSON},
+		{`{"type":"license-ref"}`, new(LicenseRef), ErrInvalidJSON},
+	}
+	for _, tt := range tests {
+		if err := json.Unmarshal([]byte(tt.input), tt.dst); !errors.Is(err, tt.err) {
+			t.Errorf("json.Unmarshal(%s) into %T error = %v, want %v", tt.input, tt.dst, err, tt.err)
+		}
+	}
+}
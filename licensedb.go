@@ -0,0 +1,144 @@
+package spdx
+
+import (
+	"iter"
+	"slices"
+	"strings"
+)
+
+// LicensedbEntry is one entry of the scancode license database, as
+// embedded.
+type LicensedbEntry struct {
+	Key           string   // scancode license key
+	SPDXKey       string   // primary SPDX identifier, or a LicenseRef
+	OtherSPDXKeys []string // other SPDX identifiers for the same license
+	Category      Category
+	IsException   bool
+	IsDeprecated  bool
+}
+
+// LicensedbView is a read-only, filterable view of the scancode license
+// database. Filtering returns a new view and leaves the receiver as it
+// was.
+type LicensedbView struct {
+	entries []licenseEntry
+}
+
+// Licensedb returns a view of every entry in the scancode license
+// database the package is using, sorted by key. It reflects the data at
+// the time of the call; Reload does not change an existing view.
+//
+// Example:
+//
+//	db := Licensedb()
+//	for e := range db.Exceptions().Deprecated().All() {
+//		fmt.Println(e.Key, e.SPDXKey)
+//	}
+//	db.InCategory(CategoryCopyleft).Len()
+func Licensedb() *LicensedbView {
+	return &LicensedbView{entries: loadConfig().registry().entries()}
+}
+
+// All iterates over the entries in the view.
+func (v *LicensedbView) All() iter.Seq[LicensedbEntry] {
+	return func(yield func(LicensedbEntry) bool) {
+		for _, e := range v.entries {
+			if !yield(newLicensedbEntry(e)) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns the number of entries in the view.
+func (v *LicensedbView) Len() int {
+	return len(v.entries)
+}
+
+// Lookup returns the entry whose scancode key or SPDX identifier,
+// including the other SPDX identifiers, is key, ignoring case.
+func (v *LicensedbView) Lookup(key string) (LicensedbEntry, bool) {
+	for _, e := range v.entries {
+		if strings.EqualFold(e.LicenseKey, key) || strings.EqualFold(e.SPDXLicenseKey, key) {
+			return newLicensedbEntry(e), true
+		}
+	}
+	for _, e := range v.entries {
+		if slices.ContainsFunc(e.OtherSPDXKeys, func(k string) bool { return strings.EqualFold(k, key) }) {
+			return newLicensedbEntry(e), true
+		}
+	}
+	return LicensedbEntry{}, false
+}
+
+// Filter returns the entries for which keep returns true.
+func (v *LicensedbView) Filter(keep func(LicensedbEntry) bool) *LicensedbView {
+	var entries []licenseEntry
+	for _, e := range v.entries {
+		if keep(newLicensedbEntry(e)) {
+			entries = append(entries, e)
+		}
+	}
+	return &LicensedbView{entries: entries}
+}
+
+// Licenses returns the entries that are not exceptions.
+func (v *LicensedbView) Licenses() *LicensedbView {
+	return v.Filter(func(e LicensedbEntry) bool { return !e.IsException })
+}
+
+// Exceptions returns the license exceptions.
+func (v *LicensedbView) Exceptions() *LicensedbView {
+	return v.Filter(func(e LicensedbEntry) bool { return e.IsException })
+}
+
+// Deprecated returns the deprecated entries.
+func (v *LicensedbView) Deprecated() *LicensedbView {
+	return v.Filter(func(e LicensedbEntry) bool { return e.IsDeprecated })
+}
+
+// InCategory returns the entries in any of the given categories.
+func (v *LicensedbView) InCategory(categories ...Category) *LicensedbView {
+	return v.Filter(func(e LicensedbEntry) bool { return slices.Contains(categories, e.Category) })
+}
+
+// Keys returns the scancode keys in the view.
+func (v *LicensedbView) Keys() []string {
+	keys := make([]string, len(v.entries))
+	for i, e := range v.entries {
+		keys[i] = e.LicenseKey
+	}
+	return keys
+}
+
+// Categories returns the distinct categories in the view, sorted.
+func (v *LicensedbView) Categories() []Category {
+	var cats []Category
+	for _, e := range v.entries {
+		if cat := entryCategory(e); !slices.Contains(cats, cat) {
+			cats = append(cats, cat)
+		}
+	}
+	slices.Sort(cats)
+	return cats
+}
+
+func newLicensedbEntry(e licenseEntry) LicensedbEntry {
+	return LicensedbEntry{
+		Key:           e.LicenseKey,
+		SPDXKey:       e.SPDXLicenseKey,
+		OtherSPDXKeys: slices.Clone(e.OtherSPDXKeys),
+		Category:      entryCategory(e),
+		IsException:   e.IsException,
+		IsDeprecated:  e.IsDeprecated,
+	}
+}
+
+// entryCategory returns the category of e, as the category index
+// records it.
+func entryCategory(e licenseEntry) Category {
+	if e.Category == "" {
+		return CategoryUnknown
+	}
+	return Category(e.Category)
+}
@@ -0,0 +1,84 @@
+package spdx
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLicensedb(t *testing.T) {
+	db := Licensedb()
+	if db.Len() < 1000 {
+		t.Fatalf("Len() = %d, want the full scancode database", db.Len())
+	}
+	if keys := db.Keys(); !slices.IsSorted(keys) {
+		t.Error("Keys() should be sorted")
+	}
+
+	e, ok := db.Lookup("mit")
+	if !ok || e.SPDXKey != "MIT" || e.Category != CategoryPermissive || e.IsException {
+		t.Errorf("Lookup(mit) = %+v, %v", e, ok)
+	}
+	if e, ok := db.Lookup("GPL-2.0"); !ok || e.SPDXKey != "GPL-2.0-only" {
+		t.Errorf("Lookup(GPL-2.0) = %+v, %v; want the entry listing it as another SPDX key", e, ok)
+	}
+	if _, ok := db.Lookup("not-a-license"); ok {
+		t.Error("Lookup(not-a-license) should fail")
+	}
+
+	exceptions := db.Exceptions()
+	if exceptions.Len() == 0 || exceptions.Len()+db.Licenses().Len() != db.Len() {
+		t.Errorf("Exceptions() = %d, Licenses() = %d, total %d", exceptions.Len(), db.Licenses().Len(), db.Len())
+	}
+	for e := range exceptions.All() {
+		if !e.IsException {
+			t.Fatalf("Exceptions() includes %s", e.Key)
+		}
+	}
+
+	copyleft := db.Deprecated().InCategory(CategoryCopyleft)
+	if copyleft.Len() == 0 {
+		t.Error("expected deprecated copyleft entries")
+	}
+	if cats := copyleft.Categories(); !slices.Equal(cats, []Category{CategoryCopyleft}) {
+		t.Errorf("Categories() = %v", cats)
+	}
+	if !slices.Contains(db.Categories(), CategoryPublicDomain) {
+		t.Errorf("Categories() = %v, want Public Domain among them", db.Categories())
+	}
+}
+
+func TestLicensedbEntriesAreCopies(t *testing.T) {
+	db := Licensedb()
+	e, _ := db.Lookup("GPL-2.0-only")
+	if len(e.OtherSPDXKeys) == 0 {
+		t.Fatal("GPL-2.0-only should have other SPDX keys")
+	}
+	e.OtherSPDXKeys[0] = "changed"
+	if again, _ := db.Lookup("GPL-2.0-only"); again.OtherSPDXKeys[0] == "changed" {
+		t.Error("changing an entry should not change the database")
+	}
+}
+
+func TestLicensedbFollowsReload(t *testing.T) {
+	before := Licensedb()
+	withLicenseData(t, LicenseData{
+		Licenses: []string{"MIT"},
+		Scancode: []byte(testScancode),
+	})
+
+	if got := Licensedb().Keys(); !slices.Equal(got, []string{"acme", "mit"}) {
+		t.Errorf("Keys() after Reload = %v", got)
+	}
+	if before.Len() == 2 {
+		t.Error("an existing view should keep the data it was created with")
+	}
+
+	stop := 0
+	for range Licensedb().All() {
+		stop++
+		break
+	}
+	if stop != 1 {
+		t.Error("All() should stop when the loop breaks")
+	}
+}
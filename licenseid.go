@@ -0,0 +1,64 @@
+package spdx
+
+// LicenseID is a canonical SPDX license identifier, such as "MIT" or
+// "Apache-2.0". Obtain one from ParseID or NormalizeID rather than by
+// conversion, so that code taking a LicenseID can rely on it being valid
+// and never confuses it with raw user input.
+type LicenseID string
+
+// ParseID validates an SPDX license identifier and returns it in its
+// canonical case. It accepts identifiers only, in any case, including
+// deprecated ones; use NormalizeID for informal names. Invalid input
+// returns a *LicenseError wrapping ErrInvalidLicenseID.
+//
+// Example:
+//
+//	ParseID("apache-2.0")  // "Apache-2.0", nil
+//	ParseID("Apache 2")    // "", ErrInvalidLicenseID
+func ParseID(s string) (LicenseID, error) {
+	id := lookupLicense(s)
+	if id == "" {
+		return "", &LicenseError{License: s, Err: ErrInvalidLicenseID}
+	}
+	return LicenseID(id), nil
+}
+
+// NormalizeID is Normalize returning a LicenseID. Results that are not a
+// single identifier, such as "Apache-2.0+", are rejected with
+// ErrInvalidLicenseID.
+//
+// Example:
+//
+//	NormalizeID("Apache 2")  // "Apache-2.0", nil
+func NormalizeID(s string) (LicenseID, error) {
+	cfg := loadConfig()
+	normalized, err := normalize(s, cfg)
+	if err != nil {
+		return "", err
+	}
+	id := cfg.registry().lookupLicense(normalized)
+	if id == "" {
+		return "", &LicenseError{License: normalized, Err: ErrInvalidLicenseID}
+	}
+	return LicenseID(id), nil
+}
+
+// String returns the identifier.
+func (id LicenseID) String() string {
+	return string(id)
+}
+
+// Category returns the license category, as LicenseCategory does.
+func (id LicenseID) Category() Category {
+	return LicenseCategory(string(id))
+}
+
+// IDStrings converts identifiers to the []string that functions such as
+// Satisfies and Prune take.
+func IDStrings(ids ...LicenseID) []string {
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		out[i] = string(id)
+	}
+	return out
+}
@@ -0,0 +1,71 @@
+package spdx
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestParseID(t *testing.T) {
+	tests := []struct {
+		input string
+		want  LicenseID
+		err   error
+	}{
+		{"MIT", "MIT", nil},
+		{"apache-2.0", "Apache-2.0", nil},
+		{"GPL-2.0", "GPL-2.0", nil},
+		{"Apache 2", "", ErrInvalidLicenseID},
+		{"MIT OR Apache-2.0", "", ErrInvalidLicenseID},
+		{"", "", ErrInvalidLicenseID},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseID(tt.input)
+		if got != tt.want || !errors.Is(err, tt.err) {
+			t.Errorf("ParseID(%q) = %q, %v; want %q, %v", tt.input, got, err, tt.want, tt.err)
+		}
+	}
+
+	var licErr *LicenseError
+	if _, err := ParseID("Apache 2"); !errors.As(err, &licErr) || licErr.License != "Apache 2" {
+		t.Errorf("ParseID error = %v, want a LicenseError naming the input", err)
+	}
+}
+
+func TestNormalizeID(t *testing.T) {
+	tests := []struct {
+		input string
+		want  LicenseID
+		err   error
+	}{
+		{"Apache 2", "Apache-2.0", nil},
+		{"MIT License", "MIT", nil},
+		{"GPL v3", "GPL-3.0-or-later", nil},
+		{"not a license", "", ErrInvalidLicense},
+	}
+
+	for _, tt := range tests {
+		got, err := NormalizeID(tt.input)
+		if got != tt.want || !errors.Is(err, tt.err) {
+			t.Errorf("NormalizeID(%q) = %q, %v; want %q, %v", tt.input, got, err, tt.want, tt.err)
+		}
+	}
+
+	if got, err := NormalizeID("Apache-2.0+"); !errors.Is(err, ErrInvalidLicenseID) {
+		t.Errorf("NormalizeID(Apache-2.0+) = %q, %v; want ErrInvalidLicenseID", got, err)
+	}
+}
+
+func TestLicenseIDMethods(t *testing.T) {
+	id, err := ParseID("mit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id.String() != "MIT" || id.Category() != CategoryPermissive {
+		t.Errorf("id = %q, category %q", id, id.Category())
+	}
+	if got := IDStrings(id, "Apache-2.0"); !slices.Equal(got, []string{"MIT", "Apache-2.0"}) {
+		t.Errorf("IDStrings() = %v", got)
+	}
+}
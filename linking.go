@@ -0,0 +1,209 @@
+package spdx
+
+import "strings"
+
+// LinkType describes how a program is combined with a library.
+type LinkType string
+
+const (
+	LinkStatic  LinkType = "static"
+	LinkDynamic LinkType = "dynamic"
+)
+
+// Obligation describes what linking against a license requires of the
+// combined work, ordered from least to most restrictive.
+type Obligation string
+
+const (
+	// ObligationNone means linking places no source obligations on the
+	// rest of the program.
+	ObligationNone Obligation = "none"
+	// ObligationFile means only changes to the licensed files themselves
+	// must be shared (MPL-2.0, EPL-2.0, CDDL).
+	ObligationFile Obligation = "file"
+	// ObligationRelink means users must be able to relink the program
+	// against a modified copy of the library, typically by shipping object
+	// files (LGPL with static linking).
+	ObligationRelink Obligation = "relink"
+	// ObligationCopyleft means the combined work must be released under
+	// the license (GPL).
+	ObligationCopyleft Obligation = "copyleft"
+	// ObligationUnknown means no linking guidance is known for the license.
+	ObligationUnknown Obligation = "unknown"
+)
+
+func (o Obligation) rank() int {
+	switch o {
+	case ObligationNone:
+		return 0
+	case ObligationFile:
+		return 1
+	case ObligationRelink:
+		return 2
+	case ObligationCopyleft:
+		return 3
+	default:
+		return 4
+	}
+}
+
+// LinkingObligation is the linking guidance for one license in an expression.
+type LinkingObligation struct {
+	License    string     // license, including any WITH exception
+	Obligation Obligation // what linking requires of the combined work
+	Note       string     // short explanation
+}
+
+// linkingRule holds the obligations for each link type.
+type linkingRule struct {
+	static  Obligation
+	dynamic Obligation
+	note    string
+}
+
+// linkingData is keyed by lowercase SPDX identifier without -only/-or-later.
+var linkingData = map[string]linkingRule{
+	"gpl-1.0":  {ObligationCopyleft, ObligationCopyleft, "linked programs are treated as derivative works"},
+	"gpl-2.0":  {ObligationCopyleft, ObligationCopyleft, "linked programs are treated as derivative works"},
+	"gpl-3.0":  {ObligationCopyleft, ObligationCopyleft, "linked programs are treated as derivative works"},
+	"agpl-1.0": {ObligationCopyleft, ObligationCopyleft, "linked programs are treated as derivative works, including network use"},
+	"agpl-3.0": {ObligationCopyleft, ObligationCopyleft, "linked programs are treated as derivative works, including network use"},
+	"lgpl-2.0": {ObligationRelink, ObligationNone, "dynamic linking is allowed; static linking requires allowing users to relink"},
+	"lgpl-2.1": {ObligationRelink, ObligationNone, "dynamic linking is allowed; static linking requires allowing users to relink"},
+	"lgpl-3.0": {ObligationRelink, ObligationNone, "dynamic linking is allowed; static linking requires allowing users to relink"},
+	"mpl-1.1":  {ObligationFile, ObligationFile, "only modified files of the library must be shared"},
+	"mpl-2.0":  {ObligationFile, ObligationFile, "only modified files of the library must be shared"},
+	"epl-1.0":  {ObligationFile, ObligationFile, "only modifications to the library must be shared"},
+	"epl-2.0":  {ObligationFile, ObligationFile, "only modifications to the library must be shared"},
+	"cddl-1.0": {ObligationFile, ObligationFile, "only modified files of the library must be shared"},
+	"cddl-1.1": {ObligationFile, ObligationFile, "only modified files of the library must be shared"},
+}
+
+// linkingExceptions lists exceptions that lift linking obligations from
+// the rest of the program, keyed by lowercase exception identifier.
+// Exceptions that only free generated output, such as the Autoconf and
+// Bison exceptions, or documents embedding a font, leave linking under
+// the license and are not listed.
+var linkingExceptions = map[string]string{
+	"classpath-exception-2.0":          "independent modules may be linked under terms of your choice",
+	"gcc-exception-2.0":                "the runtime library may be linked into programs under any license",
+	"gcc-exception-3.1":                "the runtime library may be linked into programs under any license",
+	"llvm-exception":                   "embedded portions of the library impose no attribution or source obligations",
+	"lgpl-3.0-linking-exception":       "the library may be linked statically without relinking obligations",
+	"ocaml-lgpl-linking-exception":     "the library may be linked statically without relinking obligations",
+	"fltk-exception":                   "the library may be linked statically without relinking obligations",
+	"wxwindows-exception-3.1":          "binary works based on the library may be distributed under your own terms",
+	"universal-foss-exception-1.0":     "the library may be combined with programs under any OSI-approved license",
+	"linux-syscall-note":               "user programs using kernel system calls are not derivative works",
+	"qt-lgpl-exception-1.1":            "programs using the library's headers and inline code are not derivative works",
+	"openjdk-assembly-exception-1.0":   "independent modules may be linked under terms of your choice",
+	"gnu-javamail-exception":           "the library may be linked with independent modules",
+	"ecos-exception-2.0":               "linked programs are not covered by the license",
+	"libtool-exception":                "the libtool runtime may be linked into programs under any license",
+	"gstreamer-exception-2008":         "the library may be linked with non-LGPL plugins",
+	"u-boot-exception-2.0":             "standalone applications using the API are not derivative works",
+	"swift-exception":                  "embedded portions of the runtime impose no attribution obligations",
+	"mif-exception":                    "the library may be linked into programs under any license",
+	"i2p-gpl-java-exception":           "the library may be linked with independent modules",
+	"gpl-3.0-linking-exception":        "the library may be linked with independent modules",
+	"gpl-3.0-linking-source-exception": "the library may be linked with independent modules",
+}
+
+// linkingObligation returns the guidance for a single license node.
+func linkingObligation(lic *License, link LinkType) LinkingObligation {
+	result := LinkingObligation{License: lic.String()}
+
+	if lic.Exception != "" {
+		if note, ok := linkingExceptions[strings.ToLower(lic.Exception)]; ok {
+			result.Obligation = ObligationNone
+			result.Note = note
+			return result
+		}
+	}
+
+	base := strings.ToLower(lic.ID)
+	base = strings.TrimSuffix(base, "-only")
+	base = strings.TrimSuffix(base, "-or-later")
+	if rule, ok := linkingData[base]; ok {
+		result.Obligation = rule.dynamic
+		if link == LinkStatic {
+			result.Obligation = rule.static
+		}
+		result.Note = rule.note
+		return result
+	}
+
+	switch LicenseCategory(lic.ID) {
+	case CategoryPermissive, CategoryPublicDomain:
+		result.Obligation = ObligationNone
+		result.Note = "permissive license"
+	case CategoryCopyleftLimited:
+		result.Obligation = ObligationFile
+		result.Note = "weak copyleft; check the license for linking terms"
+	case CategoryCopyleft:
+		result.Obligation = ObligationCopyleft
+		result.Note = "strong copyleft; check the license for linking terms"
+	default:
+		result.Obligation = ObligationUnknown
+		result.Note = "no linking guidance available"
+	}
+	return result
+}
+
+// linkingObligations evaluates an expression tree. AND requires every
+// operand's obligations; OR picks the least restrictive alternative.
+func linkingObligations(expr Expression, link LinkType) []LinkingObligation {
+	switch e := expr.(type) {
+	case *License:
+		return []LinkingObligation{linkingObligation(e, link)}
+	case *AndExpression:
+		return append(linkingObligations(e.Left, link), linkingObligations(e.Right, link)...)
+	case *OrExpression:
+		left := linkingObligations(e.Left, link)
+		right := linkingObligations(e.Right, link)
+		if MostRestrictive(right).rank() < MostRestrictive(left).rank() {
+			return right
+		}
+		return left
+	default:
+		return []LinkingObligation{{License: expr.String(), Obligation: ObligationUnknown, Note: "no linking guidance available"}}
+	}
+}
+
+// LinkingObligations returns linking guidance for each license that applies
+// when linking against code under the expression. For OR expressions the
+// least restrictive alternative is chosen; for AND expressions every
+// operand applies. WITH exceptions such as Classpath-exception-2.0 are
+// taken into account.
+//
+// The guidance is a summary for build tooling, not legal advice.
+//
+// Example:
+//
+//	LinkingObligations("LGPL-2.1-only", LinkDynamic)
+//	// [{LGPL-2.1-only none ...}]
+//
+//	LinkingObligations("LGPL-2.1-only", LinkStatic)
+//	// [{LGPL-2.1-only relink ...}]
+//
+//	LinkingObligations("GPL-2.0-only WITH Classpath-exception-2.0", LinkStatic)
+//	// [{GPL-2.0-only WITH Classpath-exception-2.0 none ...}]
+func LinkingObligations(expression string, link LinkType) ([]LinkingObligation, error) {
+	expr, err := Parse(expression)
+	if err != nil {
+		return nil, err
+	}
+	return linkingObligations(expr, link), nil
+}
+
+// MostRestrictive returns the most restrictive obligation in the list,
+// or ObligationNone if the list is empty.
+func MostRestrictive(obligations []LinkingObligation) Obligation {
+	most := ObligationNone
+	for _, o := range obligations {
+		if o.Obligation.rank() > most.rank() {
+			most = o.Obligation
+		}
+	}
+	return most
+}
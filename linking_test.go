@@ -0,0 +1,60 @@
+package spdx
+
+import "testing"
+
+func TestLinkingObligations(t *testing.T) {
+	tests := []struct {
+		expr string
+		link LinkType
+		want Obligation
+	}{
+		{"MIT", LinkStatic, ObligationNone},
+		{"LGPL-2.1-only", LinkDynamic, ObligationNone},
+		{"LGPL-2.1-only", LinkStatic, ObligationRelink},
+		{"LGPL-3.0-or-later", LinkStatic, ObligationRelink},
+		{"GPL-2.0-only", LinkDynamic, ObligationCopyleft},
+		{"GPL-3.0-or-later", LinkStatic, ObligationCopyleft},
+		{"GPL-2.0-only WITH Classpath-exception-2.0", LinkStatic, ObligationNone},
+		{"GPL-3.0-only WITH Bison-exception-2.2", LinkDynamic, ObligationCopyleft},
+		{"GPL-3.0-or-later WITH Autoconf-exception-3.0", LinkStatic, ObligationCopyleft},
+		{"GPL-2.0-only WITH Font-exception-2.0", LinkDynamic, ObligationCopyleft},
+		{"MPL-2.0", LinkStatic, ObligationFile},
+		{"MIT OR GPL-3.0-only", LinkStatic, ObligationNone},
+		{"MIT AND LGPL-2.1-only", LinkStatic, ObligationRelink},
+		{"MPL-2.0 OR LGPL-2.1-only", LinkStatic, ObligationFile},
+		{"LicenseRef-custom", LinkStatic, ObligationUnknown},
+		{"NOASSERTION", LinkDynamic, ObligationUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr+"/"+string(tt.link), func(t *testing.T) {
+			obligations, err := LinkingObligations(tt.expr, tt.link)
+			if err != nil {
+				t.Fatalf("LinkingObligations(%q) error: %v", tt.expr, err)
+			}
+			if got := MostRestrictive(obligations); got != tt.want {
+				t.Errorf("LinkingObligations(%q, %s) = %q, want %q", tt.expr, tt.link, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLinkingObligationsDetails(t *testing.T) {
+	obligations, err := LinkingObligations("MIT AND LGPL-2.1-only", LinkStatic)
+	if err != nil {
+		t.Fatalf("LinkingObligations error: %v", err)
+	}
+	if len(obligations) != 2 {
+		t.Fatalf("got %d obligations, want 2", len(obligations))
+	}
+	if obligations[0].License != "MIT" || obligations[1].License != "LGPL-2.1-only" {
+		t.Errorf("unexpected licenses: %+v", obligations)
+	}
+	if obligations[1].Note == "" {
+		t.Error("expected a note for LGPL-2.1-only")
+	}
+
+	if _, err := LinkingObligations("", LinkStatic); err == nil {
+		t.Error("LinkingObligations(\"\") should fail")
+	}
+}
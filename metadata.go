@@ -0,0 +1,248 @@
+package spdx
+
+import "strings"
+
+// licenseTraits holds curated legal metadata that the scancode dataset
+// does not carry. Entries are keyed by lowercase SPDX identifier.
+type licenseTraits struct {
+	governingLaw      string // jurisdiction named in a choice-of-law clause
+	patentGrant       bool   // grants an explicit patent license
+	patentRetaliation bool   // terminates rights for patent litigation
+	trademark         bool   // restricts use of trademarks or project names
+}
+
+var licenseTraitData = map[string]licenseTraits{
+	// French law
+	"cecill-1.0": {governingLaw: "France"},
+	"cecill-1.1": {governingLaw: "France"},
+	"cecill-2.0": {governingLaw: "France"},
+	"cecill-2.1": {governingLaw: "France"},
+	"cecill-b":   {governingLaw: "France"},
+	"cecill-c":   {governingLaw: "France"},
+
+	// German law
+	"d-fsl-1.0": {governingLaw: "Germany"},
+
+	// Law of the EU member state where the licensor is established,
+	// Belgian law otherwise
+	"eupl-1.0": {governingLaw: "EU member state"},
+	"eupl-1.1": {governingLaw: "EU member state"},
+	"eupl-1.2": {governingLaw: "EU member state"},
+
+	// Quebec law
+	"liliq-p-1.1":     {governingLaw: "Quebec"},
+	"liliq-r-1.1":     {governingLaw: "Quebec"},
+	"liliq-rplus-1.1": {governingLaw: "Quebec"},
+
+	// California law
+	"mpl-1.0":  {governingLaw: "California"},
+	"mpl-1.1":  {governingLaw: "California"},
+	"npl-1.0":  {governingLaw: "California"},
+	"npl-1.1":  {governingLaw: "California"},
+	"apsl-2.0": {governingLaw: "California"},
+
+	// New York law
+	"cpl-1.0": {governingLaw: "New York"},
+	"epl-1.0": {governingLaw: "New York"},
+	"ipl-1.0": {governingLaw: "New York"},
+}
+
+// patentTraitData lists licenses with an explicit patent grant, and
+// whether they also terminate that grant for patent litigation.
+// GPL-family entries are keyed without their -only/-or-later suffix.
+var patentTraitData = map[string]bool{
+	"afl-3.0":                       true,
+	"agpl-3.0":                      true,
+	"apache-2.0":                    true,
+	"apsl-2.0":                      true,
+	"artistic-2.0":                  true,
+	"blueoak-1.0.0":                 true,
+	"bsd-2-clause-patent":           false,
+	"cddl-1.0":                      true,
+	"cddl-1.1":                      true,
+	"cpl-1.0":                       true,
+	"ecl-2.0":                       true,
+	"epl-1.0":                       true,
+	"epl-2.0":                       true,
+	"eupl-1.1":                      false,
+	"eupl-1.2":                      false,
+	"gpl-3.0":                       true,
+	"ipl-1.0":                       true,
+	"lgpl-3.0":                      true,
+	"mpl-1.0":                       true,
+	"mpl-1.1":                       true,
+	"mpl-2.0":                       true,
+	"mpl-2.0-no-copyleft-exception": true,
+	"ms-pl":                         true,
+	"ms-rl":                         true,
+	"mulanpsl-2.0":                  true,
+	"npl-1.0":                       true,
+	"npl-1.1":                       true,
+	"osl-3.0":                       true,
+	"upl-1.0":                       false,
+}
+
+// trademarkTraitData lists licenses with explicit trademark or
+// reserved-name provisions, such as Apache-2.0 section 6 or the
+// Reserved Font Name clause in the OFL.
+var trademarkTraitData = []string{
+	"afl-3.0",
+	"apache-1.0",
+	"apache-1.1",
+	"apache-2.0",
+	"artistic-2.0",
+	"ecl-2.0",
+	"eupl-1.1",
+	"eupl-1.2",
+	"mpl-2.0",
+	"mpl-2.0-no-copyleft-exception",
+	"ms-pl",
+	"ms-rl",
+	"mulanpsl-2.0",
+	"ofl-1.0",
+	"ofl-1.0-no-rfn",
+	"ofl-1.0-rfn",
+	"ofl-1.1",
+	"ofl-1.1-no-rfn",
+	"ofl-1.1-rfn",
+	"openssl",
+	"osl-3.0",
+	"php-3.0",
+	"php-3.01",
+	"psf-2.0",
+	"python-2.0",
+	"zpl-2.0",
+	"zpl-2.1",
+}
+
+func init() {
+	for id, retaliation := range patentTraitData {
+		t := licenseTraitData[id]
+		t.patentGrant = true
+		t.patentRetaliation = retaliation
+		licenseTraitData[id] = t
+	}
+	for _, id := range trademarkTraitData {
+		t := licenseTraitData[id]
+		t.trademark = true
+		licenseTraitData[id] = t
+	}
+}
+
+// lookupTraits returns the curated metadata for a license identifier,
+// falling back to the identifier without an -only/-or-later suffix.
+func lookupTraits(license string) licenseTraits {
+	lower := strings.ToLower(strings.TrimSuffix(license, "+"))
+	if t, ok := licenseTraitData[lower]; ok {
+		return t
+	}
+	lower = strings.TrimSuffix(lower, "-only")
+	lower = strings.TrimSuffix(lower, "-or-later")
+	return licenseTraitData[lower]
+}
+
+// HasPatentGrant returns true if any license in the expression grants an
+// explicit patent license (like Apache-2.0, MPL-2.0 or GPL-3.0).
+// Returns false if the expression cannot be parsed.
+//
+// Example:
+//
+//	HasPatentGrant("MIT OR Apache-2.0")     // true
+//	HasPatentGrant("MIT AND BSD-3-Clause")  // false
+func HasPatentGrant(expression string) bool {
+	licenses, err := ExtractLicenses(expression)
+	if err != nil {
+		return false
+	}
+
+	for _, lic := range licenses {
+		if lookupTraits(lic).patentGrant {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPatentRetaliation returns true if any license in the expression
+// terminates rights for licensees who start patent litigation.
+// Returns false if the expression cannot be parsed.
+//
+// Example:
+//
+//	HasPatentRetaliation("Apache-2.0")  // true
+//	HasPatentRetaliation("UPL-1.0")     // false (grant without retaliation)
+func HasPatentRetaliation(expression string) bool {
+	licenses, err := ExtractLicenses(expression)
+	if err != nil {
+		return false
+	}
+
+	for _, lic := range licenses {
+		if lookupTraits(lic).patentRetaliation {
+			return true
+		}
+	}
+	return false
+}
+
+// HasTrademarkRestrictions returns true if any license in the expression
+// has explicit trademark or reserved-name provisions, which matter when
+// redistributing a renamed fork.
+// Returns false if the expression cannot be parsed.
+//
+// Example:
+//
+//	HasTrademarkRestrictions("MIT OR Apache-2.0")  // true
+//	HasTrademarkRestrictions("OFL-1.1")            // true (Reserved Font Name)
+//	HasTrademarkRestrictions("MIT")                // false
+func HasTrademarkRestrictions(expression string) bool {
+	licenses, err := ExtractLicenses(expression)
+	if err != nil {
+		return false
+	}
+
+	for _, lic := range licenses {
+		if lookupTraits(lic).trademark {
+			return true
+		}
+	}
+	return false
+}
+
+// LicenseFilter selects licenses in ListLicenses.
+type LicenseFilter func(*LicenseInfo) bool
+
+// ListLicenses returns information about every license in the license
+// data accepted by all of the given filters, sorted by scancode license
+// key. With no filters it returns every license.
+//
+// Example:
+//
+//	ListLicenses(GovernedBy("France"))
+//	// CeCILL family
+func ListLicenses(filters ...LicenseFilter) []*LicenseInfo {
+	var result []*LicenseInfo
+outer:
+	for _, entry := range loadConfig().registry().entries() {
+		info := newLicenseInfo(entry)
+		for _, f := range filters {
+			if !f(info) {
+				continue outer
+			}
+		}
+		result = append(result, info)
+	}
+	return result
+}
+
+// GovernedBy returns a filter matching licenses whose choice-of-law clause
+// names the given jurisdiction (case-insensitive). An empty jurisdiction
+// matches licenses with any choice-of-law clause.
+func GovernedBy(jurisdiction string) LicenseFilter {
+	return func(info *LicenseInfo) bool {
+		if jurisdiction == "" {
+			return info.GoverningLaw != ""
+		}
+		return strings.EqualFold(info.GoverningLaw, jurisdiction)
+	}
+}
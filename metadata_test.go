@@ -0,0 +1,133 @@
+package spdx
+
+import "testing"
+
+func TestGoverningLaw(t *testing.T) {
+	tests := map[string]string{
+		"CECILL-2.1": "France",
+		"EUPL-1.2":   "EU member state",
+		"D-FSL-1.0":  "Germany",
+		"MPL-1.1":    "California",
+		"EPL-1.0":    "New York",
+		"MIT":        "",
+		"MPL-2.0":    "",
+	}
+
+	for license, want := range tests {
+		info := GetLicenseInfo(license)
+		if info == nil {
+			t.Errorf("GetLicenseInfo(%q) = nil", license)
+			continue
+		}
+		if info.GoverningLaw != want {
+			t.Errorf("GetLicenseInfo(%q).GoverningLaw = %q, want %q", license, info.GoverningLaw, want)
+		}
+	}
+}
+
+func TestListLicenses(t *testing.T) {
+	all := ListLicenses()
+	if len(all) == 0 {
+		t.Fatal("ListLicenses() returned nothing")
+	}
+
+	french := ListLicenses(GovernedBy("france"))
+	if len(french) == 0 {
+		t.Fatal("ListLicenses(GovernedBy(france)) returned nothing")
+	}
+	for _, info := range french {
+		if info.GoverningLaw != "France" {
+			t.Errorf("%s has GoverningLaw %q, want France", info.SPDXKey, info.GoverningLaw)
+		}
+	}
+
+	anyLaw := ListLicenses(GovernedBy(""))
+	if len(anyLaw) <= len(french) || len(anyLaw) >= len(all) {
+		t.Errorf("GovernedBy(\"\") matched %d of %d licenses", len(anyLaw), len(all))
+	}
+
+	permissiveFrench := ListLicenses(GovernedBy("France"), func(info *LicenseInfo) bool {
+		return info.Category == CategoryPermissive
+	})
+	if len(permissiveFrench) != 1 || permissiveFrench[0].SPDXKey != "CECILL-B" {
+		t.Errorf("combined filters = %v, want only CECILL-B", permissiveFrench)
+	}
+}
+
+func TestPatentFlags(t *testing.T) {
+	tests := []struct {
+		license     string
+		grant       bool
+		retaliation bool
+	}{
+		{"Apache-2.0", true, true},
+		{"MPL-2.0", true, true},
+		{"GPL-3.0-only", true, true},
+		{"UPL-1.0", true, false},
+		{"BSD-2-Clause-Patent", true, false},
+		{"MIT", false, false},
+		{"GPL-2.0-only", false, false},
+	}
+
+	for _, tt := range tests {
+		info := GetLicenseInfo(tt.license)
+		if info == nil {
+			t.Errorf("GetLicenseInfo(%q) = nil", tt.license)
+			continue
+		}
+		if info.PatentGrant != tt.grant || info.PatentRetaliation != tt.retaliation {
+			t.Errorf("GetLicenseInfo(%q) patent flags = %v/%v, want %v/%v",
+				tt.license, info.PatentGrant, info.PatentRetaliation, tt.grant, tt.retaliation)
+		}
+	}
+}
+
+func TestHasPatentGrant(t *testing.T) {
+	tests := map[string]bool{
+		"MIT OR Apache-2.0":        true,
+		"MIT AND BSD-3-Clause":     false,
+		"GPL-3.0-or-later":         true,
+		"(MIT AND ISC) OR UPL-1.0": true,
+		"":                         false,
+	}
+	for expr, want := range tests {
+		if got := HasPatentGrant(expr); got != want {
+			t.Errorf("HasPatentGrant(%q) = %v, want %v", expr, got, want)
+		}
+	}
+}
+
+func TestHasPatentRetaliation(t *testing.T) {
+	tests := map[string]bool{
+		"Apache-2.0":           true,
+		"MIT OR MPL-2.0":       true,
+		"UPL-1.0":              false,
+		"MIT AND BSD-3-Clause": false,
+	}
+	for expr, want := range tests {
+		if got := HasPatentRetaliation(expr); got != want {
+			t.Errorf("HasPatentRetaliation(%q) = %v, want %v", expr, got, want)
+		}
+	}
+}
+
+func TestHasTrademarkRestrictions(t *testing.T) {
+	tests := map[string]bool{
+		"Apache-2.0":        true,
+		"Artistic-2.0":      true,
+		"OFL-1.1":           true,
+		"MIT OR Apache-2.0": true,
+		"MIT":               false,
+		"MIT AND ISC":       false,
+		"GPL-3.0-or-later":  false,
+	}
+	for expr, want := range tests {
+		if got := HasTrademarkRestrictions(expr); got != want {
+			t.Errorf("HasTrademarkRestrictions(%q) = %v, want %v", expr, got, want)
+		}
+	}
+
+	if info := GetLicenseInfo("OFL-1.1"); info == nil || !info.TrademarkRestrictions {
+		t.Errorf("GetLicenseInfo(OFL-1.1).TrademarkRestrictions should be true, got %+v", info)
+	}
+}
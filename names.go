@@ -0,0 +1,149 @@
+package spdx
+
+// licenseName holds the full and short human-readable names of a license.
+type licenseName struct {
+	full  string // full name from the SPDX license list
+	short string // compact label for tables and badges
+}
+
+// licenseNameData covers the licenses and exceptions most often seen in
+// package metadata. Full names follow the SPDX license list.
+var licenseNameData = map[string]licenseName{
+	"0BSD":                          {"BSD Zero Clause License", "0BSD"},
+	"AFL-3.0":                       {"Academic Free License v3.0", "AFL 3.0"},
+	"AGPL-3.0-only":                 {"GNU Affero General Public License v3.0 only", "AGPLv3"},
+	"AGPL-3.0-or-later":             {"GNU Affero General Public License v3.0 or later", "AGPLv3+"},
+	"Apache-1.1":                    {"Apache License 1.1", "Apache 1.1"},
+	"Apache-2.0":                    {"Apache License 2.0", "Apache 2.0"},
+	"Artistic-1.0":                  {"Artistic License 1.0", "Artistic 1.0"},
+	"Artistic-1.0-Perl":             {"Artistic License 1.0 (Perl)", "Artistic 1.0 (Perl)"},
+	"Artistic-2.0":                  {"Artistic License 2.0", "Artistic 2.0"},
+	"Beerware":                      {"Beerware License", "Beerware"},
+	"BlueOak-1.0.0":                 {"Blue Oak Model License 1.0.0", "Blue Oak 1.0.0"},
+	"BSD-1-Clause":                  {"BSD 1-Clause License", "BSD 1-Clause"},
+	"BSD-2-Clause":                  {`BSD 2-Clause "Simplified" License`, "BSD 2-Clause"},
+	"BSD-2-Clause-Patent":           {"BSD-2-Clause Plus Patent License", "BSD 2-Clause Plus Patent"},
+	"BSD-3-Clause":                  {`BSD 3-Clause "New" or "Revised" License`, "BSD 3-Clause"},
+	"BSD-3-Clause-Clear":            {"BSD 3-Clause Clear License", "BSD 3-Clause Clear"},
+	"BSD-4-Clause":                  {`BSD 4-Clause "Original" or "Old" License`, "BSD 4-Clause"},
+	"BSL-1.0":                       {"Boost Software License 1.0", "Boost 1.0"},
+	"CC-BY-3.0":                     {"Creative Commons Attribution 3.0 Unported", "CC BY 3.0"},
+	"CC-BY-4.0":                     {"Creative Commons Attribution 4.0 International", "CC BY 4.0"},
+	"CC-BY-NC-4.0":                  {"Creative Commons Attribution Non Commercial 4.0 International", "CC BY-NC 4.0"},
+	"CC-BY-NC-ND-4.0":               {"Creative Commons Attribution Non Commercial No Derivatives 4.0 International", "CC BY-NC-ND 4.0"},
+	"CC-BY-NC-SA-4.0":               {"Creative Commons Attribution Non Commercial Share Alike 4.0 International", "CC BY-NC-SA 4.0"},
+	"CC-BY-ND-4.0":                  {"Creative Commons Attribution No Derivatives 4.0 International", "CC BY-ND 4.0"},
+	"CC-BY-SA-3.0":                  {"Creative Commons Attribution Share Alike 3.0 Unported", "CC BY-SA 3.0"},
+	"CC-BY-SA-4.0":                  {"Creative Commons Attribution Share Alike 4.0 International", "CC BY-SA 4.0"},
+	"CC0-1.0":                       {"Creative Commons Zero v1.0 Universal", "CC0 1.0"},
+	"CDDL-1.0":                      {"Common Development and Distribution License 1.0", "CDDL 1.0"},
+	"CDDL-1.1":                      {"Common Development and Distribution License 1.1", "CDDL 1.1"},
+	"CECILL-2.1":                    {"CeCILL Free Software License Agreement v2.1", "CeCILL 2.1"},
+	"CPL-1.0":                       {"Common Public License 1.0", "CPL 1.0"},
+	"ECL-2.0":                       {"Educational Community License v2.0", "ECL 2.0"},
+	"EPL-1.0":                       {"Eclipse Public License 1.0", "EPL 1.0"},
+	"EPL-2.0":                       {"Eclipse Public License 2.0", "EPL 2.0"},
+	"EUPL-1.1":                      {"European Union Public License 1.1", "EUPL 1.1"},
+	"EUPL-1.2":                      {"European Union Public License 1.2", "EUPL 1.2"},
+	"GFDL-1.3-only":                 {"GNU Free Documentation License v1.3 only", "GFDLv1.3"},
+	"GFDL-1.3-or-later":             {"GNU Free Documentation License v1.3 or later", "GFDLv1.3+"},
+	"GPL-1.0-only":                  {"GNU General Public License v1.0 only", "GPLv1"},
+	"GPL-1.0-or-later":              {"GNU General Public License v1.0 or later", "GPLv1+"},
+	"GPL-2.0-only":                  {"GNU General Public License v2.0 only", "GPLv2"},
+	"GPL-2.0-or-later":              {"GNU General Public License v2.0 or later", "GPLv2+"},
+	"GPL-3.0-only":                  {"GNU General Public License v3.0 only", "GPLv3"},
+	"GPL-3.0-or-later":              {"GNU General Public License v3.0 or later", "GPLv3+"},
+	"ISC":                           {"ISC License", "ISC"},
+	"LGPL-2.0-only":                 {"GNU Library General Public License v2 only", "LGPLv2"},
+	"LGPL-2.0-or-later":             {"GNU Library General Public License v2 or later", "LGPLv2+"},
+	"LGPL-2.1-only":                 {"GNU Lesser General Public License v2.1 only", "LGPLv2.1"},
+	"LGPL-2.1-or-later":             {"GNU Lesser General Public License v2.1 or later", "LGPLv2.1+"},
+	"LGPL-3.0-only":                 {"GNU Lesser General Public License v3.0 only", "LGPLv3"},
+	"LGPL-3.0-or-later":             {"GNU Lesser General Public License v3.0 or later", "LGPLv3+"},
+	"LPPL-1.3c":                     {"LaTeX Project Public License v1.3c", "LPPL 1.3c"},
+	"MIT":                           {"MIT License", "MIT"},
+	"MIT-0":                         {"MIT No Attribution", "MIT-0"},
+	"MPL-1.1":                       {"Mozilla Public License 1.1", "MPL 1.1"},
+	"MPL-2.0":                       {"Mozilla Public License 2.0", "MPL 2.0"},
+	"MPL-2.0-no-copyleft-exception": {"Mozilla Public License 2.0 (no copyleft exception)", "MPL 2.0 (no copyleft exception)"},
+	"MS-PL":                         {"Microsoft Public License", "Ms-PL"},
+	"MS-RL":                         {"Microsoft Reciprocal License", "Ms-RL"},
+	"MulanPSL-2.0":                  {"Mulan Permissive Software License, Version 2", "MulanPSL 2.0"},
+	"NCSA":                          {"University of Illinois/NCSA Open Source License", "NCSA"},
+	"ODbL-1.0":                      {"Open Data Commons Open Database License v1.0", "ODbL 1.0"},
+	"OFL-1.1":                       {"SIL Open Font License 1.1", "OFL 1.1"},
+	"OpenSSL":                       {"OpenSSL License", "OpenSSL"},
+	"OSL-3.0":                       {"Open Software License 3.0", "OSL 3.0"},
+	"PHP-3.01":                      {"PHP License v3.01", "PHP 3.01"},
+	"PostgreSQL":                    {"PostgreSQL License", "PostgreSQL"},
+	"PSF-2.0":                       {"Python Software Foundation License 2.0", "PSF 2.0"},
+	"Python-2.0":                    {"Python License 2.0", "Python 2.0"},
+	"Ruby":                          {"Ruby License", "Ruby"},
+	"SSPL-1.0":                      {"Server Side Public License, v 1", "SSPL 1.0"},
+	"Unicode-DFS-2016":              {"Unicode License Agreement - Data Files and Software (2016)", "Unicode DFS 2016"},
+	"Unlicense":                     {"The Unlicense", "Unlicense"},
+	"UPL-1.0":                       {"Universal Permissive License v1.0", "UPL 1.0"},
+	"Vim":                           {"Vim License", "Vim"},
+	"W3C":                           {"W3C Software Notice and License (2002-12-31)", "W3C"},
+	"WTFPL":                         {"Do What The F*ck You Want To Public License", "WTFPL"},
+	"X11":                           {"X11 License", "X11"},
+	"Zlib":                          {"zlib License", "zlib"},
+	"ZPL-2.1":                       {"Zope Public License 2.1", "ZPL 2.1"},
+
+	// Exceptions
+	"Classpath-exception-2.0": {"Classpath exception 2.0", "Classpath exception"},
+	"GCC-exception-3.1":       {"GCC Runtime Library exception 3.1", "GCC runtime exception"},
+	"Linux-syscall-note":      {"Linux Syscall Note", "syscall note"},
+	"LLVM-exception":          {"LLVM Exception", "LLVM exception"},
+}
+
+// lookupName returns the names for a license or exception ID, resolving
+// case and deprecated GPL-family IDs. The second result is the canonical ID,
+// or "" if the ID is unknown.
+func lookupName(id string) (licenseName, string) {
+	canonical := lookupLicense(id)
+	if canonical == "" {
+		canonical = lookupException(id)
+	}
+	if canonical == "" {
+		return licenseName{}, ""
+	}
+	if n, ok := licenseNameData[canonical]; ok {
+		return n, canonical
+	}
+	return licenseNameData[upgradeGPL(canonical)], canonical
+}
+
+// DisplayName returns the full human-readable name of a license or
+// exception, as listed by SPDX. Identifiers without name data return the
+// canonical ID, and unknown identifiers return "".
+//
+// Example:
+//
+//	DisplayName("Apache-2.0")    // "Apache License 2.0"
+//	DisplayName("gpl-3.0-only")  // "GNU General Public License v3.0 only"
+//	DisplayName("FAKE")          // ""
+func DisplayName(id string) string {
+	n, canonical := lookupName(id)
+	if n.full == "" {
+		return canonical
+	}
+	return n.full
+}
+
+// ShortName returns a compact label for a license or exception, such as
+// "Apache 2.0" or "GPLv3+", for tables and badges where the full name is
+// too long. Identifiers without name data return the canonical ID, and
+// unknown identifiers return "".
+//
+// Example:
+//
+//	ShortName("Apache-2.0")        // "Apache 2.0"
+//	ShortName("GPL-3.0-or-later")  // "GPLv3+"
+func ShortName(id string) string {
+	n, canonical := lookupName(id)
+	if n.short == "" {
+		return canonical
+	}
+	return n.short
+}
@@ -0,0 +1,54 @@
+package spdx
+
+import "testing"
+
+func TestDisplayName(t *testing.T) {
+	tests := map[string]string{
+		"Apache-2.0":              "Apache License 2.0",
+		"apache-2.0":              "Apache License 2.0",
+		"GPL-3.0-only":            "GNU General Public License v3.0 only",
+		"GPL-2.0":                 "GNU General Public License v2.0 only",
+		"BSD-3-Clause":            `BSD 3-Clause "New" or "Revised" License`,
+		"Classpath-exception-2.0": "Classpath exception 2.0",
+		"Glide":                   "Glide", // valid ID without name data
+		"FAKEYLICENSE":            "",
+	}
+
+	for id, want := range tests {
+		if got := DisplayName(id); got != want {
+			t.Errorf("DisplayName(%q) = %q, want %q", id, got, want)
+		}
+	}
+}
+
+func TestShortName(t *testing.T) {
+	tests := map[string]string{
+		"Apache-2.0":       "Apache 2.0",
+		"GPL-3.0-or-later": "GPLv3+",
+		"LGPL-2.1-only":    "LGPLv2.1",
+		"CC-BY-SA-4.0":     "CC BY-SA 4.0",
+		"MIT":              "MIT",
+		"Glide":            "Glide",
+		"FAKEYLICENSE":     "",
+	}
+
+	for id, want := range tests {
+		if got := ShortName(id); got != want {
+			t.Errorf("ShortName(%q) = %q, want %q", id, got, want)
+		}
+	}
+}
+
+func TestLicenseNameDataKeys(t *testing.T) {
+	for id := range licenseNameData {
+		if lookupLicense(id) != id && lookupException(id) != id {
+			t.Errorf("licenseNameData key %q is not a canonical SPDX identifier", id)
+		}
+	}
+}
+
+func TestLicenseInfoName(t *testing.T) {
+	if got := GetLicenseInfo("MPL-2.0").Name; got != "Mozilla Public License 2.0" {
+		t.Errorf("GetLicenseInfo(MPL-2.0).Name = %q", got)
+	}
+}
@@ -0,0 +1,176 @@
+package spdx
+
+import (
+	"strings"
+)
+
+// DifferenceKind says whether a Difference is text added to a license or
+// text removed from it.
+type DifferenceKind int
+
+const (
+	// DifferenceAdded is text that is not in the license template.
+	DifferenceAdded DifferenceKind = iota
+	// DifferenceRemoved is template text that is missing.
+	DifferenceRemoved
+)
+
+func (k DifferenceKind) String() string {
+	if k == DifferenceRemoved {
+		return "removed"
+	}
+	return "added"
+}
+
+// Difference is a place where a license text departs from the template of
+// the license it is closest to.
+type Difference struct {
+	Kind DifferenceKind
+	// Offset is the byte offset in the text where added text starts, or
+	// where removed text is missing.
+	Offset int
+	// Text is the added text as written, or the removed template text
+	// with its whitespace collapsed.
+	Text string
+	// Clause is the sentence of the template the difference falls in,
+	// with its whitespace collapsed. It is empty for text added between
+	// sentences, such as a rider appended to the license.
+	Clause string
+}
+
+// NearMatch is a license text that is close to a license template but not
+// the same, such as MIT with an added restriction, which a matcher that
+// only reports an identifier would silently take for the license itself.
+type NearMatch struct {
+	License     string // the closest license
+	Score       float64
+	Coverage    float64
+	Differences []Difference
+}
+
+// FindNearMatch reports whether text is a modified form of a license,
+// using a TextMatcher with the default threshold.
+//
+// Example:
+//
+//	near, ok := FindNearMatch(mitText + "\n\"Commons Clause\" License Condition v1.0 ...")
+//	// ok: true
+//	near.License         // "MIT"
+//	near.Differences[0]  // {Kind: DifferenceAdded, Offset: 1071, Text: "Commons Clause\" License Condition v1.0 ..."}
+func FindNearMatch(text string) (NearMatch, bool) {
+	var m TextMatcher
+	return m.FindNearMatch(text)
+}
+
+// FindNearMatch reports whether text matches a template at the matcher's
+// threshold but not exactly, and lists the clauses added and removed. It
+// returns false for an unmodified license text, differing only in layout
+// and field values, and for text that matches no template.
+func (m *TextMatcher) FindNearMatch(text string) (NearMatch, bool) {
+	match, err := m.Match(text)
+	if err != nil || len(match.differences) == 0 {
+		return NearMatch{}, false
+	}
+	return NearMatch{
+		License:     match.License,
+		Score:       match.Score,
+		Coverage:    match.Coverage,
+		Differences: match.differences,
+	}, true
+}
+
+// added describes extra text found between the template words at prev and
+// next, which are -1 and len(t.words) at the ends.
+func (t *licenseTemplate) added(span TextSpan, prev, next int) Difference {
+	d := Difference{Kind: DifferenceAdded, Offset: span.Start, Text: span.Text}
+	if prev >= 0 && next < len(t.words) {
+		before := t.sentence(t.spans[prev][0], t.spans[prev][1])
+		if after := t.sentence(t.spans[next][0], t.spans[next][1]); before == after {
+			d.Clause = before
+		}
+	}
+	return d
+}
+
+// removed describes the runs of template words that the text, aligned by
+// tmplAt, does not have. Fields are not reported, as their values are
+// never matched.
+func (t *licenseTemplate) removed(tmplAt []int, words []textWord) []Difference {
+	found := make([]bool, len(t.words))
+	for _, i := range tmplAt {
+		if i >= 0 {
+			found[i] = true
+		}
+	}
+
+	var diffs []Difference
+	offset := 0 // end of the last text word matched so far
+	j := 0      // next text word to check for offset
+	for i := 0; i < len(t.words); {
+		if found[i] || t.words[i] == "" {
+			i++
+			continue
+		}
+		k := i
+		for k < len(t.words) && !found[k] && t.words[k] != "" {
+			k++
+		}
+		for ; j < len(tmplAt); j++ {
+			if tmplAt[j] >= i {
+				break
+			}
+			if tmplAt[j] >= 0 {
+				offset = words[j].end
+			}
+		}
+		start, end := t.spans[i][0], t.spans[k-1][1]
+		diffs = append(diffs, Difference{
+			Kind:   DifferenceRemoved,
+			Offset: offset,
+			Text:   collapseSpace(t.text[start:end]),
+			Clause: t.sentence(start, end),
+		})
+		i = k
+	}
+	return diffs
+}
+
+// sentence returns the sentence of the template around the bytes start to
+// end, with its whitespace collapsed. Sentences end at a full stop, colon
+// or semicolon followed by whitespace, or at a blank line.
+func (t *licenseTemplate) sentence(start, end int) string {
+	from := 0
+	for i := start - 1; i > 0; i-- {
+		if sentenceBreak(t.text, i) {
+			from = i + 1
+			break
+		}
+	}
+	to := len(t.text)
+	for i := end; i < len(t.text)-1; i++ {
+		if sentenceBreak(t.text, i) {
+			to = i + 1
+			break
+		}
+	}
+	return collapseSpace(t.text[from:to])
+}
+
+// sentenceBreak reports whether a sentence ends at byte i of s.
+func sentenceBreak(s string, i int) bool {
+	if i+1 >= len(s) {
+		return true
+	}
+	switch s[i] {
+	case '.', ':', ';':
+		return s[i+1] == ' ' || s[i+1] == '\n'
+	case '\n':
+		return s[i+1] == '\n'
+	}
+	return false
+}
+
+// collapseSpace replaces each run of whitespace in s with a single space.
+func collapseSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
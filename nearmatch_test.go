@@ -0,0 +1,114 @@
+package spdx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFindNearMatchExact(t *testing.T) {
+	for _, id := range TemplateLicenses() {
+		if near, ok := FindNearMatch(mustLicenseText(t, id)); ok {
+			t.Errorf("FindNearMatch(%s) = %+v, want no near match for the unmodified text", id, near)
+		}
+	}
+	if _, ok := FindNearMatch("This is not a license."); ok {
+		t.Error("FindNearMatch matched unrelated text")
+	}
+}
+
+func TestFindNearMatchRider(t *testing.T) {
+	mit := mustLicenseText(t, "MIT")
+	near, ok := FindNearMatch(mit + "\n" + commonsClause)
+	if !ok {
+		t.Fatal("FindNearMatch did not flag MIT with the Commons Clause")
+	}
+	if near.License != "MIT" {
+		t.Errorf("License = %q, want MIT", near.License)
+	}
+	if len(near.Differences) != 1 {
+		t.Fatalf("Differences = %+v, want one", near.Differences)
+	}
+	d := near.Differences[0]
+	if d.Kind != DifferenceAdded || d.Offset < len(mit) || d.Clause != "" {
+		t.Errorf("Difference = %+v, want text added after the license", d)
+	}
+	if !strings.Contains(d.Text, "the right to Sell the Software") {
+		t.Errorf("Difference text = %q, want the Commons Clause", d.Text)
+	}
+}
+
+func TestFindNearMatchClauses(t *testing.T) {
+	mit := mustLicenseText(t, "MIT")
+	tests := []struct {
+		name   string
+		old    string
+		new    string
+		kinds  []DifferenceKind
+		texts  []string
+		clause string
+	}{
+		{
+			name:   "restriction inserted",
+			old:    "to deal\nin the Software without restriction",
+			new:    "to deal\nin the Software, for non-commercial purposes only, without restriction",
+			kinds:  []DifferenceKind{DifferenceAdded},
+			texts:  []string{"for non-commercial purposes only"},
+			clause: "Permission is hereby granted, free of charge, to any person obtaining a copy of this software",
+		},
+		{
+			name:   "notice condition removed",
+			old:    " and this permission notice shall",
+			new:    " shall",
+			kinds:  []DifferenceKind{DifferenceRemoved},
+			texts:  []string{"and this permission notice"},
+			clause: "The above copyright notice and this permission notice shall be included in all copies or substantial portions of the Software.",
+		},
+		{
+			name:   "warranty disclaimer reworded",
+			old:    "WITHOUT WARRANTY OF ANY KIND",
+			new:    "SUBJECT TO A ONE-YEAR GUARANTEE",
+			kinds:  []DifferenceKind{DifferenceRemoved, DifferenceAdded},
+			texts:  []string{"WITHOUT WARRANTY OF ANY KIND", "SUBJECT TO A ONE-YEAR GUARANTEE"},
+			clause: `THE SOFTWARE IS PROVIDED "AS IS",`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !strings.Contains(mit, tt.old) {
+				t.Fatalf("MIT text has no %q", tt.old)
+			}
+			text := strings.Replace(mit, tt.old, tt.new, 1)
+			near, ok := FindNearMatch(text)
+			if !ok {
+				t.Fatal("FindNearMatch did not flag the modified text")
+			}
+			if near.License != "MIT" || near.Score >= 1 {
+				t.Errorf("got %s with score %v", near.License, near.Score)
+			}
+			if len(near.Differences) != len(tt.kinds) {
+				t.Fatalf("Differences = %+v, want %d", near.Differences, len(tt.kinds))
+			}
+			for i, d := range near.Differences {
+				if d.Kind != tt.kinds[i] || d.Text != tt.texts[i] {
+					t.Errorf("Differences[%d] = %v %q, want %v %q", i, d.Kind, d.Text, tt.kinds[i], tt.texts[i])
+				}
+				if !strings.HasPrefix(d.Clause, tt.clause) {
+					t.Errorf("Differences[%d].Clause = %q, want it to start %q", i, d.Clause, tt.clause)
+				}
+				if d.Offset < 0 || d.Offset > len(text) {
+					t.Errorf("Differences[%d].Offset = %d out of range", i, d.Offset)
+				}
+			}
+			if d := near.Differences[0]; d.Kind == DifferenceAdded && text[d.Offset:d.Offset+len(d.Text)] != d.Text {
+				t.Errorf("added text %q is not at offset %d", d.Text, d.Offset)
+			}
+		})
+	}
+}
+
+func TestDifferenceKindString(t *testing.T) {
+	if DifferenceAdded.String() != "added" || DifferenceRemoved.String() != "removed" {
+		t.Errorf("got %q and %q", DifferenceAdded, DifferenceRemoved)
+	}
+}
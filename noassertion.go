@@ -0,0 +1,64 @@
+package spdx
+
+// NoAssertionPolicy controls how NOASSERTION is treated. SPDX documents
+// use it when the author made no attempt to determine the license, which
+// some validators accept and others must reject.
+type NoAssertionPolicy int
+
+const (
+	// NoAssertionAllow accepts NOASSERTION as a valid expression. This is
+	// the default.
+	NoAssertionAllow NoAssertionPolicy = iota
+	// NoAssertionWarn accepts NOASSERTION and logs a warning to
+	// Options.Logger for each expression containing it.
+	NoAssertionWarn
+	// NoAssertionFail makes Parse, ParseStrict and Valid reject
+	// expressions containing NOASSERTION with ErrNoAssertion.
+	NoAssertionFail
+	// NoAssertionUnknown accepts NOASSERTION and counts it as a license
+	// of unknown category, so ExpressionCategories reports
+	// CategoryUnknown for it even with Options.ApplyExceptions set.
+	NoAssertionUnknown
+)
+
+// checkNoAssertion applies Options.NoAssertion to a parsed expression.
+func (c *config) checkNoAssertion(expr Expression) error {
+	if c.opts.NoAssertion == NoAssertionAllow || !hasNoAssertion(expr) {
+		return nil
+	}
+	switch c.opts.NoAssertion {
+	case NoAssertionFail:
+		return ErrNoAssertion
+	case NoAssertionWarn:
+		if c.opts.Logger != nil {
+			c.opts.Logger.Warn("spdx: expression contains NOASSERTION", "expression", expr.String())
+		}
+	}
+	return nil
+}
+
+// hasNoAssertion reports whether NOASSERTION appears anywhere in expr.
+func hasNoAssertion(expr Expression) bool {
+	switch e := expr.(type) {
+	case *AndExpression:
+		return hasNoAssertion(e.Left) || hasNoAssertion(e.Right)
+	case *OrExpression:
+		return hasNoAssertion(e.Left) || hasNoAssertion(e.Right)
+	}
+	return expr.IsNoAssertion()
+}
+
+func (l *License) IsNone() bool        { return false }
+func (l *License) IsNoAssertion() bool { return false }
+
+func (l *LicenseRef) IsNone() bool        { return false }
+func (l *LicenseRef) IsNoAssertion() bool { return false }
+
+func (e *AndExpression) IsNone() bool        { return false }
+func (e *AndExpression) IsNoAssertion() bool { return false }
+
+func (e *OrExpression) IsNone() bool        { return false }
+func (e *OrExpression) IsNoAssertion() bool { return false }
+
+func (s *SpecialValue) IsNone() bool        { return s.Value == "NONE" }
+func (s *SpecialValue) IsNoAssertion() bool { return s.Value == "NOASSERTION" }
@@ -0,0 +1,96 @@
+package spdx
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestIsNoneIsNoAssertion(t *testing.T) {
+	tests := []struct {
+		input         string
+		none, noAsser bool
+	}{
+		{"NONE", true, false},
+		{"noassertion", false, true},
+		{"MIT", false, false},
+		{"LicenseRef-x", false, false},
+		{"MIT OR Apache-2.0", false, false},
+		{"MIT AND Apache-2.0", false, false},
+	}
+
+	for _, tt := range tests {
+		expr, err := Parse(tt.input)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", tt.input, err)
+		}
+		if expr.IsNone() != tt.none || expr.IsNoAssertion() != tt.noAsser {
+			t.Errorf("%q: IsNone() = %v, IsNoAssertion() = %v", tt.input, expr.IsNone(), expr.IsNoAssertion())
+		}
+	}
+}
+
+func TestNoAssertionFail(t *testing.T) {
+	withOptions(t, Options{NoAssertion: NoAssertionFail})
+
+	for name, parse := range map[string]func(string) (Expression, error){
+		"Parse":       Parse,
+		"ParseStrict": ParseStrict,
+		"ParsePooled": ParsePooled,
+	} {
+		if _, err := parse("NOASSERTION"); !errors.Is(err, ErrNoAssertion) {
+			t.Errorf("%s(NOASSERTION) error = %v, want ErrNoAssertion", name, err)
+		}
+	}
+	if Valid("NOASSERTION") {
+		t.Error("Valid(NOASSERTION) should be false")
+	}
+	if _, err := Parse("NONE"); err != nil {
+		t.Errorf("Parse(NONE) = %v; only NOASSERTION is affected", err)
+	}
+	if _, err := ParseStrict("MIT AND (ISC OR NOASSERTION)"); !errors.Is(err, ErrNoAssertion) {
+		t.Errorf("ParseStrict of a nested NOASSERTION error = %v, want ErrNoAssertion", err)
+	}
+	if _, err := Parse("NOASSERTION"); ErrorCode(err) != CodeNoAssertion {
+		t.Errorf("ErrorCode = %q, want %q", ErrorCode(err), CodeNoAssertion)
+	}
+}
+
+func TestNoAssertionWarn(t *testing.T) {
+	var buf bytes.Buffer
+	withOptions(t, Options{
+		NoAssertion: NoAssertionWarn,
+		Logger:      slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelWarn})),
+	})
+
+	if _, err := Parse("MIT"); err != nil || buf.Len() != 0 {
+		t.Fatalf("Parse(MIT) = %v, logged %q", err, buf.String())
+	}
+	if _, err := ParseStrict("NOASSERTION"); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "level=WARN") || !strings.Contains(buf.String(), "NOASSERTION") {
+		t.Errorf("log = %q, want a warning about NOASSERTION", buf.String())
+	}
+}
+
+func TestNoAssertionUnknown(t *testing.T) {
+	withOptions(t, Options{ApplyExceptions: true})
+	if cats, err := ExpressionCategories("NOASSERTION"); err != nil || len(cats) != 0 {
+		t.Errorf("default: ExpressionCategories(NOASSERTION) = %v, %v; want none", cats, err)
+	}
+
+	withOptions(t, Options{ApplyExceptions: true, NoAssertion: NoAssertionUnknown})
+	if cats, err := ExpressionCategories("NOASSERTION"); err != nil || !slices.Equal(cats, []Category{CategoryUnknown}) {
+		t.Errorf("ExpressionCategories(NOASSERTION) = %v, %v; want Unknown", cats, err)
+	}
+	if cats, err := ExpressionCategories("MIT OR NOASSERTION"); err != nil || !slices.Equal(cats, []Category{CategoryPermissive, CategoryUnknown}) {
+		t.Errorf("ExpressionCategories(MIT OR NOASSERTION) = %v, %v", cats, err)
+	}
+	if cats, err := ExpressionCategories("NONE"); err != nil || len(cats) != 0 {
+		t.Errorf("ExpressionCategories(NONE) = %v, %v; want none", cats, err)
+	}
+}
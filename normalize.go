@@ -2,70 +2,11 @@ package spdx
 
 import (
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
-	"sync"
-
-	"github.com/github/go-spdx/v2/spdxexp/spdxlicenses"
-)
-
-var (
-	initOnce      sync.Once
-	licenseMap    map[string]string // lowercase -> canonical
-	exceptionMap  map[string]string // lowercase -> canonical
-	deprecatedMap map[string]string // lowercase -> canonical
 )
 
-func initMaps() {
-	initOnce.Do(func() {
-		licenses := spdxlicenses.GetLicenses()
-		deprecated := spdxlicenses.GetDeprecated()
-		exceptions := spdxlicenses.GetExceptions()
-
-		licenseMap = make(map[string]string, len(licenses)+len(deprecated))
-		for _, id := range licenses {
-			licenseMap[strings.ToLower(id)] = id
-		}
-
-		deprecatedMap = make(map[string]string, len(deprecated))
-		for _, id := range deprecated {
-			lower := strings.ToLower(id)
-			deprecatedMap[lower] = id
-			if _, exists := licenseMap[lower]; !exists {
-				licenseMap[lower] = id
-			}
-		}
-
-		exceptionMap = make(map[string]string, len(exceptions))
-		for _, id := range exceptions {
-			exceptionMap[strings.ToLower(id)] = id
-		}
-	})
-}
-
-// lookupLicense returns the canonical SPDX license ID for the given string,
-// or empty string if not found.
-func lookupLicense(s string) string {
-	initMaps()
-	return licenseMap[strings.ToLower(s)]
-}
-
-// lookupException returns the canonical SPDX exception ID for the given string,
-// or empty string if not found.
-func lookupException(s string) string {
-	initMaps()
-	return exceptionMap[strings.ToLower(s)]
-}
-
-// isValidLicenseOrException checks if the string is a valid license or exception.
-func isValidLicenseOrException(s string) bool {
-	initMaps()
-	lower := strings.ToLower(s)
-	_, isLicense := licenseMap[lower]
-	_, isException := exceptionMap[lower]
-	return isLicense || isException
-}
-
 // transposition represents a common misspelling or variation to correct.
 type transposition struct {
 	from      string
@@ -153,67 +94,56 @@ var (
 	reOldBSD        = regexp.MustCompile(`(?i)\b(Old|Original)(-|\s)?BSD((-|\s)License)?`)
 	reCCSpaceDigit  = regexp.MustCompile(`\s+(\d)`)
 	reCCVersion     = regexp.MustCompile(`\d\.\d`)
+	reConcatenated  = regexp.MustCompile(`(?i)^([a-z]+?(?:-[a-z]+)*?)-?v?(\d)(\d?)(clause)?$`)
 )
 
 // Transform functions that modify license strings.
 type transform func(string) string
 
-var transforms = []transform{
-	// Uppercase
-	func(s string) string { return strings.ToUpper(s) },
-	// Trim whitespace
-	func(s string) string { return strings.TrimSpace(s) },
-	// Remove dots (M.I.T. -> MIT)
-	func(s string) string { return strings.ReplaceAll(s, ".", "") },
-	// Remove all whitespace (Apache- 2.0 -> Apache-2.0)
-	func(s string) string { return reWhitespace.ReplaceAllString(s, "") },
-	// Replace spaces with dashes (CC BY 4.0 -> CC-BY-4.0)
-	func(s string) string { return reWhitespace.ReplaceAllString(s, "-") },
-	// Replace v with dash (LGPLv2.1 -> LGPL-2.1)
-	func(s string) string { return strings.Replace(s, "v", "-", 1) },
-	// Apache 2.0 -> Apache-2.0
-	func(s string) string { return reDigit.ReplaceAllString(s, "-$1") },
-	// GPL 2 -> GPL-2.0
-	func(s string) string { return reDigitEnd.ReplaceAllString(s, "-$1.0") },
-	// Apache Version 2.0 -> Apache-2.0
-	func(s string) string { return reVersion.ReplaceAllString(s, "-$2") },
-	// Apache Version 2 -> Apache-2.0
-	func(s string) string { return reVersionEnd.ReplaceAllString(s, "-$2.0") },
-	// Capitalize first letter only (zlib -> Zlib)
-	func(s string) string {
+// transformRule is a named transform. The name is its rule ID without the
+// prefix, and example shows the rewrite it performs.
+type transformRule struct {
+	name    string
+	example string
+	apply   transform
+}
+
+var transforms = []transformRule{
+	{"uppercase", "mit -> MIT", func(s string) string { return strings.ToUpper(s) }},
+	{"trim-space", "\" MIT \" -> MIT", func(s string) string { return strings.TrimSpace(s) }},
+	{"remove-dots", "M.I.T. -> MIT", func(s string) string { return strings.ReplaceAll(s, ".", "") }},
+	{"remove-whitespace", "Apache- 2.0 -> Apache-2.0", func(s string) string { return reWhitespace.ReplaceAllString(s, "") }},
+	{"spaces-to-dashes", "CC BY 4.0 -> CC-BY-4.0", func(s string) string { return reWhitespace.ReplaceAllString(s, "-") }},
+	{"v-to-dash", "LGPLv2.1 -> LGPL-2.1", func(s string) string { return strings.Replace(s, "v", "-", 1) }},
+	{"digit-to-dash", "Apache 2.0 -> Apache-2.0", func(s string) string { return reDigit.ReplaceAllString(s, "-$1") }},
+	{"trailing-digit", "GPL 2 -> GPL-2.0", func(s string) string { return reDigitEnd.ReplaceAllString(s, "-$1.0") }},
+	{"version-word", "Apache Version 2.0 -> Apache-2.0", func(s string) string { return reVersion.ReplaceAllString(s, "-$2") }},
+	{"trailing-version-word", "Apache Version 2 -> Apache-2.0", func(s string) string { return reVersionEnd.ReplaceAllString(s, "-$2.0") }},
+	{"capitalize", "zlib -> Zlib", func(s string) string {
 		if len(s) == 0 {
 			return s
 		}
 		return strings.ToUpper(s[:1]) + s[1:]
-	},
-	// Replace / with - (MPL/2.0 -> MPL-2.0)
-	func(s string) string { return strings.ReplaceAll(s, "/", "-") },
-	// GPL-2.0, GPL-3.0 -> add -only or -or-later
-	func(s string) string {
+	}},
+	{"slash-to-dash", "MPL/2.0 -> MPL-2.0", func(s string) string { return strings.ReplaceAll(s, "/", "-") }},
+	{"gpl-suffix", "GPL-2.0 -> GPL-2.0-only, GPL-3.0 -> GPL-3.0-or-later", func(s string) string {
 		if strings.Contains(s, "3.0") {
 			return s + "-or-later"
 		}
 		return s + "-only"
-	},
-	// GPL-2.0- -> GPL-2.0-only
-	func(s string) string {
+	}},
+	{"dangling-dash", "GPL-2.0- -> GPL-2.0-only", func(s string) string {
 		if strings.HasSuffix(s, "-") {
 			return s + "only"
 		}
 		return s
-	},
-	// GPL2 -> GPL-2.0
-	func(s string) string { return reTrailingDigit.ReplaceAllString(s, "-$1.0") },
-	// BSD 3 -> BSD-3-Clause
-	func(s string) string { return reBSDNum.ReplaceAllString(s, "-$2-Clause") },
-	// BSD clause 3 -> BSD-3-Clause
-	func(s string) string { return reBSDClause.ReplaceAllString(s, "-$3-Clause") },
-	// New BSD -> BSD-3-Clause
-	func(s string) string { return reNewBSD.ReplaceAllString(s, "BSD-3-Clause") },
-	// Simplified BSD -> BSD-2-Clause
-	func(s string) string { return reSimplifiedBSD.ReplaceAllString(s, "BSD-2-Clause") },
-	// Free BSD -> BSD-2-Clause-FreeBSD
-	func(s string) string {
+	}},
+	{"attached-digit", "GPL2 -> GPL-2.0", func(s string) string { return reTrailingDigit.ReplaceAllString(s, "-$1.0") }},
+	{"bsd-number", "BSD 3 -> BSD-3-Clause", func(s string) string { return reBSDNum.ReplaceAllString(s, "-$2-Clause") }},
+	{"bsd-clause-number", "BSD clause 3 -> BSD-3-Clause", func(s string) string { return reBSDClause.ReplaceAllString(s, "-$3-Clause") }},
+	{"new-bsd", "New BSD -> BSD-3-Clause", func(s string) string { return reNewBSD.ReplaceAllString(s, "BSD-3-Clause") }},
+	{"simplified-bsd", "Simplified BSD -> BSD-2-Clause", func(s string) string { return reSimplifiedBSD.ReplaceAllString(s, "BSD-2-Clause") }},
+	{"free-net-bsd", "Free BSD -> BSD-2-Clause-FreeBSD", func(s string) string {
 		if reFreeNetBSD.MatchString(s) {
 			match := reFreeNetBSD.FindStringSubmatch(s)
 			if len(match) > 1 {
@@ -222,20 +152,16 @@ var transforms = []transform{
 			}
 		}
 		return s
-	},
-	// Clear BSD -> BSD-3-Clause-Clear
-	func(s string) string { return reClearBSD.ReplaceAllString(s, "BSD-3-Clause-Clear") },
-	// Old BSD -> BSD-4-Clause
-	func(s string) string { return reOldBSD.ReplaceAllString(s, "BSD-4-Clause") },
-	// BY-NC-4.0 -> CC-BY-NC-4.0
-	func(s string) string {
+	}},
+	{"clear-bsd", "Clear BSD -> BSD-3-Clause-Clear", func(s string) string { return reClearBSD.ReplaceAllString(s, "BSD-3-Clause-Clear") }},
+	{"old-bsd", "Old BSD -> BSD-4-Clause", func(s string) string { return reOldBSD.ReplaceAllString(s, "BSD-4-Clause") }},
+	{"cc-prefix", "BY-NC-4.0 -> CC-BY-NC-4.0", func(s string) string {
 		if strings.HasPrefix(strings.ToUpper(s), "BY-") {
 			return "CC-" + s
 		}
 		return s
-	},
-	// Attribution-NonCommercial -> CC-BY-NC-4.0
-	func(s string) string {
+	}},
+	{"cc-words", "Attribution-NonCommercial -> CC-BY-NC-4.0", func(s string) string {
 		result := s
 		result = strings.ReplaceAll(result, "Attribution", "BY")
 		result = strings.ReplaceAll(result, "NonCommercial", "NC")
@@ -250,7 +176,41 @@ var transforms = []transform{
 			}
 		}
 		return result
-	},
+	}},
+}
+
+// rulesetTransforms are transforms added after RulesetV1. They run after
+// transforms, from the ruleset that introduced them on.
+var rulesetTransforms = []struct {
+	since Ruleset
+	transformRule
+}{
+	{RulesetV3, transformRule{"concatenated", "apache20 -> Apache-2.0, bsd3clause -> BSD-3-Clause", splitConcatenated}},
+}
+
+// transformsByRuleset holds the transforms each ruleset applies, in order.
+var transformsByRuleset [rulesetNewest + 1][]transformRule
+
+// splitConcatenated puts separators back into identifiers written without
+// them, as in slug fields and URL path segments: "apache20" becomes
+// "apache-2.0", "gplv30" becomes "gpl-3.0" and "bsd3clause" becomes
+// "bsd-3-Clause". A two-digit group is read as major and minor version.
+func splitConcatenated(s string) string {
+	m := reConcatenated.FindStringSubmatch(s)
+	if m == nil {
+		return s
+	}
+	name, major, minor, clause := m[1], m[2], m[3], m[4]
+	if clause != "" {
+		if minor != "" {
+			return s
+		}
+		return name + "-" + major + "-Clause"
+	}
+	if minor == "" {
+		minor = "0"
+	}
+	return name + "-" + major + "." + minor
 }
 
 // lastResort maps substrings to their canonical license identifiers.
@@ -359,6 +319,42 @@ var lastResorts = []lastResort{
 	{"WXWIDGETS", "wxWindows"},
 }
 
+// boilerplatePhrases are wording that surrounds a license name in package
+// metadata without being part of it, like "Released under the MIT license".
+var boilerplatePhrases = []string{
+	"released under the terms of the",
+	"licensed under the terms of the",
+	"distributed under the terms of the",
+	"available under the terms of the",
+	"under the terms of the",
+	"released under the",
+	"licensed under the",
+	"distributed under the",
+	"available under the",
+	"released under",
+	"licensed under",
+	"distributed under",
+	"available under",
+	"this software is",
+	"this project is",
+	"this package is",
+	"this library is",
+	"see the license file",
+	"see license file",
+	"see license",
+	"see copying",
+	"free for non-commercial use",
+	"free for commercial use",
+	"all rights reserved",
+}
+
+// boilerplateRe matches any phrase in boilerplatePhrases, longest first.
+var boilerplateRe *regexp.Regexp
+
+// emptyParensRe matches parentheses left empty by stripping, as in
+// "MIT (see LICENSE)".
+var emptyParensRe = regexp.MustCompile(`\(\s*\)`)
+
 func init() {
 	// Build transpositions from data with pre-computed fields
 	transpositions = make([]transposition, len(transpositionData))
@@ -380,6 +376,25 @@ func init() {
 		return transpositions[i].from < transpositions[j].from
 	})
 
+	// Build the boilerplate matcher, longest phrase first so alternation
+	// prefers "licensed under the" over "licensed under"
+	phrases := make([]string, len(boilerplatePhrases))
+	for i, p := range boilerplatePhrases {
+		phrases[i] = regexp.QuoteMeta(p)
+	}
+	sort.Slice(phrases, func(i, j int) bool { return len(phrases[i]) > len(phrases[j]) })
+	boilerplateRe = regexp.MustCompile(`(?i)\b(?:` + strings.Join(phrases, "|") + `)\b`)
+
+	for r := RulesetV1; r <= rulesetNewest; r++ {
+		list := slices.Clone(transforms)
+		for _, t := range rulesetTransforms {
+			if r >= t.since {
+				list = append(list, t.transformRule)
+			}
+		}
+		transformsByRuleset[r] = list
+	}
+
 	// Sort lastResorts by length (longest first)
 	sort.Slice(lastResorts, func(i, j int) bool {
 		li, lj := len(lastResorts[i].substring), len(lastResorts[j].substring)
@@ -390,23 +405,150 @@ func init() {
 	})
 }
 
+// stripBoilerplate removes boilerplate phrases from s, along with the
+// punctuation and parentheses left around what remains. It returns s
+// unchanged when no phrase matches.
+//
+// Example:
+//
+//	stripBoilerplate("Licensed under LGPL 2.1, see LICENSE")  // "LGPL 2.1"
+//	stripBoilerplate("Free for non-commercial use (GPL)")     // "GPL"
+func stripBoilerplate(s string) string {
+	if !boilerplateRe.MatchString(s) {
+		return s
+	}
+	stripped := boilerplateRe.ReplaceAllString(s, " ")
+	stripped = emptyParensRe.ReplaceAllString(stripped, " ")
+	stripped = strings.Join(strings.Fields(stripped), " ")
+	stripped = strings.Trim(stripped, " ,.;:")
+	if inner, ok := strings.CutPrefix(stripped, "("); ok {
+		if inner, ok = strings.CutSuffix(inner, ")"); ok && !strings.ContainsAny(inner, "()") {
+			stripped = strings.TrimSpace(inner)
+		}
+	}
+	return stripped
+}
+
+// reInvertedVersion matches phrasing that puts the version before the
+// license name, as in "version 2 of the GNU General Public License" or
+// "v3 or later of the GPL". The or-later clause may come either side of
+// the name.
+var reInvertedVersion = regexp.MustCompile(`(?i)^(?:the\s+)?(?:version|ver\.?|v\.?)\s*(\d+(?:\.\d+)*)(\s*\+|,?\s+or\s+(?:\(at\s+your\s+option\)\s+)?(?:any\s+)?later(?:\s+versions?)?)?\s+of\s+(?:the\s+)?(.+?)(,?\s+or\s+(?:\(at\s+your\s+option\)\s+)?(?:any\s+)?later(?:\s+versions?)?)?\s*$`)
+
+// reorderVersion rewrites inverted version phrasing into the name-first
+// form the transpositions match. It returns s unchanged when the phrasing
+// doesn't match, or when the name is just "License", which identifies
+// nothing without the surrounding text.
+//
+// Example:
+//
+//	reorderVersion("version 2 of the GNU General Public License")  // "GNU General Public License v2"
+//	reorderVersion("v3 of the GPL or any later version")           // "GPL v3+"
+func reorderVersion(s string) string {
+	m := reInvertedVersion.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return s
+	}
+	name := strings.TrimRight(m[3], " ,")
+	if strings.EqualFold(name, "license") || strings.EqualFold(name, "licence") {
+		return s
+	}
+	reordered := name + " v" + m[1]
+	if m[2] != "" || m[4] != "" {
+		reordered += "+"
+	}
+	return reordered
+}
+
+// orLaterClause matches the wordings licenses use for "this version or
+// any later one", from "or later" to the full GPL header text "or (at
+// your option) any later version published by the Free Software
+// Foundation".
+const orLaterClause = `,?\s+or\s+(?:\(?\s*at\s+your\s+option\s*\)?,?\s+)?(?:any\s+)?(?:later|newer|greater)(?:\s+versions?)?(?:\s+published\s+by\s+the\s+(?:Free\s+Software\s+Foundation|FSF))?`
+
+var (
+	reTrailingOrLater = regexp.MustCompile(`(?i)` + orLaterClause + `[\s.]*$`)
+	reTrailingOnly    = regexp.MustCompile(`(?i)(?:,\s*|\s+)\(?only\)?[\s.]*$`)
+)
+
+// Qualifiers foldQualifier recognizes.
+const (
+	qualifierNone = iota
+	qualifierOrLater
+	qualifierOnly
+)
+
+// foldQualifier splits a trailing "or any later version" or "only" off s,
+// returning what is left and which qualifier it was. It returns s and
+// qualifierNone when there is no qualifier.
+//
+// Example:
+//
+//	foldQualifier("GPL 2, or (at your option) any later version")  // "GPL 2", qualifierOrLater
+//	foldQualifier("GPL v2 only")                                   // "GPL v2", qualifierOnly
+func foldQualifier(s string) (string, int) {
+	if loc := reTrailingOrLater.FindStringIndex(s); loc != nil && loc[0] > 0 {
+		return s[:loc[0]], qualifierOrLater
+	}
+	if loc := reTrailingOnly.FindStringIndex(s); loc != nil && loc[0] > 0 {
+		return s[:loc[0]], qualifierOnly
+	}
+	return s, qualifierNone
+}
+
+// applyQualifier rewrites the version modifier of id to match qualifier.
+// GNU licenses get their -only identifier for qualifierOnly, which other
+// licenses have no form for, and every license takes + for
+// qualifierOrLater.
+func applyQualifier(reg *registry, id string, qualifier int) string {
+	base := strings.TrimSuffix(id, "+")
+	base = strings.TrimSuffix(base, "-or-later")
+	base = strings.TrimSuffix(base, "-only")
+	switch qualifier {
+	case qualifierOrLater:
+		return base + "+"
+	case qualifierOnly:
+		if only := reg.lookupLicense(base + "-only"); only != "" {
+			return only
+		}
+		return base
+	}
+	return id
+}
+
+// traceRule logs the normalization rule that turned input into result.
+func traceRule(cfg *config, rule, input, result string) {
+	cfg.debug("spdx: rule matched", "rule", rule, "input", input, "result", result)
+}
+
 // tryTransforms applies transform functions to try to get a valid license.
-func tryTransforms(s string) string {
+// Like the other try* stages it returns the canonical ID before the GPL
+// policy is applied.
+func tryTransforms(s string, cfg *config) string {
 	// Check if input has trailing +
 	hasPlus := strings.HasSuffix(s, "+")
 	base := strings.TrimSuffix(s, "+")
 
-	for _, t := range transforms {
-		transformed := strings.TrimSpace(t(s))
-		if transformed != s && lookupLicense(transformed) != "" {
-			return upgradeGPL(lookupLicense(transformed))
+	for _, t := range cfg.transforms() {
+		if cfg.ruleDisabled(transformRulePrefix, t.name) {
+			continue
+		}
+		transformed := strings.TrimSpace(t.apply(s))
+		if transformed != s {
+			if id := cfg.registry().lookupLicense(transformed); id != "" {
+				traceRule(cfg, transformRulePrefix+t.name, s, id)
+				return id
+			}
 		}
 
 		// Also try transform on base (without +) and add + back
 		if hasPlus {
-			transformedBase := strings.TrimSpace(t(base))
-			if transformedBase != base && lookupLicense(transformedBase) != "" {
-				return upgradeGPL(lookupLicense(transformedBase) + "+")
+			transformedBase := strings.TrimSpace(t.apply(base))
+			if transformedBase != base {
+				if id := cfg.registry().lookupLicense(transformedBase); id != "" {
+					traceRule(cfg, transformRulePrefix+t.name, s, id+"+")
+					return id + "+"
+				}
 			}
 		}
 	}
@@ -414,9 +556,12 @@ func tryTransforms(s string) string {
 }
 
 // tryTranspositions applies transpositions and then transforms.
-func tryTranspositions(s string) string {
+func tryTranspositions(s string, cfg *config) string {
 	sUpper := strings.ToUpper(s) // compute once
 	for _, trans := range transpositions {
+		if cfg.ruleDisabled(transpositionRulePrefix, trans.from) {
+			continue
+		}
 		if strings.Contains(s, trans.from) || strings.Contains(sUpper, trans.fromUpper) {
 			corrected := strings.ReplaceAll(s, trans.from, trans.to)
 			// Also try case-insensitive replacement using pre-compiled regex
@@ -425,12 +570,14 @@ func tryTranspositions(s string) string {
 			}
 
 			// Check if directly valid
-			if id := lookupLicense(corrected); id != "" {
-				return upgradeGPL(id)
+			if id := cfg.registry().lookupLicense(corrected); id != "" {
+				traceRule(cfg, transpositionRulePrefix+trans.from, s, id)
+				return id
 			}
 
 			// Try transforms on the corrected string
-			if result := tryTransforms(corrected); result != "" {
+			if result := tryTransforms(corrected, cfg); result != "" {
+				traceRule(cfg, transpositionRulePrefix+trans.from, s, result)
 				return result
 			}
 		}
@@ -439,27 +586,32 @@ func tryTranspositions(s string) string {
 }
 
 // tryLastResorts uses substring matching as a fallback.
-func tryLastResorts(s string) string {
+func tryLastResorts(s string, cfg *config) string {
 	upper := strings.ToUpper(s)
 	for _, lr := range lastResorts {
-		if strings.Contains(upper, lr.substring) {
-			return upgradeGPL(lr.license)
+		if strings.Contains(upper, lr.substring) && !cfg.ruleDisabled(lastResortRulePrefix, lr.substring) {
+			traceRule(cfg, lastResortRulePrefix+lr.substring, s, lr.license)
+			return lr.license
 		}
 	}
 	return ""
 }
 
 // tryTranspositionsWithLastResorts applies transpositions then last resorts.
-func tryTranspositionsWithLastResorts(s string) string {
+func tryTranspositionsWithLastResorts(s string, cfg *config) string {
 	sUpper := strings.ToUpper(s) // compute once
 	for _, trans := range transpositions {
+		if cfg.ruleDisabled(transpositionRulePrefix, trans.from) {
+			continue
+		}
 		if strings.Contains(s, trans.from) || strings.Contains(sUpper, trans.fromUpper) {
 			corrected := strings.ReplaceAll(s, trans.from, trans.to)
 			if corrected == s {
 				corrected = trans.re.ReplaceAllString(s, trans.to)
 			}
 
-			if result := tryLastResorts(corrected); result != "" {
+			if result := tryLastResorts(corrected, cfg); result != "" {
+				traceRule(cfg, transpositionRulePrefix+trans.from, s, result)
 				return result
 			}
 		}
@@ -484,3 +636,111 @@ func upgradeGPL(license string) string {
 		return license
 	}
 }
+
+// exceptionTranspositionData corrects common spellings of exception names
+// before the exception transforms run. The patterns match without regard
+// to case and are compiled once here rather than on every call.
+var exceptionTranspositionData = []struct {
+	from *regexp.Regexp
+	to   string
+}{
+	{foldPattern("GCC Runtime Library"), "GCC"},
+	{foldPattern("Runtime Library"), "GCC"},
+	{foldPattern("Class path"), "Classpath"},
+	{foldPattern("wxWindows Library"), "WxWindows"},
+	{foldPattern("Exceptions"), "Exception"},
+}
+
+// exceptionDefaults maps versionless exception names to the version most
+// projects mean, like the GPL upgrades for bare license names.
+var exceptionDefaults = map[string]string{
+	"autoconf-exception":         "Autoconf-exception-3.0",
+	"bison-exception":            "Bison-exception-2.2",
+	"classpath-exception":        "Classpath-exception-2.0",
+	"clisp-exception":            "CLISP-exception-2.0",
+	"ecos-exception":             "eCos-exception-2.0",
+	"font-exception":             "Font-exception-2.0",
+	"freertos-exception":         "freertos-exception-2.0",
+	"gcc-exception":              "GCC-exception-3.1",
+	"openjdk-assembly-exception": "OpenJDK-assembly-exception-1.0",
+	"qt-gpl-exception":           "Qt-GPL-exception-1.0",
+	"qt-lgpl-exception":          "Qt-LGPL-exception-1.1",
+	"u-boot-exception":           "u-boot-exception-2.0",
+	"universal-foss-exception":   "Universal-FOSS-exception-1.0",
+	"wxwindows-exception":        "WxWindows-exception-3.1",
+}
+
+var (
+	reExceptionVersion = regexp.MustCompile(`(?i)[\s,-]*\b(v\.?|version)\s*(\d)`)
+	reExceptionTrailer = regexp.MustCompile(`^(.*?)-(\d+(?:\.\d+)*)$`)
+	reDashes           = regexp.MustCompile(`-+`)
+)
+
+// normalizeException converts an informal exception name to its canonical
+// SPDX exception ID, or returns "" if no match is found.
+//
+// Example:
+//
+//	normalizeException("classpath exception 2.0")  // "Classpath-exception-2.0"
+//	normalizeException("Classpath Exception")      // "Classpath-exception-2.0"
+//	normalizeException("LLVM exception")           // "LLVM-exception"
+func normalizeException(s string, cfg *config) string {
+	s = strings.TrimSpace(s)
+	if id := cfg.registry().lookupException(s); id != "" {
+		return id
+	}
+	if isAdditionRef(s) {
+		return s
+	}
+
+	for _, t := range exceptionTranspositionData {
+		s = t.from.ReplaceAllLiteralString(s, t.to)
+	}
+	s = strings.TrimPrefix(s, "the ")
+	s = strings.TrimPrefix(s, "The ")
+
+	// "Classpath exception, version 2.0" -> "Classpath-exception-2.0"
+	s = reExceptionVersion.ReplaceAllString(s, "-$2")
+	s = reWhitespace.ReplaceAllString(s, "-")
+	s = strings.Trim(reDashes.ReplaceAllString(s, "-"), "-")
+
+	for _, candidate := range exceptionCandidates(s) {
+		if id := cfg.registry().lookupException(candidate); id != "" {
+			return id
+		}
+		if id := exceptionDefaults[strings.ToLower(candidate)]; id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// exceptionCandidates returns dashed spellings to try for an exception
+// name: the name itself, with a ".0" minor version added, and with a
+// missing "-exception" word inserted before the version.
+func exceptionCandidates(s string) []string {
+	base, version := s, ""
+	if m := reExceptionTrailer.FindStringSubmatch(s); m != nil {
+		base, version = m[1], m[2]
+	}
+	if !strings.Contains(strings.ToLower(base), "exception") {
+		base += "-exception"
+	}
+
+	candidates := []string{s}
+	if version == "" {
+		return append(candidates, base)
+	}
+	candidates = append(candidates, base+"-"+version)
+	if !strings.Contains(version, ".") {
+		candidates = append(candidates, s+".0", base+"-"+version+".0")
+	}
+	return candidates
+}
+
+// foldPattern returns a regexp matching old literally, ignoring case.
+// Lowercasing can change the length of non-ASCII text, so the match is
+// made by a case-insensitive regexp rather than on a lowered copy.
+func foldPattern(old string) *regexp.Regexp {
+	return regexp.MustCompile("(?i)" + regexp.QuoteMeta(old))
+}
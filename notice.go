@@ -0,0 +1,187 @@
+package spdx
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// NoticeEntry is one attribution in a NOTICE file: a paragraph naming a
+// component, its copyright lines and the license it is under.
+type NoticeEntry struct {
+	// Component is the component or organization the entry attributes,
+	// if it names one.
+	Component string `json:"component,omitempty"`
+	// Copyrights are the entry's copyright lines.
+	Copyrights []string `json:"copyrights,omitempty"`
+	// License is the normalized expression of the license the entry
+	// names, if any.
+	License string `json:"license,omitempty"`
+	// LicenseName is the license as the entry words it. It is set with
+	// License empty when the name does not normalize.
+	LicenseName string `json:"license_name,omitempty"`
+	// Span is the entry in the NOTICE text.
+	Span TextSpan `json:"span"`
+}
+
+// Notice is a parsed NOTICE file, as Apache-2.0 section 4(d) requires a
+// distribution to pass on.
+type Notice struct {
+	// Product is the product the NOTICE is for, from its first line. Its
+	// entry is Entries[0].
+	Product string        `json:"product,omitempty"`
+	Entries []NoticeEntry `json:"entries"`
+}
+
+// NoticeIssue is an entry of a Notice whose component does not map to a
+// known license.
+type NoticeIssue struct {
+	Entry     int    `json:"entry"` // index into Notice.Entries
+	Component string `json:"component"`
+	Message   string `json:"message"`
+}
+
+var (
+	// reNoticeCopyright matches a copyright line.
+	reNoticeCopyright = regexp.MustCompile(`(?i)^(?:copyright\b|\(c\)|©)`)
+
+	// reNoticeIncludes matches the "This product includes ..." sentence
+	// an attribution starts with, capturing the component or developer.
+	reNoticeIncludes = regexp.MustCompile(`(?i)\bthis (?:product|software|project|distribution) (?:includes|contains|bundles|uses|incorporates) (?:software (?:developed )?(?:by|at|from) )?(?:the )?(.+?)(?: \(|[,;]|\.(?: |$)| (?:which|that|under|licensed|released|available|developed|from)\b|$)`)
+
+	// reNoticeLicense matches the license an entry says it is under,
+	// capturing its name and any version that follows a comma.
+	reNoticeLicense = regexp.MustCompile(`(?i)\b(?:licen[cs]ed|released|available|distributed|provided) under (?:the terms of )?(?:the |an? )?(.+?)(?:, version ([\d.]+))?(?: \(|[,;]|\.(?: |$)| (?:for|see|and|which|at)\b|$)`)
+)
+
+// ParseNotice splits a NOTICE file into its attribution entries, one per
+// paragraph, and reads the component, copyright lines and license of
+// each. Lines of dashes or equals signs separate paragraphs too. The
+// first paragraph is taken as the product's own entry when its first
+// line is neither a copyright line nor an attribution sentence.
+//
+// A component is named by a "This product includes ..." sentence, or by
+// the first line of a paragraph that goes on with copyright lines. A
+// license is an SPDX expression in the entry or a name after "licensed
+// under", which is normalized.
+//
+// Example:
+//
+//	n := ParseNotice("Acme Widgets\nCopyright 2024 Acme Inc.\n\n" +
+//		"This product bundles jQuery, which is available under an \"MIT\" license.")
+//	n.Product              // "Acme Widgets"
+//	n.Entries[1].Component // "jQuery"
+//	n.Entries[1].License   // "MIT"
+func ParseNotice(text string) Notice {
+	cfg := loadConfig()
+	n := Notice{Entries: []NoticeEntry{}}
+	for i, span := range noticeParagraphs(text) {
+		entry := parseNoticeEntry(span, cfg)
+		if i == 0 {
+			first, _, _ := strings.Cut(span.Text, "\n")
+			first = strings.TrimSpace(first)
+			if !reNoticeCopyright.MatchString(first) && !reNoticeIncludes.MatchString(first) && len(first) <= 80 {
+				n.Product = first
+				entry.Component = first
+			}
+		}
+		n.Entries = append(n.Entries, entry)
+	}
+	return n
+}
+
+// Check reports the entries whose component maps to no known license:
+// those that name no license, or a license that does not normalize. The
+// product's own entry is covered by the product's license and is not
+// checked. licenses maps component names to expressions, such as from an
+// SBOM, for entries that name a component but no license; it may be nil.
+//
+// Example:
+//
+//	n := ParseNotice(text)
+//	for _, issue := range n.Check(map[string]string{"Joda.org": "Apache-2.0"}) {
+//		fmt.Println(issue.Component, issue.Message)
+//	}
+func (n Notice) Check(licenses map[string]string) []NoticeIssue {
+	cfg := loadConfig()
+	var issues []NoticeIssue
+	for i, entry := range n.Entries {
+		if entry.Component == "" || (i == 0 && n.Product != "") || entry.License != "" {
+			continue
+		}
+		issue := NoticeIssue{Entry: i, Component: entry.Component}
+		if entry.LicenseName != "" {
+			issue.Message = fmt.Sprintf("license %q is not a known license", entry.LicenseName)
+		} else if expr, ok := licenses[entry.Component]; !ok {
+			issue.Message = "names no license"
+		} else if _, err := normalize(expr, cfg); err != nil {
+			issue.Message = fmt.Sprintf("maps to %q: %v", expr, err)
+		} else {
+			continue
+		}
+		issues = append(issues, issue)
+	}
+	return issues
+}
+
+// noticeParagraphs splits text at blank lines and separator lines.
+func noticeParagraphs(text string) []TextSpan {
+	var spans []TextSpan
+	start := 0
+	add := func(end int) {
+		s := text[start:end]
+		if trimmed := strings.TrimSpace(s); trimmed != "" {
+			at := start + strings.Index(s, trimmed)
+			spans = append(spans, TextSpan{Start: at, End: at + len(trimmed), Text: trimmed})
+		}
+	}
+	for offset := 0; offset < len(text); {
+		end := strings.IndexByte(text[offset:], '\n')
+		if end < 0 {
+			end = len(text)
+		} else {
+			end += offset
+		}
+		if line := text[offset:end]; strings.TrimSpace(line) == "" || reSeparatorLine.MatchString(line) {
+			add(offset)
+			start = end
+		}
+		offset = end + 1
+	}
+	add(len(text))
+	return spans
+}
+
+// parseNoticeEntry reads the component, copyrights and license of one
+// paragraph of a NOTICE file.
+func parseNoticeEntry(span TextSpan, cfg *config) NoticeEntry {
+	entry := NoticeEntry{Span: span}
+	lines := strings.Split(span.Text, "\n")
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); reNoticeCopyright.MatchString(line) {
+			entry.Copyrights = append(entry.Copyrights, line)
+		}
+	}
+
+	text := collapseSpace(span.Text)
+	if m := reNoticeIncludes.FindStringSubmatch(text); m != nil {
+		entry.Component = m[1]
+	} else if first := strings.TrimSpace(lines[0]); len(lines) > 1 && len(first) <= 80 &&
+		!reNoticeCopyright.MatchString(first) && reNoticeCopyright.MatchString(strings.TrimSpace(lines[1])) {
+		entry.Component = first
+	}
+
+	if found := FindExpressions(text); len(found) > 0 {
+		entry.License = found[0].Expression.String()
+		entry.LicenseName = found[0].Text
+	} else if m := reNoticeLicense.FindStringSubmatch(text); m != nil {
+		entry.LicenseName = m[1]
+		if m[2] != "" {
+			entry.LicenseName += ", Version " + m[2]
+		}
+		if license, err := normalize(entry.LicenseName, cfg); err == nil {
+			entry.License = license
+		}
+	}
+	return entry
+}
@@ -0,0 +1,113 @@
+package spdx
+
+import (
+	"slices"
+	"testing"
+)
+
+const apacheNotice = `Apache Commons Lang
+Copyright 2001-2024 The Apache Software Foundation
+
+This product includes software developed at
+The Apache Software Foundation (https://www.apache.org/).
+
+This product bundles jQuery 3.5, which is available under an "MIT" license.
+For details, see licenses/jquery.txt.
+
+=====================================================
+libfoo
+Copyright (c) 2010 Foo Inc.
+Copyright (c) 2015 Bar Ltd.
+Licensed under the Apache License, Version 2.0 (the "License").
+
+This product includes Joda-Time, licensed under the Frobnicate License.
+
+This product includes zlib, distributed under Zlib OR MIT.
+`
+
+func TestParseNotice(t *testing.T) {
+	n := ParseNotice(apacheNotice)
+	if n.Product != "Apache Commons Lang" {
+		t.Errorf("Product = %q", n.Product)
+	}
+
+	tests := []struct {
+		component   string
+		copyrights  []string
+		license     string
+		licenseName string
+	}{
+		{"Apache Commons Lang", []string{"Copyright 2001-2024 The Apache Software Foundation"}, "", ""},
+		{"Apache Software Foundation", nil, "", ""},
+		{"jQuery 3.5", nil, "MIT", "MIT"},
+		{"libfoo", []string{"Copyright (c) 2010 Foo Inc.", "Copyright (c) 2015 Bar Ltd."}, "Apache-2.0", "Apache License, Version 2.0"},
+		{"Joda-Time", nil, "", "Frobnicate License"},
+		{"zlib", nil, "Zlib OR MIT", "Zlib OR MIT"},
+	}
+	if len(n.Entries) != len(tests) {
+		t.Fatalf("got %d entries, want %d: %+v", len(n.Entries), len(tests), n.Entries)
+	}
+	for i, tt := range tests {
+		e := n.Entries[i]
+		if e.Component != tt.component || !slices.Equal(e.Copyrights, tt.copyrights) || e.License != tt.license || e.LicenseName != tt.licenseName {
+			t.Errorf("entry %d = %q %q %q %q, want %q %q %q %q", i,
+				e.Component, e.Copyrights, e.License, e.LicenseName,
+				tt.component, tt.copyrights, tt.license, tt.licenseName)
+		}
+		if apacheNotice[e.Span.Start:e.Span.End] != e.Span.Text {
+			t.Errorf("entry %d span %d-%d does not match its text", i, e.Span.Start, e.Span.End)
+		}
+	}
+}
+
+func TestParseNoticeWithoutProduct(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string // components
+	}{
+		{"", nil},
+		{"This product includes software developed by Joda.org (https://www.joda.org/).", []string{"Joda.org"}},
+		{"Copyright 2024 Acme Inc.\n\nThis product contains Guava, released under Apache-2.0.", []string{"", "Guava"}},
+	}
+	for _, tt := range tests {
+		n := ParseNotice(tt.input)
+		if n.Product != "" {
+			t.Errorf("ParseNotice(%q).Product = %q, want none", tt.input, n.Product)
+		}
+		var got []string
+		for _, e := range n.Entries {
+			got = append(got, e.Component)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("ParseNotice(%q) components = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestNoticeCheck(t *testing.T) {
+	n := ParseNotice(apacheNotice)
+
+	issues := n.Check(nil)
+	var got []string
+	for _, issue := range issues {
+		got = append(got, issue.Component)
+	}
+	if want := []string{"Apache Software Foundation", "Joda-Time"}; !slices.Equal(got, want) {
+		t.Errorf("Check(nil) = %+v, want issues for %q", issues, want)
+	}
+
+	tests := []struct {
+		licenses map[string]string
+		want     int
+	}{
+		{map[string]string{"Apache Software Foundation": "Apache-2.0"}, 1},
+		{map[string]string{"Apache Software Foundation": "apache 2"}, 1},
+		{map[string]string{"Apache Software Foundation": "FAKEYLICENSE"}, 2},
+		{map[string]string{"Joda-Time": "Apache-2.0"}, 2}, // the entry's own license is not overridden
+	}
+	for _, tt := range tests {
+		if issues := n.Check(tt.licenses); len(issues) != tt.want {
+			t.Errorf("Check(%v) = %+v, want %d issues", tt.licenses, issues, tt.want)
+		}
+	}
+}
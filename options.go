@@ -0,0 +1,224 @@
+package spdx
+
+import (
+	"log/slog"
+	"sync"
+	"sync/atomic"
+)
+
+// GPLPolicy controls how bare GPL-family identifiers such as GPL-2.0 or
+// LGPL-3.0+, which the SPDX license list has deprecated, are normalized.
+type GPLPolicy int
+
+const (
+	// GPLUpgrade maps bare version 1.0/2.0/2.1 identifiers to -only and
+	// bare 3.0 identifiers to -or-later, matching what most packages mean
+	// by "GPL v3". A trailing + always becomes -or-later. This is the default.
+	GPLUpgrade GPLPolicy = iota
+	// GPLOnly maps every bare identifier to -only, following the SPDX
+	// license list's own replacement for the deprecated IDs. A trailing +
+	// still becomes -or-later.
+	GPLOnly
+	// GPLPreserve leaves deprecated identifiers as they are.
+	GPLPreserve
+)
+
+// Options configures package-level behavior of Parse, Normalize and the
+// functions built on them.
+type Options struct {
+	// GPLPolicy selects how deprecated GPL-family identifiers are rewritten.
+	GPLPolicy GPLPolicy
+
+	// Strict makes Parse and NormalizeExpression reject informal license
+	// names, as ParseStrict does.
+	Strict bool
+
+	// ApplyExceptions makes ExpressionCategories, HasCopyleft and
+	// IsFullyPermissive use the EffectiveCategory of licenses with a WITH
+	// exception, so GPL-2.0-only WITH Classpath-exception-2.0 counts as
+	// Copyleft Limited rather than Copyleft.
+	ApplyExceptions bool
+
+	// Frequencies, when set, resolves informal strings that the table
+	// covers to their most frequent target before the fuzzy matching
+	// stages run. Valid SPDX identifiers are never overridden.
+	Frequencies FrequencyTable
+
+	// MinConfidence rejects Normalize guesses whose matching stage scores
+	// below it with ErrLowConfidence. Parse and NormalizeExpressionLax
+	// apply it to each license name. Zero accepts every guess; see
+	// ConfidenceExact and the other Confidence constants for the scale.
+	MinConfidence float64
+
+	// Ruleset pins a version of the normalization heuristics. The zero
+	// value, RulesetLatest, follows the newest.
+	Ruleset Ruleset
+
+	// DisabledRules lists normalization rules to skip, by the IDs
+	// ActiveRules returns, such as "transform:remove-dots",
+	// "transposition:GNU" or "last-resort:MIT". Unknown IDs are ignored.
+	DisabledRules []string
+
+	// PublicDomain selects the identifier bare public domain statements
+	// normalize to from RulesetV3 on. The zero value,
+	// PublicDomainUnlicense, maps them to Unlicense.
+	PublicDomain PublicDomainPolicy
+
+	// NoAssertion selects how Parse and ParseStrict treat NOASSERTION.
+	// The zero value, NoAssertionAllow, accepts it.
+	NoAssertion NoAssertionPolicy
+
+	// Resolver, when set, lets Normalize and Parse resolve license
+	// strings that are only a URL, such as a link to a LICENSE file, the
+	// way ResolveURL and URLResolver do, fetching through it when the
+	// URL itself does not name the license. A resolved URL scores
+	// ConfidenceTransform. Nil keeps normalization offline. Wrap it with
+	// CachingResolver to fetch each URL once.
+	Resolver Resolver
+
+	// Order selects how ExtractLicenses and ExpressionCategories order
+	// their results. The zero value, OrderSorted, sorts them.
+	Order Order
+
+	// CacheSize is the number of Normalize results to memoize. Zero
+	// disables the cache. When the cache is full it is cleared.
+	CacheSize int
+
+	// Logger, when set, receives debug-level traces of normalization
+	// and policy decisions: the rule that matched each license string,
+	// its confidence, boilerplate and annotations removed by the lax
+	// parser, and Satisfies and Prune results. Nil disables tracing.
+	Logger *slog.Logger
+}
+
+// config is an immutable snapshot of Options plus the state derived from
+// them. Each top-level call loads the snapshot once, so a concurrent
+// SetDefaultOptions never mixes old and new settings within a call.
+type config struct {
+	opts     Options
+	cache    *normalizeCache
+	disabled map[string]bool // from opts.DisabledRules
+	reg      *registry       // license data; nil means the embedded data
+}
+
+var defaultConfig atomic.Pointer[config]
+
+func init() {
+	defaultConfig.Store(&config{})
+}
+
+// SetDefaultOptions replaces the package-level defaults. It is safe to call
+// concurrently with Parse and Normalize; calls already in progress finish
+// with the options they started with. Setting new options discards the
+// Normalize cache.
+//
+// Example:
+//
+//	spdx.SetDefaultOptions(spdx.Options{
+//		GPLPolicy: spdx.GPLOnly,
+//		CacheSize: 10000,
+//	})
+func SetDefaultOptions(opts Options) {
+	for {
+		old := loadConfig()
+		if defaultConfig.CompareAndSwap(old, newConfig(opts, old.reg)) {
+			return
+		}
+	}
+}
+
+// newConfig builds a config snapshot with an empty Normalize cache.
+func newConfig(opts Options, reg *registry) *config {
+	cfg := &config{opts: opts, reg: reg}
+	if opts.CacheSize > 0 {
+		cfg.cache = newNormalizeCache(opts.CacheSize)
+	}
+	if len(opts.DisabledRules) > 0 {
+		cfg.disabled = make(map[string]bool, len(opts.DisabledRules))
+		for _, id := range opts.DisabledRules {
+			cfg.disabled[id] = true
+		}
+	}
+	return cfg
+}
+
+// DefaultOptions returns the current package-level defaults.
+func DefaultOptions() Options {
+	return loadConfig().opts
+}
+
+func loadConfig() *config {
+	return defaultConfig.Load()
+}
+
+// debug logs a trace to Options.Logger. It is a no-op without a logger,
+// so callers only check c.opts.Logger first when building the attributes
+// allocates, as expr.String() does.
+func (c *config) debug(msg string, args ...any) {
+	if c.opts.Logger != nil {
+		c.opts.Logger.Debug(msg, args...)
+	}
+}
+
+// registry returns the license data for this snapshot.
+func (c *config) registry() *registry {
+	if c.reg != nil {
+		return c.reg
+	}
+	return builtinRegistry()
+}
+
+// upgrade applies the GPL policy to a canonical license ID, which may carry
+// a trailing +.
+func (c *config) upgrade(id string) string {
+	switch c.opts.GPLPolicy {
+	case GPLOnly:
+		switch id {
+		case "GPL-3.0", "LGPL-3.0", "AGPL-3.0":
+			return id + "-only"
+		}
+		return upgradeGPL(id)
+	case GPLPreserve:
+		return id
+	default:
+		return upgradeGPL(id)
+	}
+}
+
+// ruleDisabled reports whether the normalization rule with the given ID
+// prefix and match text is turned off.
+func (c *config) ruleDisabled(prefix, match string) bool {
+	return c.disabled != nil && c.disabled[prefix+match]
+}
+
+type cacheEntry struct {
+	id  string
+	err error
+}
+
+// normalizeCache memoizes Normalize results up to a fixed size.
+type normalizeCache struct {
+	mu      sync.RWMutex
+	size    int
+	entries map[string]cacheEntry
+}
+
+func newNormalizeCache(size int) *normalizeCache {
+	return &normalizeCache{size: size, entries: make(map[string]cacheEntry, size)}
+}
+
+func (c *normalizeCache) get(key string) (cacheEntry, bool) {
+	c.mu.RLock()
+	e, ok := c.entries[key]
+	c.mu.RUnlock()
+	return e, ok
+}
+
+func (c *normalizeCache) put(key string, e cacheEntry) {
+	c.mu.Lock()
+	if len(c.entries) >= c.size {
+		clear(c.entries)
+	}
+	c.entries[key] = e
+	c.mu.Unlock()
+}
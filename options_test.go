@@ -0,0 +1,178 @@
+package spdx
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// withOptions sets the package defaults for the duration of a test.
+func withOptions(t *testing.T, opts Options) {
+	t.Helper()
+	prev := DefaultOptions()
+	SetDefaultOptions(opts)
+	t.Cleanup(func() { SetDefaultOptions(prev) })
+}
+
+func TestGPLPolicy(t *testing.T) {
+	tests := []struct {
+		policy GPLPolicy
+		input  string
+		want   string
+	}{
+		{GPLUpgrade, "GPL-2.0", "GPL-2.0-only"},
+		{GPLUpgrade, "GPL-3.0", "GPL-3.0-or-later"},
+		{GPLUpgrade, "GPL v3", "GPL-3.0-or-later"},
+		{GPLUpgrade, "GPL-2.0+", "GPL-2.0-or-later"},
+		{GPLOnly, "GPL-2.0", "GPL-2.0-only"},
+		{GPLOnly, "GPL-3.0", "GPL-3.0-only"},
+		{GPLOnly, "LGPL-3.0", "LGPL-3.0-only"},
+		{GPLOnly, "GPL-3.0+", "GPL-3.0-or-later"},
+		{GPLPreserve, "GPL-2.0", "GPL-2.0"},
+		{GPLPreserve, "gpl-3.0", "GPL-3.0"},
+		{GPLPreserve, "GPL-3.0-only", "GPL-3.0-only"},
+		{GPLPreserve, "MIT License", "MIT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			withOptions(t, Options{GPLPolicy: tt.policy})
+			got, err := Normalize(tt.input)
+			if err != nil {
+				t.Fatalf("Normalize(%q) error = %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("Normalize(%q) with policy %d = %q, want %q", tt.input, tt.policy, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGPLPolicyParse(t *testing.T) {
+	withOptions(t, Options{GPLPolicy: GPLOnly})
+	got, err := NormalizeExpression("GPL-3.0 OR MIT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "GPL-3.0-only OR MIT"; got != want {
+		t.Errorf("NormalizeExpression = %q, want %q", got, want)
+	}
+
+	SetDefaultOptions(Options{GPLPolicy: GPLPreserve})
+	got, err = NormalizeExpression("GPL-3.0 OR MIT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "GPL-3.0 OR MIT"; got != want {
+		t.Errorf("NormalizeExpression = %q, want %q", got, want)
+	}
+}
+
+func TestStrictOption(t *testing.T) {
+	withOptions(t, Options{Strict: true})
+	if _, err := Parse("Apache 2 OR MIT License"); err == nil {
+		t.Error("Parse should reject informal names when Strict is set")
+	}
+	got, err := NormalizeExpression("mit OR apache-2.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "MIT OR Apache-2.0"; got != want {
+		t.Errorf("NormalizeExpression = %q, want %q", got, want)
+	}
+}
+
+func TestNormalizeCache(t *testing.T) {
+	withOptions(t, Options{CacheSize: 2})
+	cache := loadConfig().cache
+
+	for _, input := range []string{"Apache 2", "Apache 2", "not a license"} {
+		Normalize(input)
+	}
+	if e, ok := cache.get("Apache 2"); !ok || e.id != "Apache-2.0" {
+		t.Errorf("cache entry for %q = %+v, %v", "Apache 2", e, ok)
+	}
+	if e, ok := cache.get("not a license"); !ok || e.err != ErrInvalidLicense {
+		t.Errorf("cache should remember failures, got %+v, %v", e, ok)
+	}
+
+	// A third key clears the full cache
+	Normalize("MIT License")
+	if _, ok := cache.get("Apache 2"); ok {
+		t.Error("cache should be cleared when full")
+	}
+
+	SetDefaultOptions(Options{})
+	if loadConfig().cache != nil {
+		t.Error("CacheSize 0 should disable the cache")
+	}
+}
+
+func TestSetDefaultOptionsConcurrent(t *testing.T) {
+	withOptions(t, Options{})
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				SetDefaultOptions(Options{GPLPolicy: GPLPolicy(j % 3), CacheSize: j % 5})
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				got, err := Normalize("GPL-3.0")
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				switch got {
+				case "GPL-3.0", "GPL-3.0-only", "GPL-3.0-or-later":
+				default:
+					t.Errorf("Normalize(GPL-3.0) = %q", got)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	withOptions(t, Options{Logger: logger, MinConfidence: ConfidenceTransposition})
+
+	Normalize("Apache 2")
+	Normalize("MTI")
+	Normalize("GNU")
+	ParseLax("Licensed under BSD (3-clause)")
+	Satisfies("MIT OR Apache-2.0", []string{"MIT"})
+	Prune("MIT OR GPL-3.0-only", []string{"MIT"})
+
+	out := buf.String()
+	for _, want := range []string{
+		`msg="spdx: rule matched" rule=transform:`,
+		`input="Apache 2" result=Apache-2.0`,
+		`rule=transposition:MTI input=MTI result=MIT`,
+		`msg="spdx: guess below confidence threshold" input=GNU`,
+		`msg="spdx: stripped boilerplate" input="Licensed under BSD (3-clause)"`,
+		`msg="spdx: folded annotation" license=BSD annotation=3-clause`,
+		`msg="spdx: satisfies" expression="MIT OR Apache-2.0"`,
+		`msg="spdx: pruned"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log missing %q:\n%s", want, out)
+		}
+	}
+
+	// Info level suppresses the traces
+	buf.Reset()
+	withOptions(t, Options{Logger: slog.New(slog.NewTextHandler(&buf, nil))})
+	Normalize("Apache 2")
+	if buf.Len() != 0 {
+		t.Errorf("traces logged above debug level:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,39 @@
+package spdx
+
+import (
+	"slices"
+	"strings"
+)
+
+// Order selects how functions that return the licenses or categories of
+// an expression order their results.
+type Order int
+
+const (
+	// OrderSorted sorts licenses by identifier, comparing bytes. This is
+	// the default.
+	OrderSorted Order = iota
+	// OrderDocument keeps licenses in the order they first appear in the
+	// expression.
+	OrderDocument
+)
+
+// sortByLicense orders categories by the license each came from, keeping
+// the given order for OrderDocument.
+func sortByLicense(licenses []string, cats []Category, order Order) []Category {
+	if order == OrderDocument {
+		return cats
+	}
+	idx := make([]int, len(licenses))
+	for i := range idx {
+		idx[i] = i
+	}
+	slices.SortStableFunc(idx, func(a, b int) int {
+		return strings.Compare(licenses[a], licenses[b])
+	})
+	sorted := make([]Category, len(cats))
+	for i, j := range idx {
+		sorted[i] = cats[j]
+	}
+	return sorted
+}
@@ -0,0 +1,94 @@
+package spdx
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestExtractLicensesOrder(t *testing.T) {
+	tests := []struct {
+		expr     string
+		sorted   []string
+		document []string
+	}{
+		{"MIT OR Apache-2.0", []string{"Apache-2.0", "MIT"}, []string{"MIT", "Apache-2.0"}},
+		{"(MIT AND GPL-2.0-only) OR Apache-2.0 OR MIT", []string{"Apache-2.0", "GPL-2.0-only", "MIT"}, []string{"MIT", "GPL-2.0-only", "Apache-2.0"}},
+		{"ISC AND GPL-2.0-or-later WITH Classpath-exception-2.0", []string{"GPL-2.0-or-later", "ISC"}, []string{"ISC", "GPL-2.0-or-later"}},
+		{"mit OR apache-2.0", []string{"Apache-2.0", "MIT"}, []string{"MIT", "Apache-2.0"}},
+		{"GPL-2.0+ OR LicenseRef-Internal OR (MIT AND GPL-2.0+)", []string{"GPL-2.0+", "LicenseRef-Internal", "MIT"}, []string{"GPL-2.0+", "LicenseRef-Internal", "MIT"}},
+	}
+
+	for _, tt := range tests {
+		sorted, err := ExtractLicenses(tt.expr)
+		if err != nil {
+			t.Fatalf("ExtractLicenses(%q): %v", tt.expr, err)
+		}
+		if tt.sorted != nil && !slices.Equal(sorted, tt.sorted) {
+			t.Errorf("ExtractLicenses(%q) = %v, want %v", tt.expr, sorted, tt.sorted)
+		}
+		if !slices.IsSorted(sorted) {
+			t.Errorf("ExtractLicenses(%q) = %v, not sorted", tt.expr, sorted)
+		}
+	}
+
+	for _, expr := range []string{"", "(", "MIT OR", "FAKEYLICENSE"} {
+		if _, err := ExtractLicenses(expr); err == nil {
+			t.Errorf("ExtractLicenses(%q) succeeded, want an error", expr)
+		}
+	}
+
+	withOptions(t, Options{Order: OrderDocument})
+	for _, tt := range tests {
+		if tt.document == nil {
+			continue
+		}
+		got, err := ExtractLicenses(tt.expr)
+		if err != nil {
+			t.Fatalf("ExtractLicenses(%q): %v", tt.expr, err)
+		}
+		if !slices.Equal(got, tt.document) {
+			t.Errorf("OrderDocument ExtractLicenses(%q) = %v, want %v", tt.expr, got, tt.document)
+		}
+	}
+}
+
+func TestExpressionCategoriesOrder(t *testing.T) {
+	const expr = "MIT OR GPL-3.0-only"
+
+	cats, err := ExpressionCategories(expr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// GPL-3.0-only sorts before MIT
+	if want := []Category{CategoryCopyleft, CategoryPermissive}; !slices.Equal(cats, want) {
+		t.Errorf("ExpressionCategories(%q) = %v, want %v", expr, cats, want)
+	}
+
+	for _, opts := range []Options{{Order: OrderDocument}, {Order: OrderDocument, ApplyExceptions: true}} {
+		withOptions(t, opts)
+		cats, err := ExpressionCategories(expr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := []Category{CategoryPermissive, CategoryCopyleft}; !slices.Equal(cats, want) {
+			t.Errorf("%+v: ExpressionCategories(%q) = %v, want %v", opts, expr, cats, want)
+		}
+	}
+
+	withOptions(t, Options{ApplyExceptions: true})
+	cats, err = ExpressionCategories(expr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []Category{CategoryCopyleft, CategoryPermissive}; !slices.Equal(cats, want) {
+		t.Errorf("ApplyExceptions ExpressionCategories(%q) = %v, want %v", expr, cats, want)
+	}
+}
+
+func TestListLicensesSorted(t *testing.T) {
+	infos := ListLicenses()
+	if !slices.IsSortedFunc(infos, func(a, b *LicenseInfo) int { return strings.Compare(a.Key, b.Key) }) {
+		t.Error("ListLicenses should be sorted by key")
+	}
+}
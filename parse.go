@@ -11,8 +11,13 @@ import (
 type Expression interface {
 	// String returns the normalized string representation.
 	String() string
-	// Licenses returns all license identifiers in the expression.
+	// Licenses returns all license identifiers in the expression, in the
+	// order they appear and including duplicates.
 	Licenses() []string
+	// IsNone reports whether the expression is NONE.
+	IsNone() bool
+	// IsNoAssertion reports whether the expression is NOASSERTION.
+	IsNoAssertion() bool
 	isExpr()
 }
 
@@ -21,6 +26,8 @@ type License struct {
 	ID       string // The canonical license ID
 	Plus     bool   // True if followed by +
 	Exception string // Exception ID if using WITH
+
+	Annotations Annotations // Curation notes; never part of String
 }
 
 func (l *License) String() string {
@@ -44,6 +51,8 @@ func (l *License) isExpr() {}
 type LicenseRef struct {
 	DocumentRef string // Optional document reference
 	LicenseRef  string // The license reference ID
+
+	Annotations Annotations // Curation notes; never part of String
 }
 
 func (l *LicenseRef) String() string {
@@ -63,6 +72,8 @@ func (l *LicenseRef) isExpr() {}
 type AndExpression struct {
 	Left  Expression
 	Right Expression
+
+	Annotations Annotations // Curation notes; never part of String
 }
 
 func (e *AndExpression) String() string {
@@ -90,6 +101,8 @@ func (e *AndExpression) isExpr() {}
 type OrExpression struct {
 	Left  Expression
 	Right Expression
+
+	Annotations Annotations // Curation notes; never part of String
 }
 
 func (e *OrExpression) String() string {
@@ -123,6 +136,8 @@ func (e *OrExpression) isExpr() {}
 // SpecialValue represents NONE or NOASSERTION.
 type SpecialValue struct {
 	Value string
+
+	Annotations Annotations // Curation notes; never part of String
 }
 
 func (s *SpecialValue) String() string {
@@ -144,6 +159,7 @@ var (
 	ErrInvalidException    = errors.New("invalid exception identifier")
 	ErrMissingOperand      = errors.New("missing operand")
 	ErrInvalidSpecialValue = errors.New("NONE and NOASSERTION must be standalone")
+	ErrNoAssertion         = errors.New("NOASSERTION not accepted")
 )
 
 // tokenType represents the type of a lexer token.
@@ -215,7 +231,7 @@ func (l *lexer) next() (token, error) {
 	}
 
 	if l.pos == start {
-		return token{}, fmt.Errorf("unexpected character: %c", ch)
+		return token{}, fmt.Errorf("%w: unexpected character %c", ErrUnexpectedToken, ch)
 	}
 
 	word := l.input[start:l.pos]
@@ -246,10 +262,12 @@ func (l *lexer) next() (token, error) {
 type parser struct {
 	lexer   *lexer
 	current token
+	pooled  bool      // allocate nodes from the pools in pool.go
+	reg     *registry // license data to resolve identifiers against
 }
 
-func newParser(input string) (*parser, error) {
-	p := &parser{lexer: newLexer(input)}
+func newParser(input string, reg *registry) (*parser, error) {
+	p := &parser{lexer: newLexer(input), reg: reg}
 	tok, err := p.lexer.next()
 	if err != nil {
 		return nil, err
@@ -279,22 +297,52 @@ func (p *parser) advance() error {
 //	Parse("GPL v3 AND BSD")          // normalizes to "GPL-3.0-or-later AND BSD-2-Clause"
 //
 // For strict SPDX-only parsing (no fuzzy normalization), use ParseStrict.
+// Package-wide behavior can be changed with SetDefaultOptions.
 func Parse(expression string) (Expression, error) {
+	return parse(expression, false)
+}
+
+// parse implements Parse. When pooled is set, AST nodes come from the
+// node pools used by ParsePooled.
+func parse(expression string, pooled bool) (Expression, error) {
+	return parseConfig(expression, pooled, loadConfig())
+}
+
+// parseConfig implements parse using the given options snapshot.
+func parseConfig(expression string, pooled bool, cfg *config) (Expression, error) {
+	if !cfg.opts.Strict && cfg.ruleset() >= RulesetV2 {
+		// Repair encoding damage like BOMs and mojibake before matching
+		expression = sanitizeInput(expression)
+	}
+
 	expression = strings.TrimSpace(expression)
 	if expression == "" {
 		return nil, ErrEmptyExpression
 	}
 
-	// Pre-process: normalize informal license names while preserving operators
-	normalized, err := normalizeExpressionString(expression)
-	if err != nil {
-		return nil, err
+	if !cfg.opts.Strict {
+		// Fast path: most inputs are already valid SPDX, so skip the lax
+		// normalization pipeline when a strict parse gives the same result
+		if expr, ok := parseCanonical(expression, pooled, cfg); ok {
+			if err := cfg.checkNoAssertion(expr); err != nil {
+				return nil, err
+			}
+			return expr, nil
+		}
+
+		// Pre-process: normalize informal license names while preserving operators
+		normalized, err := normalizeExpressionString(expression, cfg)
+		if err != nil {
+			return nil, err
+		}
+		expression = normalized
 	}
 
-	p, err := newParser(normalized)
+	p, err := newParser(expression, cfg.registry())
 	if err != nil {
 		return nil, err
 	}
+	p.pooled = pooled
 
 	expr, err := p.parseExpression()
 	if err != nil {
@@ -305,6 +353,9 @@ func Parse(expression string) (Expression, error) {
 		return nil, fmt.Errorf("%w: %s", ErrUnexpectedToken, p.current.value)
 	}
 
+	if err := cfg.checkNoAssertion(expr); err != nil {
+		return nil, err
+	}
 	return expr, nil
 }
 
@@ -318,12 +369,17 @@ func Parse(expression string) (Expression, error) {
 //	ParseStrict("MIT OR Apache-2.0")  // succeeds
 //	ParseStrict("mit OR apache 2")    // fails - "apache 2" is not a valid SPDX ID
 func ParseStrict(expression string) (Expression, error) {
+	return parseStrict(expression, loadConfig())
+}
+
+// parseStrict implements ParseStrict using the given options snapshot.
+func parseStrict(expression string, cfg *config) (Expression, error) {
 	expression = strings.TrimSpace(expression)
 	if expression == "" {
 		return nil, ErrEmptyExpression
 	}
 
-	p, err := newParser(expression)
+	p, err := newParser(expression, cfg.registry())
 	if err != nil {
 		return nil, err
 	}
@@ -337,9 +393,55 @@ func ParseStrict(expression string) (Expression, error) {
 		return nil, fmt.Errorf("%w: %s", ErrUnexpectedToken, p.current.value)
 	}
 
+	if err := cfg.checkNoAssertion(expr); err != nil {
+		return nil, err
+	}
 	return expr, nil
 }
 
+// parseCanonical parses an expression that uses only valid SPDX identifiers.
+// It reports false if the strict parse fails or if lax normalization would
+// change a license, such as upgrading GPL-2.0 to GPL-2.0-only.
+func parseCanonical(expression string, pooled bool, cfg *config) (Expression, bool) {
+	p, err := newParser(expression, cfg.registry())
+	if err != nil {
+		return nil, false
+	}
+	p.pooled = pooled
+
+	expr, err := p.parseExpression()
+	if err != nil || p.current.typ != tokenEOF {
+		return nil, false
+	}
+
+	if !isNormalized(expr, cfg) {
+		if pooled {
+			Release(expr)
+		}
+		return nil, false
+	}
+	return expr, true
+}
+
+// isNormalized reports whether every license in the tree is already in the
+// form lax normalization would produce.
+func isNormalized(expr Expression, cfg *config) bool {
+	switch e := expr.(type) {
+	case *License:
+		id := e.ID
+		if e.Plus {
+			id += "+"
+		}
+		return cfg.upgrade(id) == id
+	case *AndExpression:
+		return isNormalized(e.Left, cfg) && isNormalized(e.Right, cfg)
+	case *OrExpression:
+		return isNormalized(e.Left, cfg) && isNormalized(e.Right, cfg)
+	default:
+		return true
+	}
+}
+
 // parseExpression parses a full expression (handles OR, lowest precedence).
 func (p *parser) parseExpression() (Expression, error) {
 	left, err := p.parseAnd()
@@ -357,7 +459,7 @@ func (p *parser) parseExpression() (Expression, error) {
 			return nil, err
 		}
 
-		left = &OrExpression{Left: left, Right: right}
+		left = p.newOr(left, right)
 	}
 
 	return left, nil
@@ -380,7 +482,7 @@ func (p *parser) parseAnd() (Expression, error) {
 			return nil, err
 		}
 
-		left = &AndExpression{Left: left, Right: right}
+		left = p.newAnd(left, right)
 	}
 
 	return left, nil
@@ -404,17 +506,25 @@ func (p *parser) parseWith() (Expression, error) {
 			return nil, err
 		}
 
-		if p.current.typ != tokenLicense {
-			return nil, fmt.Errorf("%w: expected exception after WITH", ErrMissingOperand)
-		}
-
-		exception := lookupException(p.current.value)
-		if exception == "" {
+		switch p.current.typ {
+		case tokenLicense, tokenDocumentRef:
+			if isAdditionRef(p.current.value) {
+				// A user-defined exception, such as a rider; see DetectRiders
+				license.Exception = p.current.value
+				break
+			}
+			exception := p.reg.lookupException(p.current.value)
+			if exception == "" {
+				return nil, fmt.Errorf("%w: %s", ErrInvalidException, p.current.value)
+			}
+			license.Exception = exception
+		case tokenLicenseRef:
+			// A LicenseRef names a license, not an exception
 			return nil, fmt.Errorf("%w: %s", ErrInvalidException, p.current.value)
+		default:
+			return nil, fmt.Errorf("%w: expected exception after WITH", ErrMissingOperand)
 		}
 
-		license.Exception = exception
-
 		if err := p.advance(); err != nil {
 			return nil, err
 		}
@@ -459,12 +569,12 @@ func (p *parser) parseAtom() (Expression, error) {
 		}
 
 		// Look up the canonical license ID
-		id := lookupLicense(value)
+		id := p.reg.lookupLicense(value)
 		if id == "" {
 			return nil, fmt.Errorf("%w: %s", ErrInvalidLicenseID, value)
 		}
 
-		license := &License{ID: id}
+		license := p.newLicense(id)
 
 		if err := p.advance(); err != nil {
 			return nil, err
@@ -512,6 +622,17 @@ func parseLicenseRef(s string) *LicenseRef {
 	return &LicenseRef{LicenseRef: s}
 }
 
+// isAdditionRef reports whether s is a user-defined exception,
+// "AdditionRef-xxx" or "DocumentRef-xxx:AdditionRef-yyy", which SPDX allows
+// after WITH in place of a listed exception.
+func isAdditionRef(s string) bool {
+	upper := strings.ToUpper(s)
+	if strings.HasPrefix(upper, "DOCUMENTREF-") {
+		_, upper, _ = strings.Cut(upper, ":")
+	}
+	return strings.HasPrefix(upper, "ADDITIONREF-") && len(upper) > len("ADDITIONREF-")
+}
+
 // parseDocumentRef parses "DocumentRef-xxx:LicenseRef-yyy" into a LicenseRef.
 func parseDocumentRef(s string) *LicenseRef {
 	// Format: DocumentRef-xxx:LicenseRef-yyy
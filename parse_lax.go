@@ -1,6 +1,8 @@
 package spdx
 
 import (
+	"errors"
+	"regexp"
 	"strings"
 	"unicode"
 )
@@ -21,11 +23,48 @@ func ParseLax(expression string) (Expression, error) {
 
 // normalizeExpressionString normalizes informal license names in an expression string.
 // It preserves AND, OR, WITH operators and parentheses.
-func normalizeExpressionString(expr string) (string, error) {
-	tokens := tokenizeForNormalization(expr)
-	return normalizeTokens(tokens)
+func normalizeExpressionString(expr string, cfg *config) (string, error) {
+	stripped := expr
+	if unquoted := cfg.unquoteExpression(expr); unquoted != expr {
+		cfg.debug("spdx: unquoted input", "input", expr, "unquoted", unquoted)
+		stripped = unquoted
+	}
+	if s := cfg.stripBoilerplate(stripped); s != stripped {
+		cfg.debug("spdx: stripped boilerplate", "input", stripped, "stripped", s)
+		stripped = s
+	}
+	// Reorder before tokenizing, since an or-later clause in an inverted
+	// phrase would otherwise split on OR
+	if reordered := cfg.reorderVersion(stripped); reordered != stripped {
+		cfg.debug("spdx: reordered version phrase", "input", stripped, "reordered", reordered)
+		stripped = reordered
+	}
+	// Shorthand like "GPLv2+CE" uses + for the exception, so expand it
+	// before + is read as or-later
+	stripped = cfg.expandExceptionShorthand(stripped)
+	// An or-later clause would also split on OR, so attach it to the
+	// license it qualifies as +
+	if cfg.ruleset() >= RulesetV3 {
+		stripped = reOrLater.ReplaceAllString(stripped, "+")
+	}
+	tokens := tokenizeForNormalization(stripped)
+	if cfg.ruleset() >= RulesetV2 {
+		tokens = interpretAnnotations(tokens, cfg)
+	}
+	normalized, err := normalizeTokens(tokens, cfg)
+	if (err != nil || normalized == "") && !errors.Is(err, ErrProprietary) && !errors.Is(err, ErrLowConfidence) {
+		// Stripping can leave nothing of "All rights reserved", or a
+		// copyright holder's name before it, so judge the whole input
+		if perr := proprietaryError(expr); perr != nil {
+			return "", perr
+		}
+	}
+	return normalized, err
 }
 
+// reOrLater matches an or-later clause anywhere in an expression.
+var reOrLater = regexp.MustCompile(`(?i)` + orLaterClause + `\b`)
+
 // tokenForNorm represents a token during normalization.
 type tokenForNorm struct {
 	value    string
@@ -76,8 +115,149 @@ func tokenizeForNormalization(expr string) []tokenForNorm {
 	return tokens
 }
 
+// orLaterSuffix matches annotation text meaning "this version or later".
+var orLaterSuffix = regexp.MustCompile(`(?i)(?:^|\s)(?:or|and) (?:any )?(?:later|newer|greater)(?: versions?)?$`)
+
+// minVersion matches annotations like ">= 2" or ">=v2.1".
+var minVersion = regexp.MustCompile(`^>=?\s*v?(\d+(?:\.\d+)*)$`)
+
+// interpretAnnotations rewrites parenthesized annotations that follow a
+// license name, like "MIT (see LICENSE file)" or "BSD (3-clause)". In the
+// expression grammar "(" can only start a group after an operator or
+// another "(", so a "(" directly after license words is an annotation.
+//
+// The annotation is folded into the license when it names an exception
+// ("with LLVM exception"), an or-later clause ("or later", ">= 2"), or a
+// qualifier that still normalizes together with the license name
+// ("3-clause", "v2.1"). It is dropped when the license name normalizes on
+// its own. Anything else is left for normalizeTokens to reject.
+func interpretAnnotations(tokens []tokenForNorm, cfg *config) []tokenForNorm {
+	var out []tokenForNorm
+	runStart := 0 // index in out where the current run of license words begins
+
+	for i := 0; i < len(tokens); i++ {
+		tok := tokens[i]
+		if !tok.isParen || tok.value != "(" || runStart == len(out) {
+			out = append(out, tok)
+			if tok.isOp || tok.isParen {
+				runStart = len(out)
+			}
+			continue
+		}
+
+		// Find the matching close paren
+		end, depth := -1, 0
+		for j := i; j < len(tokens) && end < 0; j++ {
+			if tokens[j].isParen {
+				if tokens[j].value == "(" {
+					depth++
+				} else if depth--; depth == 0 {
+					end = j
+				}
+			}
+		}
+		if end < 0 {
+			out = append(out, tok)
+			runStart = len(out)
+			continue
+		}
+
+		if folded, ok := foldAnnotation(out[runStart:], tokens[i+1:end], cfg); ok {
+			if cfg.opts.Logger != nil {
+				cfg.debug("spdx: folded annotation", "license", joinTokens(out[runStart:]), "annotation", joinTokens(tokens[i+1:end]), "result", joinTokens(folded))
+			}
+			out = append(out[:runStart], folded...)
+			i = end
+			if folded[len(folded)-1].isOp {
+				runStart = len(out)
+			}
+			continue
+		}
+
+		out = append(out, tok)
+		runStart = len(out)
+	}
+
+	return out
+}
+
+// foldAnnotation interprets the annotation tokens that follow the license
+// words in run. It returns the replacement tokens for run and the
+// annotation, or false if the annotation is not understood.
+func foldAnnotation(run, annotation []tokenForNorm, cfg *config) ([]tokenForNorm, bool) {
+	words := joinTokens(run)
+	text := joinTokens(annotation)
+	if words == "" || text == "" {
+		return nil, false
+	}
+	result := append([]tokenForNorm(nil), run...)
+
+	// "Apache-2.0 (with LLVM exception)"
+	if rest, ok := cutPrefixFold(text, "with "); ok {
+		if normalizeException(rest, cfg) == "" {
+			return nil, false
+		}
+		result = append(result, tokenForNorm{value: "WITH", isOp: true})
+		return append(result, tokenizeForNormalization(rest)...), true
+	}
+
+	// "GPL-2.0 (or later)", "GPL (v2 or later)", "GPL (>= 2)"
+	plus := false
+	if loc := orLaterSuffix.FindStringIndex(text); loc != nil {
+		text = strings.TrimSpace(text[:loc[0]])
+		plus = true
+	}
+	if m := minVersion.FindStringSubmatch(text); m != nil {
+		text = m[1]
+		plus = true
+	}
+
+	switch {
+	case text == "":
+	case validWords(words+" "+text, cfg):
+		// "BSD (3-clause)", "LGPL (v2.1)"
+		result = append(result, tokenizeForNormalization(text)...)
+	case !plus && validWords(words, cfg):
+		// "MIT (see LICENSE file)", "The MIT License (MIT)"
+	default:
+		return nil, false
+	}
+
+	if plus {
+		result = append(result, tokenForNorm{value: "+", isPlus: true})
+	}
+	return result, true
+}
+
+// validWords reports whether s normalizes to a license.
+func validWords(s string, cfg *config) bool {
+	_, err := normalize(s, cfg)
+	return err == nil
+}
+
+// joinTokens joins token values back into text, attaching + to the word
+// before it.
+func joinTokens(tokens []tokenForNorm) string {
+	var b strings.Builder
+	for _, tok := range tokens {
+		if b.Len() > 0 && !tok.isPlus {
+			b.WriteByte(' ')
+		}
+		b.WriteString(tok.value)
+	}
+	return b.String()
+}
+
+// cutPrefixFold is strings.CutPrefix with case-insensitive matching.
+func cutPrefixFold(s, prefix string) (string, bool) {
+	if len(s) < len(prefix) || !strings.EqualFold(s[:len(prefix)], prefix) {
+		return s, false
+	}
+	return strings.TrimSpace(s[len(prefix):]), true
+}
+
 // normalizeTokens processes tokens and normalizes informal license names.
-func normalizeTokens(tokens []tokenForNorm) (string, error) {
+func normalizeTokens(tokens []tokenForNorm, cfg *config) (string, error) {
 	var result strings.Builder
 	var licenseWords []string
 	expectException := false // true if we just saw WITH
@@ -87,7 +267,7 @@ func normalizeTokens(tokens []tokenForNorm) (string, error) {
 			return nil
 		}
 
-		normalized, err := normalizeLicenseWords(licenseWords)
+		normalized, err := normalizeLicenseWords(licenseWords, cfg)
 		if err != nil {
 			return err
 		}
@@ -105,18 +285,15 @@ func normalizeTokens(tokens []tokenForNorm) (string, error) {
 			return nil
 		}
 
-		// Exception should be a single valid exception ID
-		exc := strings.Join(licenseWords, "-")
-		if lookupException(exc) == "" {
-			// Try the original form
-			exc = strings.Join(licenseWords, " ")
-			if lookupException(exc) == "" {
-				return &LicenseError{License: exc, Err: ErrInvalidException}
-			}
+		// Exception words may be an informal name like "Classpath Exception"
+		exc := strings.Join(licenseWords, " ")
+		id := normalizeException(exc, cfg)
+		if id == "" {
+			return &LicenseError{License: exc, Err: ErrInvalidException}
 		}
 
 		result.WriteString(" ")
-		result.WriteString(lookupException(exc))
+		result.WriteString(id)
 		licenseWords = nil
 		return nil
 	}
@@ -183,7 +360,7 @@ func normalizeTokens(tokens []tokenForNorm) (string, error) {
 
 // normalizeLicenseWords takes a slice of words that should form a license name
 // and tries to normalize them. It uses greedy matching from the start.
-func normalizeLicenseWords(words []string) (string, error) {
+func normalizeLicenseWords(words []string, cfg *config) (string, error) {
 	if len(words) == 0 {
 		return "", ErrMissingOperand
 	}
@@ -206,26 +383,34 @@ func normalizeLicenseWords(words []string) (string, error) {
 
 	for i < len(words) {
 		matched := false
+		rejected := false // a span had a guess below Options.MinConfidence
+		var proprietary error
 
 		// Try longest span first, working backwards
 		for end := len(words); end > i; end-- {
 			candidate := strings.Join(words[i:end], " ")
 
 			// Try direct normalization
-			normalized, err := Normalize(candidate)
+			normalized, err := normalize(candidate, cfg)
 			if err == nil {
 				results = append(results, normalized)
 				i = end
 				matched = true
 				break
 			}
+			if errors.Is(err, ErrLowConfidence) {
+				rejected = true
+			}
+			if proprietary == nil && errors.Is(err, ErrProprietary) {
+				proprietary = err
+			}
 
 			// Try with + suffix handling
 			if strings.HasSuffix(candidate, "+") {
 				base := strings.TrimSuffix(candidate, "+")
-				normalized, err := Normalize(base)
+				normalized, err := normalize(base, cfg)
 				if err == nil {
-					results = append(results, upgradeGPL(normalized+"+"))
+					results = append(results, cfg.upgrade(normalized+"+"))
 					i = end
 					matched = true
 					break
@@ -234,6 +419,14 @@ func normalizeLicenseWords(words []string) (string, error) {
 		}
 
 		if !matched {
+			// Report a rejected guess over a missing one, since the words
+			// would have normalized without the threshold
+			if rejected {
+				return "", &LicenseError{License: strings.Join(words[i:], " "), Err: ErrLowConfidence}
+			}
+			if proprietary != nil {
+				return "", proprietary
+			}
 			// Single word didn't normalize - it's invalid
 			return "", &LicenseError{License: words[i], Err: ErrInvalidLicenseID}
 		}
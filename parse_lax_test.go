@@ -89,6 +89,29 @@ var laxParseTests = map[string]string{
 
 	// Complex nested
 	"(Apache 2 OR MIT) AND (GPL v3 OR BSD)":  "(Apache-2.0 OR MIT) AND (GPL-3.0-or-later OR BSD-2-Clause)",
+
+	// Informal exception names
+	"GPL-2.0-only WITH classpath exception 2.0":   "GPL-2.0-only WITH Classpath-exception-2.0",
+	"GPL-2.0-only WITH Classpath Exception":       "GPL-2.0-only WITH Classpath-exception-2.0",
+	"Apache-2.0 WITH LLVM exception":              "Apache-2.0 WITH LLVM-exception",
+	"GPL-3.0-or-later WITH GCC exception 3.1":     "GPL-3.0-or-later WITH GCC-exception-3.1",
+	"(GPL v2 WITH Classpath exception) OR MIT":    "(GPL-2.0-only WITH Classpath-exception-2.0) OR MIT",
+
+	// Boilerplate phrases around the expression
+	"Free for non-commercial use (GPL)":           "GPL-3.0-or-later",
+	"Licensed under MIT OR Apache 2.0":            "MIT OR Apache-2.0",
+
+	// Parenthetical annotations after a license name
+	"MIT (see LICENSE file)":                       "MIT",
+	"The MIT License (MIT)":                        "MIT",
+	"Apache-2.0 (with LLVM exception)":             "Apache-2.0 WITH LLVM-exception",
+	"BSD (3-clause)":                               "BSD-3-Clause",
+	"LGPL (v2.1)":                                  "LGPL-2.1-only",
+	"GPL-2.0 (or later)":                           "GPL-2.0-or-later",
+	"GPL (v2 or later)":                            "GPL-2.0-or-later",
+	"GPL (>= 2)":                                   "GPL-2.0-or-later",
+	"BSD (3-clause) OR Apache 2 (with LLVM exception)": "BSD-3-Clause OR (Apache-2.0 WITH LLVM-exception)",
+	"MIT OR (Apache 2)":                            "MIT OR Apache-2.0",
 }
 
 func TestParseLax(t *testing.T) {
@@ -118,6 +141,9 @@ func TestParseLaxInvalid(t *testing.T) {
 		"MIT AND",
 		"OR MIT",
 		"((MIT)",
+		"GPL-2.0-only WITH Not An Exception",
+		"FAKEYLICENSE (see LICENSE)",
+		"MIT (with Not An Exception)",
 	}
 
 	for _, input := range invalidCases {
@@ -0,0 +1,81 @@
+package spdx
+
+import "sync"
+
+// Node pools used by ParsePooled. Only the node types that make up the bulk
+// of a typical tree are pooled; LicenseRef and SpecialValue nodes are
+// allocated normally and left to the garbage collector.
+var (
+	licensePool = sync.Pool{New: func() any { return new(License) }}
+	andPool     = sync.Pool{New: func() any { return new(AndExpression) }}
+	orPool      = sync.Pool{New: func() any { return new(OrExpression) }}
+)
+
+// ParsePooled is like Parse but takes AST nodes from a pool instead of
+// allocating them. Pass the result to Release once you are done with it to
+// return the nodes for reuse, which reduces GC pressure in services that
+// parse large volumes of expressions.
+//
+// The tree must not be used after Release, and nodes must not be shared
+// with a tree that is still in use. Trees that are never released are
+// collected normally, so forgetting to call Release is safe, just slower.
+//
+// Example:
+//
+//	expr, err := spdx.ParsePooled("MIT OR Apache-2.0")
+//	if err != nil {
+//		return err
+//	}
+//	licenses := expr.Licenses()
+//	spdx.Release(expr)
+func ParsePooled(expression string) (Expression, error) {
+	return parse(expression, true)
+}
+
+// Release returns the nodes of a tree from ParsePooled to the pool. It is
+// a no-op for nil. Calling Release on a tree from Parse is allowed, as long
+// as no other references to its nodes remain.
+func Release(expr Expression) {
+	switch e := expr.(type) {
+	case *License:
+		*e = License{}
+		licensePool.Put(e)
+	case *AndExpression:
+		Release(e.Left)
+		Release(e.Right)
+		*e = AndExpression{}
+		andPool.Put(e)
+	case *OrExpression:
+		Release(e.Left)
+		Release(e.Right)
+		*e = OrExpression{}
+		orPool.Put(e)
+	}
+}
+
+func (p *parser) newLicense(id string) *License {
+	if !p.pooled {
+		return &License{ID: id}
+	}
+	l := licensePool.Get().(*License)
+	l.ID = id
+	return l
+}
+
+func (p *parser) newAnd(left, right Expression) *AndExpression {
+	if !p.pooled {
+		return &AndExpression{Left: left, Right: right}
+	}
+	e := andPool.Get().(*AndExpression)
+	e.Left, e.Right = left, right
+	return e
+}
+
+func (p *parser) newOr(left, right Expression) *OrExpression {
+	if !p.pooled {
+		return &OrExpression{Left: left, Right: right}
+	}
+	e := orPool.Get().(*OrExpression)
+	e.Left, e.Right = left, right
+	return e
+}
@@ -0,0 +1,98 @@
+package spdx
+
+import "testing"
+
+func TestParsePooled(t *testing.T) {
+	tests := []string{
+		"MIT",
+		"MIT OR Apache-2.0",
+		"GPL-2.0-only WITH Classpath-exception-2.0 AND (MIT OR BSD-3-Clause)",
+		"LicenseRef-custom AND NOASSERTION",
+		"GPL-2.0+",
+		"mit or apache 2",
+		"GPL v3 AND BSD",
+	}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			want, wantErr := Parse(input)
+			// Parse twice so the second run reuses released nodes
+			for i := 0; i < 2; i++ {
+				got, err := ParsePooled(input)
+				if (err != nil) != (wantErr != nil) {
+					t.Fatalf("ParsePooled(%q) error = %v, Parse error = %v", input, err, wantErr)
+				}
+				if err != nil {
+					return
+				}
+				if got.String() != want.String() {
+					t.Errorf("ParsePooled(%q) = %q, want %q", input, got.String(), want.String())
+				}
+				Release(got)
+			}
+		})
+	}
+}
+
+func TestParsePooledInvalid(t *testing.T) {
+	if _, err := ParsePooled("MIT AND"); err == nil {
+		t.Error("ParsePooled should fail for invalid input")
+	}
+	if _, err := ParsePooled(""); err != ErrEmptyExpression {
+		t.Errorf("ParsePooled(\"\") error = %v, want ErrEmptyExpression", err)
+	}
+}
+
+func TestReleaseClearsNodes(t *testing.T) {
+	expr, err := ParsePooled("GPL-2.0-only WITH Classpath-exception-2.0 OR MIT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	or := expr.(*OrExpression)
+	license := or.Left.(*License)
+	Release(expr)
+
+	if or.Left != nil || or.Right != nil {
+		t.Error("Release should clear OrExpression children")
+	}
+	if license.ID != "" || license.Exception != "" {
+		t.Error("Release should clear License fields")
+	}
+	Release(nil)
+}
+
+func BenchmarkParsePooled(b *testing.B) {
+	inputs := []string{
+		"MIT",
+		"MIT OR Apache-2.0",
+		"(MIT OR Apache-2.0) AND BSD-3-Clause AND GPL-2.0-only WITH Classpath-exception-2.0",
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, input := range inputs {
+			expr, err := ParsePooled(input)
+			if err != nil {
+				b.Fatal(err)
+			}
+			Release(expr)
+		}
+	}
+}
+
+func BenchmarkParseUnpooled(b *testing.B) {
+	inputs := []string{
+		"MIT",
+		"MIT OR Apache-2.0",
+		"(MIT OR Apache-2.0) AND BSD-3-Clause AND GPL-2.0-only WITH Classpath-exception-2.0",
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, input := range inputs {
+			if _, err := Parse(input); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package spdx
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// ErrProprietary is returned for license strings that describe
+// proprietary or non-open terms, such as "Freeware" or "All rights
+// reserved", rather than naming a license. The error is a
+// *ProprietaryError, which also matches ErrInvalidLicense.
+var ErrProprietary = errors.New("proprietary license terms")
+
+// ProprietaryKind classifies proprietary license terms.
+type ProprietaryKind string
+
+const (
+	// ProprietaryUnspecified is a plain statement that the terms are
+	// proprietary, such as "Proprietary" or "Closed source".
+	ProprietaryUnspecified ProprietaryKind = "proprietary"
+	// ProprietaryAllRightsReserved is a copyright notice that grants no
+	// license, such as "All rights reserved".
+	ProprietaryAllRightsReserved ProprietaryKind = "all-rights-reserved"
+	// ProprietaryCommercial is a commercial license, such as "Commercial
+	// license available".
+	ProprietaryCommercial ProprietaryKind = "commercial"
+	// ProprietaryEULA is an end user license agreement.
+	ProprietaryEULA ProprietaryKind = "eula"
+	// ProprietaryEvaluation is an evaluation or trial license.
+	ProprietaryEvaluation ProprietaryKind = "evaluation"
+	// ProprietaryFreeware is software free to use but not to modify or
+	// redistribute.
+	ProprietaryFreeware ProprietaryKind = "freeware"
+	// ProprietarySharedSource is source made available for reference
+	// under restrictive terms, such as Microsoft Shared Source.
+	ProprietarySharedSource ProprietaryKind = "shared-source"
+	// ProprietaryShareware is software distributed for trial with a
+	// request for payment.
+	ProprietaryShareware ProprietaryKind = "shareware"
+)
+
+// proprietaryPhrases maps phrases to the kind of terms they describe,
+// most specific first.
+var proprietaryPhrases = []struct {
+	kind ProprietaryKind
+	re   *regexp.Regexp
+}{
+	{ProprietarySharedSource, regexp.MustCompile(`(?i)\bshared[\s-]?source\b`)},
+	{ProprietaryEvaluation, regexp.MustCompile(`(?i)\b(?:evaluation|trial)(?:[\s-]+(?:license|licence|version|only))\b`)},
+	{ProprietaryShareware, regexp.MustCompile(`(?i)\bshareware\b`)},
+	{ProprietaryFreeware, regexp.MustCompile(`(?i)\bfreeware\b`)},
+	{ProprietaryEULA, regexp.MustCompile(`(?i)\b(?:EULA|end[\s-]user[\s-]licen[sc]e[\s-]agreement|licen[sc]e agreement)\b`)},
+	{ProprietaryCommercial, regexp.MustCompile(`(?i)\bcommercial\b`)},
+	{ProprietaryAllRightsReserved, regexp.MustCompile(`(?i)\ball rights reserved\b`)},
+	{ProprietaryUnspecified, regexp.MustCompile(`(?i)\b(?:proprietary|closed[\s-]source)\b`)},
+}
+
+// Proprietary reports whether s describes proprietary terms, and which
+// kind. It only looks for the phrases; Normalize and Parse report
+// ErrProprietary when a string also fails to normalize, so "MIT, all
+// rights reserved" is still MIT.
+//
+// Example:
+//
+//	Proprietary("Freeware")                      // ProprietaryFreeware, true
+//	Proprietary("Commercial license available")  // ProprietaryCommercial, true
+//	Proprietary("MIT")                           // "", false
+func Proprietary(s string) (ProprietaryKind, bool) {
+	s = strings.TrimSpace(s)
+	for _, p := range proprietaryPhrases {
+		if p.re.MatchString(s) {
+			return p.kind, true
+		}
+	}
+	return "", false
+}
+
+// ProprietaryError reports a license string that describes proprietary
+// terms. It matches ErrProprietary, and ErrInvalidLicense for callers that
+// only check whether normalization failed.
+type ProprietaryError struct {
+	License string
+	Kind    ProprietaryKind
+}
+
+func (e *ProprietaryError) Error() string {
+	return ErrProprietary.Error() + " (" + string(e.Kind) + "): " + e.License
+}
+
+func (e *ProprietaryError) Unwrap() []error {
+	return []error{ErrProprietary, ErrInvalidLicense}
+}
+
+// proprietaryError returns a *ProprietaryError for s, or nil if s does
+// not describe proprietary terms.
+func proprietaryError(s string) error {
+	if kind, ok := Proprietary(s); ok {
+		return &ProprietaryError{License: s, Kind: kind}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package spdx
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestProprietary(t *testing.T) {
+	tests := []struct {
+		input string
+		want  ProprietaryKind
+		ok    bool
+	}{
+		{"Freeware", ProprietaryFreeware, true},
+		{"Shareware", ProprietaryShareware, true},
+		{"Evaluation license", ProprietaryEvaluation, true},
+		{"trial version only", ProprietaryEvaluation, true},
+		{"All Rights Reserved", ProprietaryAllRightsReserved, true},
+		{"Commercial license available", ProprietaryCommercial, true},
+		{"EULA", ProprietaryEULA, true},
+		{"End User License Agreement", ProprietaryEULA, true},
+		{"Microsoft Shared Source", ProprietarySharedSource, true},
+		{"Proprietary", ProprietaryUnspecified, true},
+		{"closed-source", ProprietaryUnspecified, true},
+		{"MIT", "", false},
+		{"Free Software", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := Proprietary(tt.input)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("Proprietary(%q) = %q, %v; want %q, %v", tt.input, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestNormalizeProprietary(t *testing.T) {
+	_, err := Normalize("Freeware")
+	var perr *ProprietaryError
+	if !errors.As(err, &perr) || perr.Kind != ProprietaryFreeware || perr.License != "Freeware" {
+		t.Fatalf("Normalize(Freeware) error = %v, want a ProprietaryError", err)
+	}
+	if !errors.Is(err, ErrProprietary) || !errors.Is(err, ErrInvalidLicense) {
+		t.Errorf("error %v should match ErrProprietary and ErrInvalidLicense", err)
+	}
+	if got := ErrorCode(err); got != CodeProprietary {
+		t.Errorf("ErrorCode = %q, want %q", got, CodeProprietary)
+	}
+
+	// A license named alongside the phrase still wins
+	if got, err := Normalize("MIT, all rights reserved"); err != nil || got != "MIT" {
+		t.Errorf("Normalize(MIT, all rights reserved) = %q, %v; want MIT", got, err)
+	}
+}
+
+func TestParseProprietary(t *testing.T) {
+	tests := []struct {
+		input   string
+		license string
+		kind    ProprietaryKind
+	}{
+		{"MIT OR Freeware", "Freeware", ProprietaryFreeware},
+		{"GPL-3.0 or commercial", "commercial", ProprietaryCommercial},
+		{"All rights reserved", "All rights reserved", ProprietaryAllRightsReserved},
+		{"Copyright 2020 Foo. All rights reserved.", "Copyright 2020 Foo. All rights reserved.", ProprietaryAllRightsReserved},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse(tt.input)
+		var perr *ProprietaryError
+		if !errors.As(err, &perr) || perr.License != tt.license || perr.Kind != tt.kind {
+			t.Errorf("Parse(%q) error = %v, want %s terms in %q", tt.input, err, tt.kind, tt.license)
+		}
+	}
+
+	if _, err := Parse("NOT-A-LICENSE"); errors.Is(err, ErrProprietary) {
+		t.Errorf("Parse(NOT-A-LICENSE) error = %v, should not be ErrProprietary", err)
+	}
+}
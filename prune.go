@@ -0,0 +1,92 @@
+package spdx
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNoAllowedAlternative is returned by Prune when no choice of OR
+// alternatives leaves an expression made only of allowed licenses.
+var ErrNoAllowedAlternative = errors.New("no allowed alternative")
+
+// Prune removes the OR alternatives that the allowed list forbids and
+// returns the remaining expression. Each license is checked with Satisfies,
+// so allowed uses the same rules as Satisfies. AND operands are all
+// required, so a forbidden license under an AND rules out that whole
+// alternative. Prune returns ErrNoAllowedAlternative if nothing remains.
+//
+// Use it to record the terms actually elected, for example in a NOTICE
+// file for a proprietary product.
+//
+// Example:
+//
+//	Prune("MIT OR GPL-3.0-only", []string{"MIT", "Apache-2.0"})
+//	// "MIT", nil
+//
+//	Prune("(MIT AND GPL-3.0-only) OR Apache-2.0", []string{"MIT", "Apache-2.0"})
+//	// "Apache-2.0", nil
+//
+//	Prune("GPL-3.0-only", []string{"MIT"})
+//	// "", ErrNoAllowedAlternative
+func Prune(expression string, allowed []string) (string, error) {
+	expr, err := Parse(expression)
+	if err != nil {
+		return "", err
+	}
+
+	pruned, ok, err := prune(expr, allowed)
+	if err != nil {
+		return "", err
+	}
+	cfg := loadConfig()
+	if !ok {
+		if cfg.opts.Logger != nil {
+			cfg.debug("spdx: prune left no alternative", "expression", expr.String(), "allowed", allowed)
+		}
+		return "", fmt.Errorf("%w: %s", ErrNoAllowedAlternative, expr)
+	}
+	if cfg.opts.Logger != nil {
+		cfg.debug("spdx: pruned", "expression", expr.String(), "allowed", allowed, "result", pruned.String())
+	}
+	return pruned.String(), nil
+}
+
+// prune returns the allowed part of expr, and false if none of it is
+// allowed.
+func prune(expr Expression, allowed []string) (Expression, bool, error) {
+	switch e := expr.(type) {
+	case *AndExpression:
+		ops := flattenAnd(e)
+		for i, op := range ops {
+			p, ok, err := prune(op, allowed)
+			if err != nil || !ok {
+				return nil, false, err
+			}
+			ops[i] = p
+		}
+		return joinAnd(ops), true, nil
+
+	case *OrExpression:
+		var kept []Expression
+		for _, alt := range flattenOr(e) {
+			p, ok, err := prune(alt, allowed)
+			if err != nil {
+				return nil, false, err
+			}
+			if ok {
+				kept = append(kept, p)
+			}
+		}
+		if len(kept) == 0 {
+			return nil, false, nil
+		}
+		return joinOr(kept), true, nil
+
+	case *SpecialValue:
+		return nil, false, nil
+
+	default:
+		ok, err := Satisfies(expr.String(), allowed)
+		return expr, ok, err
+	}
+}
@@ -0,0 +1,50 @@
+package spdx
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPrune(t *testing.T) {
+	allowed := []string{"MIT", "Apache-2.0", "ISC"}
+	tests := []struct {
+		expr, want string
+	}{
+		{"MIT", "MIT"},
+		{"MIT OR GPL-3.0-only", "MIT"},
+		{"GPL-3.0-only OR MIT OR Apache-2.0", "MIT OR Apache-2.0"},
+		{"(MIT AND GPL-3.0-only) OR Apache-2.0", "Apache-2.0"},
+		{"ISC AND (MIT OR GPL-3.0-only)", "ISC AND MIT"},
+		{"(MIT OR GPL-2.0-only) AND (Apache-2.0 OR LGPL-2.1-only)", "MIT AND Apache-2.0"},
+	}
+
+	for _, tt := range tests {
+		got, err := Prune(tt.expr, allowed)
+		if err != nil {
+			t.Errorf("Prune(%q) error = %v", tt.expr, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Prune(%q) = %q, want %q", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestPruneNothingAllowed(t *testing.T) {
+	allowed := []string{"MIT"}
+	for _, expr := range []string{
+		"GPL-3.0-only",
+		"GPL-3.0-only OR LGPL-2.1-only",
+		"MIT AND GPL-3.0-only",
+		"NOASSERTION",
+	} {
+		_, err := Prune(expr, allowed)
+		if !errors.Is(err, ErrNoAllowedAlternative) {
+			t.Errorf("Prune(%q) error = %v, want ErrNoAllowedAlternative", expr, err)
+		}
+	}
+
+	if _, err := Prune("MIT AND", allowed); err == nil {
+		t.Error("Prune should fail for invalid input")
+	}
+}
@@ -0,0 +1,64 @@
+package spdx
+
+import "regexp"
+
+// LicenseRefPublicDomain is the identifier PublicDomainLicenseRef maps
+// public domain statements to. LicenseCategory reports it as
+// CategoryPublicDomain.
+const LicenseRefPublicDomain = "LicenseRef-public-domain"
+
+// PublicDomainPolicy selects the identifier a bare "public domain"
+// statement normalizes to. SPDX has no identifier for the public domain
+// itself, so each choice is a guess at what the author meant.
+type PublicDomainPolicy int
+
+const (
+	// PublicDomainUnlicense maps public domain statements to Unlicense,
+	// matching spdx-correct. This is the default.
+	PublicDomainUnlicense PublicDomainPolicy = iota
+	// PublicDomainCC0 maps them to CC0-1.0, the dedication most
+	// registries recommend.
+	PublicDomainCC0
+	// PublicDomainLicenseRef maps them to LicenseRefPublicDomain, so they
+	// stay distinct from any license text.
+	PublicDomainLicenseRef
+)
+
+// target returns the identifier p maps public domain statements to.
+func (p PublicDomainPolicy) target() string {
+	switch p {
+	case PublicDomainCC0:
+		return "CC0-1.0"
+	case PublicDomainLicenseRef:
+		return LicenseRefPublicDomain
+	default:
+		return "Unlicense"
+	}
+}
+
+// rePublicDomain matches a whole string that states a work is in the
+// public domain without naming a dedication, such as "Public Domain" or
+// "released into the public domain".
+var rePublicDomain = regexp.MustCompile(`(?i)^(?:(?:released|dedicated|placed)\s+(?:in)?to\s+)?(?:the\s+)?public[\s-]?domain(?:\s+dedication)?\.?$`)
+
+// publicDomain returns the identifier Options.PublicDomain maps s to, or
+// "" if s is not a public domain statement. It applies from RulesetV3 on.
+func (c *config) publicDomain(s string) string {
+	if c.ruleset() < RulesetV3 || !rePublicDomain.MatchString(s) {
+		return ""
+	}
+	return c.opts.PublicDomain.target()
+}
+
+// licenseRefCategory returns the category of a LicenseRef in an
+// expression. Only LicenseRefPublicDomain and the riders DetectRiders
+// reports have one.
+func licenseRefCategory(ref *LicenseRef) Category {
+	if ref.DocumentRef == "" && ref.String() == LicenseRefPublicDomain {
+		return CategoryPublicDomain
+	}
+	if rider, ok := riderCategory(ref.String()); ok && ref.DocumentRef == "" {
+		return rider
+	}
+	return CategoryUnknown
+}
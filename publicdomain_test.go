@@ -0,0 +1,72 @@
+package spdx
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestPublicDomainPolicy(t *testing.T) {
+	tests := []struct {
+		policy PublicDomainPolicy
+		want   string
+	}{
+		{PublicDomainUnlicense, "Unlicense"},
+		{PublicDomainCC0, "CC0-1.0"},
+		{PublicDomainLicenseRef, LicenseRefPublicDomain},
+	}
+
+	for _, tt := range tests {
+		withOptions(t, Options{PublicDomain: tt.policy})
+		for _, input := range []string{"Public Domain", "public-domain", "Released into the public domain", "Licensed under the public domain"} {
+			if got, err := Normalize(input); err != nil || got != tt.want {
+				t.Errorf("policy %d: Normalize(%q) = %q, %v; want %q", tt.policy, input, got, err, tt.want)
+			}
+		}
+		expr, err := Parse("MIT OR public domain")
+		if want := "MIT OR " + tt.want; err != nil || expr.String() != want {
+			t.Errorf("policy %d: Parse = %v, %v; want %q", tt.policy, expr, err, want)
+		}
+	}
+}
+
+func TestPublicDomainConfidence(t *testing.T) {
+	if _, confidence := guessLicense("Public Domain", loadConfig()); confidence != ConfidencePublicDomain {
+		t.Errorf("confidence = %v, want ConfidencePublicDomain", confidence)
+	}
+
+	withOptions(t, Options{MinConfidence: ConfidenceTransposition})
+	if _, err := Normalize("Public Domain"); !errors.Is(err, ErrLowConfidence) {
+		t.Errorf("Normalize(Public Domain) error = %v, want ErrLowConfidence", err)
+	}
+	// The Unlicense and CC0 by name are not guesses
+	if got, err := Normalize("Unlicense"); err != nil || got != "Unlicense" {
+		t.Errorf("Normalize(Unlicense) = %q, %v", got, err)
+	}
+}
+
+func TestPublicDomainCategory(t *testing.T) {
+	if got := LicenseCategory(LicenseRefPublicDomain); got != CategoryPublicDomain {
+		t.Errorf("LicenseCategory(%s) = %q, want %q", LicenseRefPublicDomain, got, CategoryPublicDomain)
+	}
+	cats, err := ExpressionCategories("MIT OR LicenseRef-public-domain")
+	if err != nil || !slices.Contains(cats, CategoryPublicDomain) {
+		t.Errorf("ExpressionCategories = %v, %v; want Public Domain included", cats, err)
+	}
+	if !IsFullyPermissive("MIT AND LicenseRef-public-domain") {
+		t.Error("IsFullyPermissive(MIT AND LicenseRef-public-domain) = false")
+	}
+	if IsFullyPermissive("MIT AND LicenseRef-internal") {
+		t.Error("IsFullyPermissive(MIT AND LicenseRef-internal) = true, want an unknown LicenseRef to count")
+	}
+	if got := licenseRefCategory(&LicenseRef{LicenseRef: "other"}); got != CategoryUnknown {
+		t.Errorf("licenseRefCategory(LicenseRef-other) = %q, want Unknown", got)
+	}
+}
+
+func TestPublicDomainRuleset(t *testing.T) {
+	withOptions(t, Options{PublicDomain: PublicDomainCC0})
+	if got, _ := RulesetV2.Normalize("Public Domain"); got != "Unlicense" {
+		t.Errorf("RulesetV2.Normalize(Public Domain) = %q, want the V2 result Unlicense", got)
+	}
+}
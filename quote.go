@@ -0,0 +1,154 @@
+package spdx
+
+import "strings"
+
+// quotePairs lists the marks that wrap license strings copied out of JSON
+// arrays, Python reprs, Markdown and prose, as opening and closing pairs.
+// Typographic quotes are folded to ASCII by sanitizeInput, but guillemets
+// are not.
+var quotePairs = [...][2]string{
+	{`"`, `"`},
+	{"'", "'"},
+	{"`", "`"},
+	{"[", "]"},
+	{"«", "»"},
+	{"‹", "›"},
+}
+
+// unquote strips quotes and brackets that wrap the whole of s, however
+// deeply nested. Marks that only wrap part of s, as in `"MIT" OR "BSD"`,
+// are left alone.
+//
+// Example:
+//
+//	unquote(`["MIT"]`)  // "MIT"
+//	unquote("«MIT»")    // "MIT"
+func unquote(s string) string {
+	for {
+		s = strings.TrimSpace(s)
+		inner, ok := unwrapQuoted(s)
+		if !ok {
+			return s
+		}
+		s = inner
+	}
+}
+
+// unwrapQuoted returns s without its wrapping marks and true if one pair
+// of quotePairs wraps all of s and does not occur inside it.
+func unwrapQuoted(s string) (string, bool) {
+	for _, p := range quotePairs {
+		open, closing := p[0], p[1]
+		if len(s) <= len(open)+len(closing) || !strings.HasPrefix(s, open) || !strings.HasSuffix(s, closing) {
+			continue
+		}
+		inner := s[len(open) : len(s)-len(closing)]
+		if strings.Contains(inner, open) || strings.Contains(inner, closing) {
+			continue
+		}
+		return inner, true
+	}
+	return "", false
+}
+
+// unquoteList rewrites a serialized list of licenses, such as
+// `"MIT", "Apache-2.0"` from a JSON array, as an AND of its items, since
+// a package that lists several licenses is covered by all of them. Each
+// item must be quoted, or, if the list was bracketed, a single word, so
+// that commas in prose like "Apache License, Version 2.0" are not taken
+// for a list. Items with spaces are grouped in parentheses.
+func unquoteList(s string, bracketed bool) (string, bool) {
+	if !strings.Contains(s, ",") {
+		return s, false
+	}
+	var items []string
+	for item := range strings.SplitSeq(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if inner, ok := unwrapQuoted(item); ok {
+			item = unquote(inner)
+		} else if !bracketed || strings.ContainsFunc(item, isSpace) {
+			return s, false
+		}
+		if strings.ContainsFunc(item, isSpace) {
+			item = "(" + item + ")"
+		}
+		items = append(items, item)
+	}
+	if len(items) < 2 {
+		return s, false
+	}
+	return strings.Join(items, " AND "), true
+}
+
+// unquoteWords strips quotes and brackets around words and phrases inside
+// an expression, as in `'GPL-3.0' OR 'MIT'`. A mark only counts when it
+// starts after a space, "(" or "," and closes before one, or at either end
+// of s, so apostrophes inside words are kept.
+func unquoteWords(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		if i == 0 || isQuoteBoundary(s[i-1]) {
+			if inner, n, ok := quotedAt(s[i:]); ok {
+				b.WriteString(inner)
+				i += n
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+		i++
+	}
+	return b.String()
+}
+
+// quotedAt reports whether s starts with a quoted span that ends at a
+// word boundary, returning the span's content and length.
+func quotedAt(s string) (string, int, bool) {
+	for _, p := range quotePairs {
+		open, closing := p[0], p[1]
+		if !strings.HasPrefix(s, open) {
+			continue
+		}
+		end := strings.Index(s[len(open):], closing)
+		if end <= 0 {
+			continue
+		}
+		inner := s[len(open) : len(open)+end]
+		n := len(open) + end + len(closing)
+		if strings.Contains(inner, open) || (n < len(s) && !isQuoteBoundary(s[n])) {
+			continue
+		}
+		return inner, n, true
+	}
+	return "", 0, false
+}
+
+// isQuoteBoundary reports whether c can border a quoted word.
+func isQuoteBoundary(c byte) bool {
+	return c == ' ' || c == '\t' || c == '(' || c == ')' || c == ','
+}
+
+// isSpace reports whether r is an ASCII space or tab.
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t'
+}
+
+// unquoteExpression strips the quotes and brackets a license expression
+// picks up in manifests and metadata: around the whole string, around the
+// items of a serialized list, and around single words.
+//
+// Example:
+//
+//	unquoteExpression(`["MIT", "CC-BY-NC-ND-4.0"]`)  // "MIT AND CC-BY-NC-ND-4.0"
+//	unquoteExpression(`'GPL-3.0' OR 'MIT'`)          // "GPL-3.0 OR MIT"
+func unquoteExpression(s string) string {
+	trimmed := strings.TrimSpace(s)
+	inner := unquote(trimmed)
+	bracketed := strings.HasPrefix(trimmed, "[") && inner != trimmed
+	if list, ok := unquoteList(inner, bracketed); ok {
+		return list
+	}
+	return unquoteWords(inner)
+}
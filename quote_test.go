@@ -0,0 +1,102 @@
+package spdx
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestUnquote(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"MIT", "MIT"},
+		{"[MIT]", "MIT"},
+		{`["MIT"]`, "MIT"},
+		{`"Apache-2.0"`, "Apache-2.0"},
+		{"'GPL-3.0'", "GPL-3.0"},
+		{"`MIT`", "MIT"},
+		{"«MIT»", "MIT"},
+		{"‹MIT›", "MIT"},
+		{` [ "MIT" ] `, "MIT"},
+		{`"MIT" OR "Apache-2.0"`, `"MIT" OR "Apache-2.0"`},
+		{"[MIT] OR [BSD]", "[MIT] OR [BSD]"},
+		{`""`, `""`},
+	}
+
+	for _, tt := range tests {
+		if got := unquote(tt.input); got != tt.want {
+			t.Errorf("unquote(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestUnquoteExpression(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{`["MIT", "CC-BY-NC-ND-4.0"]`, "MIT AND CC-BY-NC-ND-4.0"},
+		{"['MIT', 'Apache-2.0']", "MIT AND Apache-2.0"},
+		{"[MIT, BSD-3-Clause]", "MIT AND BSD-3-Clause"},
+		{`["Apache License 2.0", "MIT"]`, "(Apache License 2.0) AND MIT"},
+		{`"MIT", "ISC",`, "MIT AND ISC"},
+		{"'GPL-3.0' OR 'MIT'", "GPL-3.0 OR MIT"},
+		{`("MIT" OR "Apache 2.0") AND "BSD"`, "(MIT OR Apache 2.0) AND BSD"},
+		{"[GPL-2.0+]", "GPL-2.0+"},
+
+		// Commas and apostrophes in prose are not quoting
+		{"Apache License, Version 2.0", "Apache License, Version 2.0"},
+		{"[Apache License, Version 2.0]", "Apache License, Version 2.0"},
+		{"Author's license", "Author's license"},
+		{`MIT, "ISC"`, "MIT, ISC"},
+	}
+
+	for _, tt := range tests {
+		if got := unquoteExpression(tt.input); got != tt.want {
+			t.Errorf("unquoteExpression(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestParseQuoted(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		// From real_licenses.json
+		{`["MIT"]`, "MIT"},
+		{`["MIT", "CC-BY-NC-ND-4.0"]`, "MIT AND CC-BY-NC-ND-4.0"},
+
+		{"[MIT]", "MIT"},
+		{`"Apache-2.0"`, "Apache-2.0"},
+		{"'GPL-3.0'", "GPL-3.0-or-later"},
+		{"«MIT»", "MIT"},
+		{"[GPL-2.0+]", "GPL-2.0-or-later"},
+		{"['MIT', 'Apache-2.0']", "MIT AND Apache-2.0"},
+		{`"MIT OR Apache-2.0"`, "MIT OR Apache-2.0"},
+		{"'GPL-3.0' OR 'MIT'", "GPL-3.0-or-later OR MIT"},
+	}
+
+	for _, tt := range tests {
+		rec := NormalizeRecord(tt.input)
+		if rec.Output != tt.want || rec.Error != "" {
+			t.Errorf("NormalizeRecord(%q) = %q, %s; want %q", tt.input, rec.Output, rec.Error, tt.want)
+			continue
+		}
+		if rec.Confidence != ConfidenceExact || !slices.Contains(rec.Rules, recordStageUnquote) {
+			t.Errorf("NormalizeRecord(%q) confidence %v, rules %v; want an exact unquoted match", tt.input, rec.Confidence, rec.Rules)
+		}
+	}
+}
+
+func TestNormalizeQuoted(t *testing.T) {
+	for _, input := range []string{"[MIT]", `"MIT"`, "'MIT'", "«MIT»", `["MIT"]`} {
+		if got, err := Normalize(input); got != "MIT" || err != nil {
+			t.Errorf("Normalize(%q) = %q, %v; want MIT", input, got, err)
+		}
+	}
+	if got, err := RulesetV2.Normalize("[GPL-2.0+]"); got == "GPL-2.0-or-later" {
+		t.Errorf("RulesetV2.Normalize([GPL-2.0+]) = %q, %v; unquoting belongs to RulesetV3", got, err)
+	}
+}
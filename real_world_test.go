@@ -29,26 +29,17 @@ func TestRealWorldLicenses(t *testing.T) {
 		skipped         []string // Intentionally skipped (proprietary, unknown, etc.)
 	)
 
-	skipPatterns := []string{
-		"UNLICENSED", "proprietary", "Proprietary", "PROPRIETARY",
-		"custom", "Custom", "CUSTOM", "private", "Private", "PRIVATE",
-		"unknown", "Unknown", "UNKNOWN", "none", "None", "NONE",
-		"SEE LICENSE", "See license", "LICENSE", "License",
-		"All rights reserved", "All Rights Reserved",
-		"TODO", "TBD", "tbc", "hi", "iewrbb", "john-wick-4",
-		"Commercial", "COMMERCIAL", "EULA", "non-standard", "Nonstandard",
-		"Other/Proprietary", "License Agreement", "Copyright",
-	}
-
+	// Skip what a Classifier buckets as not naming a license, plus junk
+	// seen in this dataset
+	classifier := Classifier{Ignore: append(DefaultIgnorePatterns(),
+		"UNLICENSED", "hi", "iewrbb", "john-wick-4", "copyright",
+	)}
 	shouldSkip := func(s string) bool {
-		for _, p := range skipPatterns {
-			if strings.Contains(s, p) {
-				return true
-			}
-		}
-		// Skip URLs
-		if strings.HasPrefix(s, "http") {
+		switch cl := classifier.Classify(s); cl.Bucket {
+		case BucketProprietary, BucketSeeFile, BucketURLOnly:
 			return true
+		case BucketUnknown:
+			return cl.Err == nil
 		}
 		return false
 	}
@@ -301,3 +292,31 @@ func BenchmarkRealWorldNormalization(b *testing.B) {
 		}
 	}
 }
+
+// BenchmarkRealWorldCanonical benchmarks Parse on the real-world strings
+// that are already valid SPDX, which is the common case in production.
+func BenchmarkRealWorldCanonical(b *testing.B) {
+	data, err := os.ReadFile("real_licenses.json")
+	if err != nil {
+		b.Skip("real_licenses.json not found")
+	}
+
+	var licenses map[string]int
+	if err := json.Unmarshal(data, &licenses); err != nil {
+		b.Fatalf("Failed to parse: %v", err)
+	}
+
+	var inputs []string
+	for license := range licenses {
+		if Valid(license) {
+			inputs = append(inputs, license)
+		}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, input := range inputs {
+			_, _ = Parse(input)
+		}
+	}
+}
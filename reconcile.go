@@ -0,0 +1,114 @@
+package spdx
+
+import (
+	"slices"
+	"strings"
+)
+
+// Declaration is a license declared for a package by one source, such as
+// registry metadata, deps.dev or a local manifest.
+type Declaration struct {
+	Source  string // where the license came from, e.g. "npm", "deps.dev", "package.json"
+	License string // expression or informal license string as declared
+}
+
+// DeclarationResult is how one Declaration was interpreted.
+type DeclarationResult struct {
+	Declaration
+	Normalized string // normalized expression; "" if it could not be parsed
+	Err        error  // parse error, if any
+	Agrees     bool   // Normalized is equivalent to the consensus
+}
+
+// Consensus is the license a set of sources agree on.
+type Consensus struct {
+	// Expression is the normalized expression declared by the most
+	// sources, or "" if no source declared a usable license.
+	Expression string
+	// Sources holds one result per declaration, in input order.
+	Sources []DeclarationResult
+	// Conflict is set when sources declare licenses that are not
+	// equivalent to each other.
+	Conflict bool
+}
+
+// ReconcileDeclarations combines the licenses several sources declare for
+// the same package into a consensus. Declarations are compared by meaning,
+// so "MIT OR Apache-2.0" and "Apache-2.0 OR MIT" agree. The most common
+// declaration wins; ties go to the source listed first, so list sources
+// in order of trust. NONE, NOASSERTION, empty strings and declarations
+// that do not parse are recorded but neither vote nor cause a conflict.
+//
+// Example:
+//
+//	c := ReconcileDeclarations([]Declaration{
+//		{Source: "package.json", License: "MIT"},
+//		{Source: "npm", License: "mit"},
+//		{Source: "deps.dev", License: "MIT OR Apache-2.0"},
+//	})
+//	c.Expression  // "MIT"
+//	c.Conflict    // true
+func ReconcileDeclarations(decls []Declaration) *Consensus {
+	c := &Consensus{Sources: make([]DeclarationResult, len(decls))}
+
+	var keys []string // distinct equivalence keys, in first-seen order
+	votes := make(map[string]int)
+	first := make(map[string]string) // key -> first normalized expression
+	results := make([]string, len(decls))
+
+	for i, d := range decls {
+		c.Sources[i].Declaration = d
+		if strings.TrimSpace(d.License) == "" {
+			continue
+		}
+
+		expr, err := Parse(d.License)
+		if err != nil {
+			c.Sources[i].Err = err
+			continue
+		}
+		c.Sources[i].Normalized = expr.String()
+		if _, ok := expr.(*SpecialValue); ok {
+			continue
+		}
+
+		key := equivalenceKey(expr)
+		results[i] = key
+		if votes[key] == 0 {
+			keys = append(keys, key)
+			first[key] = expr.String()
+		}
+		votes[key]++
+	}
+
+	if len(keys) == 0 {
+		return c
+	}
+
+	winner := keys[0]
+	for _, key := range keys[1:] {
+		if votes[key] > votes[winner] {
+			winner = key
+		}
+	}
+	c.Expression = first[winner]
+	c.Conflict = len(keys) > 1
+	for i := range c.Sources {
+		c.Sources[i].Agrees = results[i] == winner
+	}
+	return c
+}
+
+// equivalenceKey returns a string that is equal for expressions offering
+// the same alternatives, regardless of operand order and grouping.
+func equivalenceKey(expr Expression) string {
+	var terms []string
+	for _, alt := range alternatives(expr) {
+		term := strings.Join(alt.keys, " AND ")
+		if !slices.Contains(terms, term) {
+			terms = append(terms, term)
+		}
+	}
+	slices.Sort(terms)
+	return strings.Join(terms, " OR ")
+}
@@ -0,0 +1,61 @@
+package spdx
+
+import "testing"
+
+func TestReconcileDeclarations(t *testing.T) {
+	tests := []struct {
+		name     string
+		licenses []string
+		want     string
+		conflict bool
+		agrees   []bool
+	}{
+		{"unanimous", []string{"MIT", "mit", "MIT License"}, "MIT", false, []bool{true, true, true}},
+		{"order ignored", []string{"MIT OR Apache-2.0", "Apache-2.0 OR MIT"}, "MIT OR Apache-2.0", false, []bool{true, true}},
+		{"grouping ignored", []string{"(MIT AND ISC) OR BSD-3-Clause", "BSD-3-Clause OR (ISC AND MIT)"}, "(MIT AND ISC) OR BSD-3-Clause", false, []bool{true, true}},
+		{"majority wins", []string{"Apache-2.0", "MIT", "mit"}, "MIT", true, []bool{false, true, true}},
+		{"tie goes to first", []string{"Apache-2.0", "MIT"}, "Apache-2.0", true, []bool{true, false}},
+		{"and differs from or", []string{"MIT AND ISC", "MIT OR ISC"}, "MIT AND ISC", true, []bool{true, false}},
+		{"no assertion ignored", []string{"NOASSERTION", "MIT", ""}, "MIT", false, []bool{false, true, false}},
+		{"unparseable ignored", []string{"some custom thing", "MIT"}, "MIT", false, []bool{false, true}},
+		{"nothing usable", []string{"NONE", ""}, "", false, []bool{false, false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decls := make([]Declaration, len(tt.licenses))
+			for i, l := range tt.licenses {
+				decls[i] = Declaration{Source: string(rune('a' + i)), License: l}
+			}
+
+			c := ReconcileDeclarations(decls)
+			if c.Expression != tt.want {
+				t.Errorf("Expression = %q, want %q", c.Expression, tt.want)
+			}
+			if c.Conflict != tt.conflict {
+				t.Errorf("Conflict = %v, want %v", c.Conflict, tt.conflict)
+			}
+			for i, r := range c.Sources {
+				if r.Source != decls[i].Source {
+					t.Errorf("Sources[%d].Source = %q, want %q", i, r.Source, decls[i].Source)
+				}
+				if r.Agrees != tt.agrees[i] {
+					t.Errorf("Sources[%d].Agrees = %v, want %v", i, r.Agrees, tt.agrees[i])
+				}
+			}
+		})
+	}
+}
+
+func TestReconcileDeclarationsProvenance(t *testing.T) {
+	c := ReconcileDeclarations([]Declaration{
+		{Source: "package.json", License: "Apache 2"},
+		{Source: "deps.dev", License: "not a license at all"},
+	})
+	if got := c.Sources[0].Normalized; got != "Apache-2.0" {
+		t.Errorf("Sources[0].Normalized = %q, want Apache-2.0", got)
+	}
+	if c.Sources[1].Err == nil || c.Sources[1].Normalized != "" {
+		t.Errorf("Sources[1] = %+v, want a parse error", c.Sources[1])
+	}
+}
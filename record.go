@@ -0,0 +1,162 @@
+package spdx
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+)
+
+// NormalizationRecord is the audit record of normalizing one input: what
+// came in, what came out, how it got there, and which ruleset and license
+// data produced it. It marshals to the shape in
+// schema/normalization-record.schema.json, which the spdx command's
+// -format record output also uses, so records from every integration can
+// be stored and diffed alike.
+type NormalizationRecord struct {
+	Input       string   `json:"input"`
+	Output      string   `json:"output,omitempty"` // normalized expression, if the input parsed
+	Confidence  float64  `json:"confidence"`       // lowest confidence of any license in Output; 0 on failure
+	Rules       []string `json:"rules"`            // rules and stages applied, in order
+	Warnings    []string `json:"warnings"`         // things a reviewer should look at
+	Code        Code     `json:"code,omitempty"`   // error code, on failure
+	Error       string   `json:"error,omitempty"`
+	Ruleset     string   `json:"ruleset"`      // resolved ruleset, such as "v3"
+	LicenseList string   `json:"license_list"` // LicenseListVersion of the data used
+}
+
+// Stage names NormalizationRecord lists in Rules alongside rule IDs.
+const (
+	recordStageUnquote      = "unquote"
+	recordStageResolveURL   = "resolve-url"
+	recordStageFrequency    = "frequency"
+	recordStagePublicDomain = "public-domain"
+	recordStageBoilerplate  = "boilerplate"
+	recordStageReorder      = "reorder-version"
+	recordStageQualifier    = "qualifier"
+	recordStageAnnotation   = "annotation"
+)
+
+// NormalizeRecord parses input as Parse does and returns the record of
+// it. Rules lists the IDs of the rules that matched, as in DumpRules, and
+// the other stages that rewrote the input: "unquote", "resolve-url",
+// "frequency", "public-domain", "boilerplate", "reorder-version",
+// "qualifier" and "annotation".
+//
+// Example:
+//
+//	rec := NormalizeRecord("mit license and bsd")
+//	rec.Output      // "MIT AND BSD-2-Clause"
+//	rec.Confidence  // 0.5
+//	rec.Rules       // ["transposition: License", "last-resort:BSD"]
+func NormalizeRecord(input string) NormalizationRecord {
+	return normalizeRecord(input, loadConfig())
+}
+
+// NormalizeRecords returns the record of each input. All of them are
+// made with the same options and license data, even if SetDefaultOptions
+// or Reload runs meanwhile.
+func NormalizeRecords(inputs []string) []NormalizationRecord {
+	cfg := loadConfig()
+	records := make([]NormalizationRecord, len(inputs))
+	for i, input := range inputs {
+		records[i] = normalizeRecord(input, cfg)
+	}
+	return records
+}
+
+// normalizeRecord records a parse by running it with a Logger that
+// collects the traces the pipeline already emits. The Normalize cache is
+// bypassed, since cached results carry no traces.
+func normalizeRecord(input string, cfg *config) NormalizationRecord {
+	rec := NormalizationRecord{
+		Input:       input,
+		Rules:       []string{},
+		Warnings:    []string{},
+		Ruleset:     cfg.ruleset().String(),
+		LicenseList: cfg.registry().version().String(),
+	}
+
+	h := &recordHandler{rec: &rec, confidence: ConfidenceExact}
+	if cfg.opts.Logger != nil {
+		h.next = cfg.opts.Logger.Handler()
+	}
+	traced := *cfg
+	traced.opts.Logger = slog.New(h)
+	traced.cache = nil
+
+	expr, err := parseConfig(input, false, &traced)
+	if err != nil {
+		rec.Code = ErrorCode(err)
+		rec.Error = err.Error()
+		return rec
+	}
+	rec.Output = expr.String()
+	rec.Confidence = h.confidence
+	return rec
+}
+
+// recordHandler is a slog.Handler that fills in a NormalizationRecord
+// from pipeline traces, passing them on to next if set.
+type recordHandler struct {
+	rec        *NormalizationRecord
+	confidence float64
+	next       slog.Handler
+}
+
+func (h *recordHandler) Enabled(context.Context, slog.Level) bool { return true }
+
+func (h *recordHandler) Handle(ctx context.Context, r slog.Record) error {
+	attrs := make(map[string]slog.Value, r.NumAttrs())
+	r.Attrs(func(a slog.Attr) bool {
+		attrs[a.Key] = a.Value
+		return true
+	})
+
+	switch r.Message {
+	case "spdx: rule matched":
+		h.addRule(attrs["rule"].String())
+	case "spdx: frequency table match":
+		h.addRule(recordStageFrequency)
+	case "spdx: public domain statement":
+		h.addRule(recordStagePublicDomain)
+	case "spdx: unquoted input":
+		h.addRule(recordStageUnquote)
+	case "spdx: resolved URL":
+		h.addRule(recordStageResolveURL)
+	case "spdx: stripped boilerplate":
+		h.addRule(recordStageBoilerplate)
+	case "spdx: reordered version phrase":
+		h.addRule(recordStageReorder)
+	case "spdx: folded qualifier":
+		h.addRule(recordStageQualifier)
+	case "spdx: folded annotation":
+		h.addRule(recordStageAnnotation)
+	case "spdx: normalized license":
+		if c, ok := attrs["confidence"]; ok && c.Kind() == slog.KindFloat64 {
+			h.confidence = min(h.confidence, c.Float64())
+		}
+	case "spdx: guess below confidence threshold":
+		h.rec.Warnings = append(h.rec.Warnings, attrs["input"].String()+": guess "+attrs["guess"].String()+" is below the confidence threshold")
+	default:
+		if r.Level >= slog.LevelWarn {
+			h.rec.Warnings = append(h.rec.Warnings, strings.TrimPrefix(r.Message, "spdx: "))
+		}
+	}
+
+	if h.next != nil && h.next.Enabled(ctx, r.Level) {
+		return h.next.Handle(ctx, r)
+	}
+	return nil
+}
+
+func (h *recordHandler) addRule(rule string) {
+	for _, r := range h.rec.Rules {
+		if r == rule {
+			return
+		}
+	}
+	h.rec.Rules = append(h.rec.Rules, rule)
+}
+
+func (h *recordHandler) WithAttrs([]slog.Attr) slog.Handler { return h }
+func (h *recordHandler) WithGroup(string) slog.Handler      { return h }
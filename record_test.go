@@ -0,0 +1,89 @@
+package spdx
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeRecord(t *testing.T) {
+	tests := []struct {
+		input      string
+		output     string
+		confidence float64
+		rules      []string
+		code       Code
+	}{
+		{"MIT OR Apache-2.0", "MIT OR Apache-2.0", ConfidenceExact, []string{}, ""},
+		{"mit license and bsd", "MIT AND BSD-2-Clause", ConfidenceLastResort, []string{"transposition: License", "last-resort:BSD"}, ""},
+		{"Public Domain", "Unlicense", ConfidencePublicDomain, []string{"public-domain"}, ""},
+		{"version 2 of the GNU General Public License or any later version", "GPL-2.0-or-later", ConfidenceTransposition,
+			[]string{"reorder-version", "transform:trailing-version-word", "transposition:GNU GENERAL PUBLIC LICENSE"}, ""},
+		{"MIT OR", "", 0, []string{}, CodeMissingOperand},
+	}
+
+	version := LicenseListVersion().String()
+	for _, tt := range tests {
+		rec := NormalizeRecord(tt.input)
+		if rec.Input != tt.input || rec.Output != tt.output || rec.Confidence != tt.confidence || rec.Code != tt.code {
+			t.Errorf("NormalizeRecord(%q) = %+v", tt.input, rec)
+		}
+		if !slices.Equal(rec.Rules, tt.rules) {
+			t.Errorf("NormalizeRecord(%q).Rules = %q, want %q", tt.input, rec.Rules, tt.rules)
+		}
+		if (rec.Code != "") != (rec.Error != "") {
+			t.Errorf("NormalizeRecord(%q): code %q with error %q", tt.input, rec.Code, rec.Error)
+		}
+		if rec.Ruleset != "v3" || rec.LicenseList != version {
+			t.Errorf("NormalizeRecord(%q) versions = %q, %q", tt.input, rec.Ruleset, rec.LicenseList)
+		}
+	}
+}
+
+func TestNormalizeRecordJSON(t *testing.T) {
+	out, err := json.Marshal(NormalizeRecord("MIT"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"input":"MIT","output":"MIT","confidence":1,"rules":[],"warnings":[],"ruleset":"v3","license_list":"` + LicenseListVersion().String() + `"}`
+	if string(out) != want {
+		t.Errorf("Marshal = %s\nwant %s", out, want)
+	}
+}
+
+func TestNormalizeRecordWarnings(t *testing.T) {
+	var buf bytes.Buffer
+	withOptions(t, Options{
+		MinConfidence: ConfidenceTransform,
+		NoAssertion:   NoAssertionWarn,
+		CacheSize:     10,
+		Logger:        slog.New(slog.NewTextHandler(&buf, nil)),
+	})
+
+	// Prime the cache, which must not hide the traces
+	_, _ = Normalize("bsd")
+
+	rec := NormalizeRecord("bsd")
+	if rec.Code != CodeLowConfidence || len(rec.Warnings) != 1 || !strings.Contains(rec.Warnings[0], "below the confidence threshold") {
+		t.Errorf("NormalizeRecord(bsd) = %+v", rec)
+	}
+	if rec := NormalizeRecord("NOASSERTION"); !slices.Equal(rec.Warnings, []string{"expression contains NOASSERTION"}) {
+		t.Errorf("NormalizeRecord(NOASSERTION).Warnings = %q", rec.Warnings)
+	}
+	if !strings.Contains(buf.String(), "NOASSERTION") {
+		t.Error("traces should still reach the configured Logger")
+	}
+	if strings.Contains(buf.String(), "rule matched") {
+		t.Error("debug traces should be filtered by the configured Logger's level")
+	}
+}
+
+func TestNormalizeRecords(t *testing.T) {
+	recs := NormalizeRecords([]string{"Apache 2", "not a license"})
+	if len(recs) != 2 || recs[0].Output != "Apache-2.0" || recs[1].Code == "" {
+		t.Errorf("NormalizeRecords = %+v", recs)
+	}
+}
@@ -0,0 +1,290 @@
+package spdx
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/github/go-spdx/v2/spdxexp/spdxlicenses"
+)
+
+// registry is an immutable snapshot of the license data: the SPDX license
+// and exception identifiers and the scancode license database. It lives
+// in the config snapshot, so a call that loads the config once sees one
+// consistent set of data even while Reload swaps in another.
+type registry struct {
+	data       LicenseData
+	licenses   map[string]string   // lowercase -> canonical
+	exceptions map[string]string   // lowercase -> canonical
+	deprecated map[string]string   // lowercase -> canonical
+	canonical  map[string]bool     // canonical license IDs, case-sensitive
+	categories map[string]Category // lowercase SPDX key or scancode key -> category
+
+	// The scancode entries are only decoded for the functions that list
+	// or describe licenses, so callers that only need categories never
+	// hold the full database.
+	entriesOnce sync.Once
+	entryList   []licenseEntry
+
+	versionOnce sync.Once
+	dataVersion DataVersion
+
+	familiesOnce sync.Once
+	families     map[string]int // lowercase version family name -> number of versions
+}
+
+var (
+	builtinOnce sync.Once
+	builtin     *registry
+)
+
+// builtinRegistry returns the registry built from the embedded data.
+func builtinRegistry() *registry {
+	builtinOnce.Do(func() {
+		reg, err := newRegistry(EmbeddedLicenseData())
+		if err != nil {
+			// If JSON is invalid, categories will be empty
+			data := EmbeddedLicenseData()
+			data.Scancode = nil
+			reg, _ = newRegistry(data)
+		}
+		builtin = reg
+	})
+	return builtin
+}
+
+// newRegistry builds the lookup tables for data.
+func newRegistry(data LicenseData) (*registry, error) {
+	reg := &registry{data: data}
+
+	reg.licenses = make(map[string]string, len(data.Licenses)+len(data.Deprecated))
+	for _, id := range data.Licenses {
+		reg.licenses[strings.ToLower(id)] = id
+	}
+
+	reg.deprecated = make(map[string]string, len(data.Deprecated))
+	for _, id := range data.Deprecated {
+		lower := strings.ToLower(id)
+		reg.deprecated[lower] = id
+		if _, exists := reg.licenses[lower]; !exists {
+			reg.licenses[lower] = id
+		}
+	}
+
+	reg.canonical = make(map[string]bool, len(reg.licenses))
+	for _, id := range reg.licenses {
+		reg.canonical[id] = true
+	}
+
+	reg.exceptions = make(map[string]string, len(data.Exceptions))
+	for _, id := range data.Exceptions {
+		reg.exceptions[strings.ToLower(id)] = id
+	}
+
+	reg.categories = make(map[string]Category)
+	err := eachEntry(data.Scancode, func(entry *licenseEntry) {
+		cat := entryCategory(*entry)
+
+		// Map primary SPDX key
+		if entry.SPDXLicenseKey != "" {
+			reg.categories[strings.ToLower(entry.SPDXLicenseKey)] = cat
+		}
+
+		// Map alternative SPDX keys (skip LicenseRef- ones)
+		for _, key := range entry.OtherSPDXKeys {
+			if !strings.HasPrefix(key, "LicenseRef-") {
+				reg.categories[strings.ToLower(key)] = cat
+			}
+		}
+
+		// Also map the license_key itself
+		reg.categories[strings.ToLower(entry.LicenseKey)] = cat
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scancode license data: %w", err)
+	}
+	reg.categories[strings.ToLower(LicenseRefPublicDomain)] = CategoryPublicDomain
+
+	return reg, nil
+}
+
+// eachEntry decodes the scancode database one entry at a time, reusing a
+// single licenseEntry, so that building the category index doesn't
+// materialize the whole database.
+func eachEntry(scancode []byte, fn func(*licenseEntry)) error {
+	if len(scancode) == 0 {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(scancode))
+	if tok, err := dec.Token(); err != nil {
+		return err
+	} else if tok != json.Delim('[') {
+		return fmt.Errorf("expected an array, got %v", tok)
+	}
+	var entry licenseEntry
+	for dec.More() {
+		entry = licenseEntry{}
+		if err := dec.Decode(&entry); err != nil {
+			return err
+		}
+		fn(&entry)
+	}
+	_, err := dec.Token()
+	return err
+}
+
+// entries returns the scancode database sorted by license key, decoding
+// it on first use.
+func (r *registry) entries() []licenseEntry {
+	r.entriesOnce.Do(func() {
+		var list []licenseEntry
+		_ = eachEntry(r.data.Scancode, func(entry *licenseEntry) {
+			list = append(list, *entry)
+		})
+		// Keep ListLicenses and GetLicenseInfo independent of file order
+		slices.SortStableFunc(list, func(a, b licenseEntry) int {
+			return strings.Compare(a.LicenseKey, b.LicenseKey)
+		})
+		r.entryList = list
+	})
+	return r.entryList
+}
+
+// lookupLicense returns the canonical SPDX license ID for the given string,
+// or empty string if not found.
+func (r *registry) lookupLicense(s string) string {
+	return r.licenses[strings.ToLower(s)]
+}
+
+// isCanonicalLicense reports whether s is exactly a canonical SPDX license ID.
+func (r *registry) isCanonicalLicense(s string) bool {
+	return r.canonical[s]
+}
+
+// lookupException returns the canonical SPDX exception ID for the given string,
+// or empty string if not found.
+func (r *registry) lookupException(s string) string {
+	return r.exceptions[strings.ToLower(s)]
+}
+
+// category returns the category for a license identifier or scancode key,
+// falling back to the identifier without an -only/-or-later suffix.
+func (r *registry) category(license string) Category {
+	// Try exact match first
+	if cat, ok := r.categories[strings.ToLower(license)]; ok {
+		return cat
+	}
+
+	// Try without -only/-or-later suffixes
+	license = strings.TrimSuffix(license, "-only")
+	license = strings.TrimSuffix(license, "-or-later")
+	if cat, ok := r.categories[strings.ToLower(license)]; ok {
+		return cat
+	}
+
+	return CategoryUnknown
+}
+
+// lookupLicense returns the canonical SPDX license ID for the given string
+// in the current license data, or empty string if not found.
+func lookupLicense(s string) string {
+	return loadConfig().registry().lookupLicense(s)
+}
+
+// lookupException returns the canonical SPDX exception ID for the given
+// string in the current license data, or empty string if not found.
+func lookupException(s string) string {
+	return loadConfig().registry().lookupException(s)
+}
+
+// LicenseData is a set of license data for Reload.
+type LicenseData struct {
+	Licenses   []string // SPDX license identifiers
+	Deprecated []string // deprecated SPDX license identifiers
+	Exceptions []string // SPDX exception identifiers
+
+	// Scancode is a scancode license index in the format of the embedded
+	// licenses.json: a JSON array of objects with license_key, category,
+	// spdx_license_key, other_spdx_license_keys, is_exception and
+	// is_deprecated. It supplies categories and LicenseInfo.
+	Scancode []byte
+
+	// Source describes where the data came from, such as a URL or file
+	// path. It is reported by LicenseListVersion.
+	Source string
+	// Checksum, if set, is the expected Digest of this data as passed to
+	// Reload, before nil fields are filled in. Reload refuses data that
+	// does not match with ErrDigestMismatch.
+	Checksum string
+}
+
+// EmbeddedLicenseData returns the license data compiled into the package.
+func EmbeddedLicenseData() LicenseData {
+	return LicenseData{
+		Licenses:   spdxlicenses.GetLicenses(),
+		Deprecated: spdxlicenses.GetDeprecated(),
+		Exceptions: spdxlicenses.GetExceptions(),
+		Scancode:   licensesJSON,
+		Source:     embeddedSource,
+	}
+}
+
+// CurrentLicenseData returns the license data in use, which is the
+// embedded data unless Reload replaced it.
+func CurrentLicenseData() LicenseData {
+	return loadConfig().registry().data
+}
+
+// Reload atomically replaces the license data used by every function in
+// the package, for long-running services that refresh the license list
+// without restarting. Nil fields in data keep the data currently loaded.
+// Calls already in progress finish against the old data; each call loads
+// the data once, so it never mixes old and new. The Normalize cache is
+// discarded. The normalization rules and other Options are unchanged;
+// use SetDefaultOptions for those.
+//
+// Example:
+//
+//	scancode, _ := os.ReadFile("licenses.json")
+//	err := Reload(LicenseData{Scancode: scancode, Source: "licenses.json"})
+func Reload(data LicenseData) error {
+	if data.Checksum != "" {
+		if err := VerifyDigest(data.canonical(), data.Checksum); err != nil {
+			return err
+		}
+	}
+
+	for {
+		old := loadConfig()
+		merged := data
+		current := old.registry().data
+		if merged.Licenses == nil {
+			merged.Licenses = current.Licenses
+		}
+		if merged.Deprecated == nil {
+			merged.Deprecated = current.Deprecated
+		}
+		if merged.Exceptions == nil {
+			merged.Exceptions = current.Exceptions
+		}
+		if merged.Scancode == nil {
+			merged.Scancode = current.Scancode
+		}
+		// The checksum covered the data as passed, not the merged result
+		merged.Checksum = ""
+
+		reg, err := newRegistry(merged)
+		if err != nil {
+			return err
+		}
+
+		// Retry if SetDefaultOptions or another Reload won the race, so
+		// neither update is lost
+		if defaultConfig.CompareAndSwap(old, newConfig(old.opts, reg)) {
+			return nil
+		}
+	}
+}
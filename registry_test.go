@@ -0,0 +1,172 @@
+package spdx
+
+import (
+	"sync"
+	"testing"
+)
+
+// withLicenseData reloads the license data for the duration of a test.
+func withLicenseData(t *testing.T, data LicenseData) {
+	t.Helper()
+	prev := CurrentLicenseData()
+	if err := Reload(data); err != nil {
+		t.Fatalf("Reload: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := Reload(prev); err != nil {
+			t.Fatalf("restore: %v", err)
+		}
+	})
+}
+
+const testScancode = `[
+	{"license_key": "acme", "category": "Proprietary Free", "spdx_license_key": "LicenseRef-Acme"},
+	{"license_key": "mit", "category": "Copyleft", "spdx_license_key": "MIT"}
+]`
+
+func TestReload(t *testing.T) {
+	withLicenseData(t, LicenseData{
+		Licenses: []string{"MIT", "Acme-1.0"},
+		Scancode: []byte(testScancode),
+	})
+
+	if !ValidLicense("Acme-1.0") {
+		t.Error("Acme-1.0 should be valid after Reload")
+	}
+	if ValidLicense("Apache-2.0") {
+		t.Error("Apache-2.0 should be invalid after Reload")
+	}
+	if got, err := Normalize("acme-1.0"); err != nil || got != "Acme-1.0" {
+		t.Errorf("Normalize(acme-1.0) = %q, %v", got, err)
+	}
+	if got := LicenseCategory("MIT"); got != CategoryCopyleft {
+		t.Errorf("LicenseCategory(MIT) = %q, want %q", got, CategoryCopyleft)
+	}
+	if info := GetLicenseInfo("acme"); info == nil || info.SPDXKey != "LicenseRef-Acme" {
+		t.Errorf("GetLicenseInfo(acme) = %+v", info)
+	}
+	if _, err := Parse("MIT AND Apache-2.0"); err == nil {
+		t.Error("Parse should reject a license missing from the reloaded data")
+	}
+
+	// Exceptions were nil, so the embedded ones are kept
+	if _, err := Parse("MIT WITH Classpath-exception-2.0"); err != nil {
+		t.Errorf("embedded exceptions should be kept: %v", err)
+	}
+}
+
+func TestReloadRestores(t *testing.T) {
+	prev := CurrentLicenseData()
+	if err := Reload(LicenseData{Licenses: []string{"Acme-1.0"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := Reload(prev); err != nil {
+		t.Fatal(err)
+	}
+	if !ValidLicense("MIT") || ValidLicense("Acme-1.0") {
+		t.Error("Reload of the previous data should restore it")
+	}
+}
+
+func TestReloadInvalidScancode(t *testing.T) {
+	err := Reload(LicenseData{Licenses: []string{"Acme-1.0"}, Scancode: []byte("{")})
+	if err == nil {
+		t.Fatal("Reload should fail on invalid scancode JSON")
+	}
+	if ValidLicense("Acme-1.0") || !ValidLicense("MIT") {
+		t.Error("a failed Reload should leave the data unchanged")
+	}
+}
+
+func TestReloadKeepsOptions(t *testing.T) {
+	withOptions(t, Options{GPLPolicy: GPLOnly, CacheSize: 16})
+	if got, _ := Normalize("Acme-1.0"); got != "" {
+		t.Fatalf("Normalize(Acme-1.0) = %q before Reload", got)
+	}
+
+	withLicenseData(t, LicenseData{Licenses: []string{"GPL-3.0", "Acme-1.0"}})
+
+	if got := DefaultOptions().GPLPolicy; got != GPLOnly {
+		t.Errorf("GPLPolicy = %v after Reload, want GPLOnly", got)
+	}
+	// The cache is discarded, so the earlier failure is not replayed
+	if got, err := Normalize("Acme-1.0"); err != nil || got != "Acme-1.0" {
+		t.Errorf("Normalize(Acme-1.0) = %q, %v", got, err)
+	}
+	if got, _ := Normalize("GPL-3.0"); got != "GPL-3.0-only" {
+		t.Errorf("Normalize(GPL-3.0) = %q, want GPL-3.0-only", got)
+	}
+
+	// Changing options later keeps the reloaded data
+	SetDefaultOptions(Options{})
+	if !ValidLicense("Acme-1.0") {
+		t.Error("SetDefaultOptions should keep the reloaded data")
+	}
+}
+
+func TestReloadConcurrent(t *testing.T) {
+	prev := CurrentLicenseData()
+	t.Cleanup(func() { _ = Reload(prev) })
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				if _, err := Parse("MIT OR Apache-2.0"); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+	for i := 0; i < 20; i++ {
+		if err := Reload(LicenseData{Deprecated: prev.Deprecated}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wg.Wait()
+}
+
+func TestRegistryEntriesAreLazy(t *testing.T) {
+	reg, err := newRegistry(LicenseData{Scancode: []byte(`[
+		{"license_key": "zlib", "category": "Permissive", "spdx_license_key": "Zlib"},
+		{"license_key": "acme", "category": "Proprietary Free", "spdx_license_key": "LicenseRef-Acme", "other_spdx_license_keys": ["Acme-1.0"]}
+	]`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := reg.category("acme-1.0"); got != CategoryProprietaryFree {
+		t.Errorf("category(acme-1.0) = %q", got)
+	}
+	if reg.entryList != nil {
+		t.Error("category lookups should not decode the entries")
+	}
+
+	entries := reg.entries()
+	if len(entries) != 2 || entries[0].LicenseKey != "acme" || entries[0].OtherSPDXKeys[0] != "Acme-1.0" {
+		t.Errorf("entries() = %+v, want both entries sorted by key", entries)
+	}
+}
+
+func TestNewRegistryRejectsNonArray(t *testing.T) {
+	for _, scancode := range []string{`{}`, `[{"license_key": 1}]`, `[`} {
+		if _, err := newRegistry(LicenseData{Scancode: []byte(scancode)}); err == nil {
+			t.Errorf("newRegistry(%s) should fail", scancode)
+		}
+	}
+}
+
+func BenchmarkLicenseCategoryCold(b *testing.B) {
+	data := EmbeddedLicenseData()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		reg, err := newRegistry(data)
+		if err != nil {
+			b.Fatal(err)
+		}
+		reg.category("MIT")
+	}
+}
@@ -0,0 +1,388 @@
+package spdx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// ErrUnresolvedURL is returned when a URL names no license that could be
+// resolved: offline heuristics found none and there was no text to fetch,
+// or the fetched text did not name one either.
+var ErrUnresolvedURL = errors.New("unresolved license URL")
+
+// ResolveMethod is how a URLResolver resolved a URL.
+type ResolveMethod string
+
+const (
+	// ResolveLicensePage is a known license page, such as
+	// opensource.org/licenses/MIT or spdx.org/licenses/Apache-2.0.html.
+	ResolveLicensePage ResolveMethod = "license-page"
+	// ResolveFileName is a repository file whose name names the license,
+	// such as LICENSE-MIT or COPYING.APACHE.
+	ResolveFileName ResolveMethod = "file-name"
+	// ResolveFetchedText is a repository file whose fetched text names the
+	// license, in an SPDX-License-Identifier line or its title.
+	ResolveFetchedText ResolveMethod = "fetched-text"
+)
+
+// URLResolution is the result of resolving one license URL.
+type URLResolution struct {
+	URL        string
+	Expression Expression    // the license, or nil if unresolved
+	Method     ResolveMethod // how Expression was found, if it was
+	Input      string        // the string Expression was parsed from
+	// FetchURL is the raw file to fetch for a repository link whose file
+	// name does not name the license, such as
+	// https://raw.githubusercontent.com/foo/bar/main/LICENSE. It is set
+	// whether or not the URL was resolved.
+	FetchURL string
+}
+
+// URLResolver turns license URLs from package metadata, such as
+// "https://github.com/foo/bar/blob/main/LICENSE", into licenses. Known
+// license pages and file names like LICENSE-MIT resolve offline; other
+// repository files resolve by fetching their raw text with Fetch and
+// reading its SPDX-License-Identifier line or title. The zero value
+// resolves offline only.
+//
+// Example:
+//
+//	r := &URLResolver{Fetch: myFetch}
+//	res, err := r.Resolve(ctx, "https://github.com/foo/bar/blob/main/LICENSE")
+//	res.FetchURL               // "https://raw.githubusercontent.com/foo/bar/main/LICENSE"
+//	res.Expression.String()    // "MIT", if the file's title is "MIT License"
+type URLResolver struct {
+	// Fetch returns the text at a URL. It is only called with the raw
+	// file URLs of repository links, and is supplied by the caller so
+	// that network access, caching and rate limits stay under its control.
+	// Nil keeps resolution offline.
+	Fetch func(ctx context.Context, url string) (string, error)
+}
+
+// ResolveURL resolves a license URL offline, as a URLResolver without
+// Fetch does. A repository link that needs fetching returns
+// ErrUnresolvedURL with FetchURL set, so the caller can fetch the text
+// itself and pass it to ParseLicenseText.
+//
+// Example:
+//
+//	res, _ := ResolveURL("https://opensource.org/licenses/MIT")
+//	res.Expression.String()  // "MIT"
+//	res, _ = ResolveURL("https://github.com/foo/bar/blob/main/LICENSE-APACHE")
+//	res.Expression.String()  // "Apache-2.0"
+func ResolveURL(rawURL string) (URLResolution, error) {
+	var r URLResolver
+	return r.Resolve(context.Background(), rawURL)
+}
+
+// Resolve resolves a license URL. Input that is not an http or https URL
+// returns ErrUnresolvedURL, as does a URL the heuristics and the fetched
+// text cannot resolve. Errors from Fetch are returned wrapped.
+func (r *URLResolver) Resolve(ctx context.Context, rawURL string) (URLResolution, error) {
+	return r.resolve(ctx, rawURL, loadConfig())
+}
+
+// resolve implements Resolve using the given options snapshot.
+func (r *URLResolver) resolve(ctx context.Context, rawURL string, cfg *config) (URLResolution, error) {
+	res := URLResolution{URL: rawURL}
+	trimmed := strings.TrimSpace(rawURL)
+	if !isURL(trimmed) {
+		return res, fmt.Errorf("%w: %s", ErrUnresolvedURL, rawURL)
+	}
+	u, err := url.Parse(trimmed)
+	if err != nil {
+		return res, fmt.Errorf("%w: %s", ErrUnresolvedURL, rawURL)
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	path := strings.Trim(u.Path, "/")
+
+	if input := licensePageInput(host, path); input != "" {
+		if expr, err := parseConfig(input, false, cfg); err == nil {
+			res.Expression, res.Method, res.Input = expr, ResolveLicensePage, input
+			return res, nil
+		}
+	}
+
+	fetchURL, file := repositoryFile(host, path)
+	if fetchURL == "" {
+		return res, fmt.Errorf("%w: %s", ErrUnresolvedURL, rawURL)
+	}
+	res.FetchURL = fetchURL
+
+	if input := licenseFileInput(file); input != "" {
+		if expr, err := parseConfig(input, false, cfg); err == nil {
+			res.Expression, res.Method, res.Input = expr, ResolveFileName, input
+			return res, nil
+		}
+	}
+
+	if r.Fetch == nil {
+		return res, fmt.Errorf("%w: %s", ErrUnresolvedURL, rawURL)
+	}
+	text, err := r.Fetch(ctx, fetchURL)
+	if err != nil {
+		return res, fmt.Errorf("fetching %s: %w", fetchURL, err)
+	}
+	expr, input, err := parseLicenseText(text, cfg)
+	if err != nil {
+		return res, fmt.Errorf("%w: %s", ErrUnresolvedURL, rawURL)
+	}
+	res.Expression, res.Method, res.Input = expr, ResolveFetchedText, input
+	return res, nil
+}
+
+// reSPDXPage matches the path of an spdx.org license list page.
+var reSPDXPage = regexp.MustCompile(`^licenses/([A-Za-z0-9.+-]+?)(?:\.html|\.json|\.txt)?$`)
+
+// reGNUPage matches the path of a GNU license page, such as
+// licenses/old-licenses/gpl-2.0.html or licenses/lgpl-3.0-standalone.html.
+var reGNUPage = regexp.MustCompile(`^licenses/(?:old-licenses/)?((?:a|l)?gpl-\d\.\d)(?:-standalone)?(?:\.en)?(?:\.html|\.txt)?$`)
+
+// reCCPage matches the path of a Creative Commons license deed or legal
+// code, such as licenses/by-sa/4.0/legalcode.
+var reCCPage = regexp.MustCompile(`^(?:licenses/(by(?:-nc)?(?:-nd|-sa)?)|publicdomain/(zero))/(\d\.\d)(?:/(?:legalcode(?:\.txt)?|deed\.\w+))?$`)
+
+// licensePageInput returns the license string for a known license page,
+// or "" if host and path are not one.
+func licensePageInput(host, path string) string {
+	switch host {
+	case "spdx.org":
+		if m := reSPDXPage.FindStringSubmatch(path); m != nil {
+			return m[1]
+		}
+	case "opensource.org":
+		if rest, ok := strings.CutPrefix(path, "licenses/"); ok {
+			return strings.TrimSuffix(rest, ".php")
+		}
+		if rest, ok := strings.CutPrefix(path, "license/"); ok {
+			return rest
+		}
+	case "choosealicense.com":
+		if rest, ok := strings.CutPrefix(path, "licenses/"); ok && !strings.Contains(rest, "/") {
+			return rest
+		}
+	case "gnu.org":
+		if m := reGNUPage.FindStringSubmatch(path); m != nil {
+			return m[1]
+		}
+	case "apache.org":
+		if strings.HasPrefix(path, "licenses/LICENSE-2.0") {
+			return "Apache-2.0"
+		}
+	case "creativecommons.org":
+		if m := reCCPage.FindStringSubmatch(path); m != nil {
+			if m[2] != "" {
+				return "CC0-" + m[3]
+			}
+			return "CC-" + m[1] + "-" + m[3]
+		}
+	case "mozilla.org":
+		if strings.HasPrefix(path, "MPL/2.0") || strings.HasPrefix(path, "en-US/MPL/2.0") {
+			return "MPL-2.0"
+		}
+	}
+	return ""
+}
+
+// repositoryFile returns the raw file URL and file name for a link to a
+// file on a code host, or the raw URL of a LICENSE file at the default
+// branch for a link to a repository itself. It returns "" for other URLs.
+func repositoryFile(host, path string) (fetchURL, file string) {
+	parts := strings.Split(path, "/")
+	switch host {
+	case "github.com":
+		if len(parts) == 2 {
+			return "https://raw.githubusercontent.com/" + path + "/HEAD/LICENSE", "LICENSE"
+		}
+		if len(parts) > 4 && (parts[2] == "blob" || parts[2] == "raw") {
+			return "https://raw.githubusercontent.com/" + parts[0] + "/" + parts[1] + "/" + strings.Join(parts[3:], "/"), parts[len(parts)-1]
+		}
+	case "raw.githubusercontent.com":
+		if len(parts) > 3 {
+			return "https://raw.githubusercontent.com/" + path, parts[len(parts)-1]
+		}
+	case "gitlab.com":
+		if before, after, ok := strings.Cut(path, "/-/blob/"); ok {
+			return "https://gitlab.com/" + before + "/-/raw/" + after, parts[len(parts)-1]
+		}
+		if strings.Contains(path, "/-/raw/") {
+			return "https://gitlab.com/" + path, parts[len(parts)-1]
+		}
+	case "bitbucket.org", "codeberg.org":
+		if len(parts) > 4 && (parts[2] == "src" || parts[2] == "raw") {
+			return "https://" + host + "/" + parts[0] + "/" + parts[1] + "/raw/" + strings.Join(parts[3:], "/"), parts[len(parts)-1]
+		}
+	}
+	return "", ""
+}
+
+// reLicenseFile matches a license file name, capturing the part that
+// names the license in names like LICENSE-MIT or COPYING.APACHE.
+var reLicenseFile = regexp.MustCompile(`(?i)^(?:licen[cs]e|copying)(?:[._-](.+?))?(?:\.(?:txt|md|markdown|rst|html))?$`)
+
+// licenseFileInput returns the license a file name names, or "" if it is
+// not a license file or only a generic one like LICENSE or COPYING.txt.
+func licenseFileInput(file string) string {
+	if strings.EqualFold(file, "UNLICENSE") || strings.EqualFold(file, "UNLICENSE.txt") || strings.EqualFold(file, "UNLICENSE.md") {
+		return "Unlicense"
+	}
+	m := reLicenseFile.FindStringSubmatch(file)
+	if m == nil {
+		return ""
+	}
+	return m[1]
+}
+
+// reTitleSuffix matches what follows a license title on its line: a
+// release date, as in "Version 2.0, January 2004" and "Version 3, 29 June
+// 2007", or a parenthetical, as in "The MIT License (MIT)".
+var reTitleSuffix = regexp.MustCompile(`(?i)(?:(?:,|\s-)?\s+(?:\d{1,2}(?:st|nd|rd|th)?\s+)?(?:january|february|march|april|may|june|july|august|september|october|november|december)\s+(?:\d{1,2}(?:st|nd|rd|th)?,?\s+)?\d{4}|\s*\([^()]*\))$`)
+
+// titleScanLines is how many non-blank lines from the top of a license
+// file are tried as its title.
+const titleScanLines = 3
+
+// ParseLicenseText returns the license a license file's text names. It
+// uses the first SPDX-License-Identifier line near the top, and otherwise
+// the title, such as "MIT License" or "GNU GENERAL PUBLIC LICENSE /
+// Version 3, 29 June 2007". A title only counts if it normalizes with at
+// least ConfidenceTransposition. It does not compare full license texts,
+// so a file without a tag or title returns ErrInvalidLicense.
+//
+// Example:
+//
+//	ParseLicenseText("Apache License\n    Version 2.0, January 2004\n...")
+//	// Apache-2.0, nil
+func ParseLicenseText(text string) (Expression, error) {
+	expr, _, err := parseLicenseText(text, loadConfig())
+	return expr, err
+}
+
+// parseLicenseText implements ParseLicenseText, also returning the string
+// the expression was parsed from.
+func parseLicenseText(text string, cfg *config) (Expression, string, error) {
+	lines := strings.Split(text, "\n")
+	for i := 0; i < len(lines) && i < headerScanLines; i++ {
+		if _, tag, ok := strings.Cut(lines[i], spdxTag); ok {
+			tag = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(tag), "*/"))
+			if expr, err := parseConfig(tag, false, cfg); err == nil {
+				return expr, tag, nil
+			}
+		}
+	}
+
+	var title []string
+	for _, line := range lines {
+		line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "#=*-"))
+		if line == "" {
+			continue
+		}
+		// "Boost Software License - Version 1.0" separates with dashes
+		line = strings.ReplaceAll(reTitleSuffix.ReplaceAllString(line, ""), " - ", " ")
+		title = append(title, line)
+		if len(title) == titleScanLines {
+			break
+		}
+	}
+	// Try the longest title first, so "Apache License" gets its version
+	for n := len(title); n > 0; n-- {
+		candidate := strings.Join(title[:n], " ")
+		id, confidence := guessLicense(candidate, cfg)
+		if id == "" || confidence < ConfidenceTransposition || confidence < cfg.opts.MinConfidence {
+			continue
+		}
+		id = cfg.upgrade(id)
+		if expr, err := parseConfig(id, false, cfg); err == nil {
+			return expr, candidate, nil
+		}
+	}
+	return nil, "", ErrInvalidLicense
+}
+
+// Resolver fetches the content at a license URL, such as a license page
+// or a raw LICENSE file. Set Options.Resolver to let Parse and Normalize
+// resolve license strings that are only a URL; without one they never
+// touch the network. Implementations must be safe for concurrent use.
+type Resolver interface {
+	Resolve(url string) ([]byte, error)
+}
+
+// ResolverFunc adapts a function to the Resolver interface.
+//
+// Example:
+//
+//	SetDefaultOptions(Options{Resolver: CachingResolver(ResolverFunc(fetch), 1000)})
+type ResolverFunc func(url string) ([]byte, error)
+
+// Resolve calls f(url).
+func (f ResolverFunc) Resolve(url string) ([]byte, error) {
+	return f(url)
+}
+
+// CachingResolver wraps r so that each URL is fetched once while its
+// content stays cached. Up to size URLs are cached; when the cache is
+// full it is cleared. Failed fetches are not cached, so they are retried.
+func CachingResolver(r Resolver, size int) Resolver {
+	return &cachingResolver{r: r, size: size, entries: make(map[string][]byte)}
+}
+
+type cachingResolver struct {
+	r       Resolver
+	mu      sync.RWMutex
+	size    int
+	entries map[string][]byte
+}
+
+func (c *cachingResolver) Resolve(url string) ([]byte, error) {
+	c.mu.RLock()
+	data, ok := c.entries[url]
+	c.mu.RUnlock()
+	if ok {
+		return data, nil
+	}
+	data, err := c.r.Resolve(url)
+	if err != nil || c.size <= 0 {
+		return data, err
+	}
+	c.mu.Lock()
+	if len(c.entries) >= c.size {
+		clear(c.entries)
+	}
+	c.entries[url] = data
+	c.mu.Unlock()
+	return data, nil
+}
+
+// resolveURL resolves a license string that is only a URL through
+// Options.Resolver, as a URLResolver fetching with it does. It returns ""
+// without a Resolver, and for URLs that do not resolve to a single
+// license, which is all Normalize can return.
+func (c *config) resolveURL(license string) string {
+	if c.opts.Resolver == nil || !isURL(license) {
+		return ""
+	}
+	r := URLResolver{Fetch: func(_ context.Context, url string) (string, error) {
+		data, err := c.opts.Resolver.Resolve(url)
+		return string(data), err
+	}}
+	res, err := r.resolve(context.Background(), license, c)
+	if err != nil {
+		c.debug("spdx: unresolved URL", "input", license, "error", err)
+		return ""
+	}
+	l, ok := res.Expression.(*License)
+	if !ok || l.Exception != "" {
+		return ""
+	}
+	id := l.ID
+	if l.Plus {
+		id += "+"
+	}
+	c.debug("spdx: resolved URL", "input", license, "method", string(res.Method), "result", id)
+	return id
+}
@@ -0,0 +1,200 @@
+package spdx
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestResolveURL(t *testing.T) {
+	tests := []struct {
+		url    string
+		want   string
+		method ResolveMethod
+	}{
+		{"https://opensource.org/licenses/MIT", "MIT", ResolveLicensePage},
+		{"https://opensource.org/license/bsd-3-clause/", "BSD-3-Clause", ResolveLicensePage},
+		{"https://spdx.org/licenses/Apache-2.0.html", "Apache-2.0", ResolveLicensePage},
+		{"https://www.gnu.org/licenses/old-licenses/lgpl-2.1.en.html", "LGPL-2.1-only", ResolveLicensePage},
+		{"http://www.apache.org/licenses/LICENSE-2.0.txt", "Apache-2.0", ResolveLicensePage},
+		{"https://creativecommons.org/licenses/by-sa/4.0/legalcode", "CC-BY-SA-4.0", ResolveLicensePage},
+		{"https://creativecommons.org/publicdomain/zero/1.0/", "CC0-1.0", ResolveLicensePage},
+		{"https://choosealicense.com/licenses/mit/", "MIT", ResolveLicensePage},
+		{"https://www.mozilla.org/en-US/MPL/2.0/", "MPL-2.0", ResolveLicensePage},
+		{"https://github.com/foo/bar/blob/main/LICENSE-MIT", "MIT", ResolveFileName},
+		{"https://raw.githubusercontent.com/foo/bar/master/LICENSE-APACHE", "Apache-2.0", ResolveFileName},
+		{"https://github.com/foo/bar/blob/main/UNLICENSE", "Unlicense", ResolveFileName},
+	}
+
+	for _, tt := range tests {
+		res, err := ResolveURL(tt.url)
+		if err != nil || res.Expression == nil || res.Expression.String() != tt.want || res.Method != tt.method {
+			t.Errorf("ResolveURL(%q) = %v (%s), %v; want %s (%s)", tt.url, res.Expression, res.Method, err, tt.want, tt.method)
+		}
+	}
+}
+
+func TestResolveURLNeedsFetch(t *testing.T) {
+	tests := map[string]string{
+		"https://github.com/foo/bar/blob/main/LICENSE":          "https://raw.githubusercontent.com/foo/bar/main/LICENSE",
+		"https://github.com/foo/bar/raw/v1.2/docs/COPYING":      "https://raw.githubusercontent.com/foo/bar/v1.2/docs/COPYING",
+		"https://github.com/foo/bar":                            "https://raw.githubusercontent.com/foo/bar/HEAD/LICENSE",
+		"https://gitlab.com/group/sub/repo/-/blob/main/LICENSE": "https://gitlab.com/group/sub/repo/-/raw/main/LICENSE",
+		"https://bitbucket.org/foo/bar/src/master/LICENSE.txt":  "https://bitbucket.org/foo/bar/raw/master/LICENSE.txt",
+		"https://codeberg.org/foo/bar/src/branch/main/LICENSE":  "https://codeberg.org/foo/bar/raw/branch/main/LICENSE",
+	}
+
+	for input, fetchURL := range tests {
+		res, err := ResolveURL(input)
+		if !errors.Is(err, ErrUnresolvedURL) || res.FetchURL != fetchURL {
+			t.Errorf("ResolveURL(%q) = FetchURL %q, %v; want %q, ErrUnresolvedURL", input, res.FetchURL, err, fetchURL)
+		}
+	}
+
+	for _, input := range []string{"https://example.com/license", "MIT", "see https://opensource.org/licenses/MIT"} {
+		res, err := ResolveURL(input)
+		if !errors.Is(err, ErrUnresolvedURL) || res.FetchURL != "" {
+			t.Errorf("ResolveURL(%q) = FetchURL %q, %v; want ErrUnresolvedURL", input, res.FetchURL, err)
+		}
+	}
+	if _, err := ResolveURL("https://example.com/license"); ErrorCode(err) != CodeUnresolvedURL {
+		t.Errorf("ErrorCode(ResolveURL error) = %q, want %q", ErrorCode(err), CodeUnresolvedURL)
+	}
+}
+
+func TestURLResolverFetch(t *testing.T) {
+	files := map[string]string{
+		"https://raw.githubusercontent.com/foo/bar/main/LICENSE":    "MIT License\n\nCopyright (c) 2024 Foo\n",
+		"https://raw.githubusercontent.com/foo/baz/main/LICENSE":    "Copyright (c) 2024 Baz\n\nPermission is hereby granted\n",
+		"https://raw.githubusercontent.com/foo/qux/main/COPYING":    "// SPDX-License-Identifier: GPL-2.0-only OR MIT\n",
+		"https://raw.githubusercontent.com/foo/apache/main/LICENSE": "\n                                 Apache License\n                           Version 2.0, January 2004\n",
+	}
+	var fetched []string
+	r := &URLResolver{Fetch: func(ctx context.Context, url string) (string, error) {
+		fetched = append(fetched, url)
+		text, ok := files[url]
+		if !ok {
+			return "", errors.New("404 Not Found")
+		}
+		return text, nil
+	}}
+	ctx := context.Background()
+
+	res, err := r.Resolve(ctx, "https://github.com/foo/bar/blob/main/LICENSE")
+	if err != nil || res.Expression.String() != "MIT" || res.Method != ResolveFetchedText || res.Input != "MIT License" {
+		t.Errorf("Resolve(bar) = %+v, %v", res, err)
+	}
+	res, err = r.Resolve(ctx, "https://github.com/foo/qux/blob/main/COPYING")
+	if err != nil || res.Expression.String() != "GPL-2.0-only OR MIT" {
+		t.Errorf("Resolve(qux) = %v, %v", res.Expression, err)
+	}
+	res, err = r.Resolve(ctx, "https://github.com/foo/apache/blob/main/LICENSE")
+	if err != nil || res.Expression.String() != "Apache-2.0" {
+		t.Errorf("Resolve(apache) = %v, %v", res.Expression, err)
+	}
+	if _, err := r.Resolve(ctx, "https://github.com/foo/baz/blob/main/LICENSE"); !errors.Is(err, ErrUnresolvedURL) {
+		t.Errorf("Resolve(baz) error = %v, want ErrUnresolvedURL", err)
+	}
+	if _, err := r.Resolve(ctx, "https://github.com/foo/missing/blob/main/LICENSE"); err == nil || errors.Is(err, ErrUnresolvedURL) {
+		t.Errorf("Resolve(missing) error = %v, want the fetch error", err)
+	}
+
+	// Offline heuristics come first
+	fetched = nil
+	if _, err := r.Resolve(ctx, "https://github.com/foo/bar/blob/main/LICENSE-MIT"); err != nil || len(fetched) != 0 {
+		t.Errorf("Resolve(LICENSE-MIT) fetched %v, %v; want no fetch", fetched, err)
+	}
+}
+
+func TestParseLicenseText(t *testing.T) {
+	tests := map[string]string{
+		"MIT License\n\nCopyright (c) 2024":                                        "MIT",
+		"The MIT License (MIT)\n\nCopyright":                                       "MIT",
+		"# BSD 3-Clause License\n\nCopyright":                                      "BSD-3-Clause",
+		"Mozilla Public License Version 2.0\n=====":                                "MPL-2.0",
+		"Eclipse Public License - v 2.0\n\nTHE":                                    "EPL-2.0",
+		"    GNU GENERAL PUBLIC LICENSE\n       Version 3, 29 June 2007\n":         "GPL-3.0-or-later",
+		"  GNU LESSER GENERAL PUBLIC LICENSE\n       Version 2.1, February 1999\n": "LGPL-2.1-only",
+		"/* SPDX-License-Identifier: Apache-2.0 */\n":                              "Apache-2.0",
+	}
+
+	for text, want := range tests {
+		expr, err := ParseLicenseText(text)
+		if err != nil || expr.String() != want {
+			t.Errorf("ParseLicenseText(%q) = %v, %v; want %s", text, expr, err, want)
+		}
+	}
+
+	for _, text := range []string{"", "Copyright (c) 2024 Foo\n\nPermission is hereby granted", "MIT Press\nCambridge"} {
+		if expr, err := ParseLicenseText(text); !errors.Is(err, ErrInvalidLicense) {
+			t.Errorf("ParseLicenseText(%q) = %v, %v; want ErrInvalidLicense", text, expr, err)
+		}
+	}
+}
+
+func TestOptionsResolver(t *testing.T) {
+	const licenseURL = "https://github.com/foo/bar/blob/main/LICENSE"
+	var calls int
+	fetch := ResolverFunc(func(url string) ([]byte, error) {
+		calls++
+		if url != "https://raw.githubusercontent.com/foo/bar/main/LICENSE" {
+			return nil, errors.New("404 Not Found")
+		}
+		return []byte("Apache License\nVersion 2.0, January 2004\n"), nil
+	})
+
+	// Offline by default: the URL is not resolved
+	if got, err := Normalize(licenseURL); got == "Apache-2.0" {
+		t.Errorf("Normalize(%q) without a Resolver = %q, %v", licenseURL, got, err)
+	}
+
+	withOptions(t, Options{Resolver: CachingResolver(fetch, 10)})
+
+	for range 2 {
+		if got, err := Normalize(licenseURL); err != nil || got != "Apache-2.0" {
+			t.Errorf("Normalize(%q) = %q, %v; want Apache-2.0", licenseURL, got, err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Resolver called %d times, want 1 with caching", calls)
+	}
+
+	expr, err := Parse(licenseURL + " OR MIT")
+	if err != nil || expr.String() != "Apache-2.0 OR MIT" {
+		t.Errorf("Parse with a URL = %v, %v", expr, err)
+	}
+	if got, err := Normalize("https://opensource.org/licenses/MIT"); err != nil || got != "MIT" || calls != 1 {
+		t.Errorf("Normalize(license page) = %q, %v after %d fetches; want MIT without fetching", got, err, calls)
+	}
+	rec := NormalizeRecord(licenseURL)
+	if rec.Output != "Apache-2.0" || rec.Confidence != ConfidenceTransform || !slices.Contains(rec.Rules, recordStageResolveURL) {
+		t.Errorf("NormalizeRecord(%q) = %+v", licenseURL, rec)
+	}
+
+	// Fetch failures fall through to the offline heuristics
+	if _, err := Normalize("https://github.com/foo/missing/blob/main/LICENSE"); err == nil {
+		t.Error("Normalize of an unfetchable URL should fail")
+	}
+}
+
+func TestCachingResolver(t *testing.T) {
+	var calls int
+	r := CachingResolver(ResolverFunc(func(url string) ([]byte, error) {
+		calls++
+		if url == "bad" {
+			return nil, errors.New("unavailable")
+		}
+		return []byte(url), nil
+	}), 2)
+
+	for _, url := range []string{"a", "a", "b", "a", "bad", "bad"} {
+		r.Resolve(url)
+	}
+	if calls != 4 {
+		t.Errorf("calls = %d, want 4: failures are not cached", calls)
+	}
+	if data, err := r.Resolve("b"); err != nil || string(data) != "b" {
+		t.Errorf("Resolve(b) = %q, %v", data, err)
+	}
+}
@@ -0,0 +1,68 @@
+package spdx
+
+import "maps"
+
+// Rewrite returns a copy of expr with fn applied to every node, bottom
+// up: the operands of an AND or OR are rewritten before fn sees the node
+// built from them. fn receives a copy it may change in place, such as
+// clearing a license's exception, and returns the node to use, which may
+// be a different expression. Returning nil removes the node; an AND or OR
+// left with one operand becomes that operand, and Rewrite returns nil if
+// the whole tree is removed. expr itself is not changed.
+//
+// Example:
+//
+//	expr, _ := Parse("MIT OR GPL-2.0-only WITH Classpath-exception-2.0")
+//	Rewrite(expr, func(e Expression) Expression {
+//		if l, ok := e.(*License); ok {
+//			l.Exception = ""
+//		}
+//		return e
+//	}).String()
+//	// "MIT OR GPL-2.0-only"
+//
+//	Rewrite(expr, func(e Expression) Expression {
+//		if l, ok := e.(*License); ok && l.ID == "MIT" {
+//			return nil
+//		}
+//		return e
+//	}).String()
+//	// "GPL-2.0-only WITH Classpath-exception-2.0"
+func Rewrite(expr Expression, fn func(Expression) Expression) Expression {
+	switch e := expr.(type) {
+	case *AndExpression:
+		left, right := Rewrite(e.Left, fn), Rewrite(e.Right, fn)
+		if left == nil || right == nil {
+			return rewriteOperand(left, right)
+		}
+		return fn(&AndExpression{Left: left, Right: right, Annotations: maps.Clone(e.Annotations)})
+	case *OrExpression:
+		left, right := Rewrite(e.Left, fn), Rewrite(e.Right, fn)
+		if left == nil || right == nil {
+			return rewriteOperand(left, right)
+		}
+		return fn(&OrExpression{Left: left, Right: right, Annotations: maps.Clone(e.Annotations)})
+	case *License:
+		l := *e
+		l.Annotations = maps.Clone(e.Annotations)
+		return fn(&l)
+	case *LicenseRef:
+		r := *e
+		r.Annotations = maps.Clone(e.Annotations)
+		return fn(&r)
+	case *SpecialValue:
+		s := *e
+		s.Annotations = maps.Clone(e.Annotations)
+		return fn(&s)
+	}
+	return expr
+}
+
+// rewriteOperand returns the operand of an AND or OR that Rewrite did not
+// remove, or nil if both were.
+func rewriteOperand(left, right Expression) Expression {
+	if left != nil {
+		return left
+	}
+	return right
+}
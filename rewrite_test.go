@@ -0,0 +1,94 @@
+package spdx
+
+import "testing"
+
+func TestRewrite(t *testing.T) {
+	stripExceptions := func(e Expression) Expression {
+		if l, ok := e.(*License); ok {
+			l.Exception = ""
+		}
+		return e
+	}
+	upgradeDeprecated := func(e Expression) Expression {
+		if l, ok := e.(*License); ok && l.ID == "GPL-2.0" && l.Plus {
+			return &License{ID: "GPL-2.0-or-later", Exception: l.Exception}
+		}
+		return e
+	}
+	dropMIT := func(e Expression) Expression {
+		if l, ok := e.(*License); ok && l.ID == "MIT" {
+			return nil
+		}
+		return e
+	}
+	orToAnd := func(e Expression) Expression {
+		if or, ok := e.(*OrExpression); ok {
+			return &AndExpression{Left: or.Left, Right: or.Right}
+		}
+		return e
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		fn    func(Expression) Expression
+		want  string
+	}{
+		{"strip exceptions", "MIT OR GPL-2.0-only WITH Classpath-exception-2.0", stripExceptions, "MIT OR GPL-2.0-only"},
+		{"replace deprecated", "GPL-2.0+ WITH Classpath-exception-2.0 AND MIT", upgradeDeprecated, "GPL-2.0-or-later WITH Classpath-exception-2.0 AND MIT"},
+		{"remove operand", "MIT OR (Apache-2.0 AND MIT) OR ISC", dropMIT, "Apache-2.0 OR ISC"},
+		{"remove everything", "MIT AND MIT", dropMIT, ""},
+		{"replace operators", "(MIT OR ISC) AND Apache-2.0", orToAnd, "MIT AND ISC AND Apache-2.0"},
+		{"identity", "NONE", func(e Expression) Expression { return e }, "NONE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := ParseStrict(tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			before := expr.String()
+			got := Rewrite(expr, tt.fn)
+			if tt.want == "" {
+				if got != nil {
+					t.Errorf("Rewrite = %q, want nil", got)
+				}
+			} else if got == nil || got.String() != tt.want {
+				t.Errorf("Rewrite = %v, want %q", got, tt.want)
+			}
+			if expr.String() != before {
+				t.Errorf("Rewrite changed its input to %q", expr.String())
+			}
+		})
+	}
+}
+
+func TestRewriteAnnotations(t *testing.T) {
+	expr, err := Parse("MIT OR ISC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	Annotate(expr, "ticket", "LEGAL-1")
+	Annotate(expr.(*OrExpression).Left, "reviewer", "sam")
+
+	got := Rewrite(expr, func(e Expression) Expression {
+		Annotate(e, "rewritten", "yes")
+		return e
+	})
+	if AnnotationsOf(got)["ticket"] != "LEGAL-1" || AnnotationsOf(got.(*OrExpression).Left)["reviewer"] != "sam" {
+		t.Errorf("Rewrite lost annotations: %v", AnnotationsOf(got))
+	}
+	if _, ok := AnnotationsOf(expr)["rewritten"]; ok {
+		t.Error("Rewrite changed the annotations of its input")
+	}
+
+	order := ""
+	Rewrite(expr, func(e Expression) Expression {
+		order += e.String() + ";"
+		return e
+	})
+	if order != "MIT;ISC;MIT OR ISC;" {
+		t.Errorf("visit order = %q, want operands before their node", order)
+	}
+}
@@ -0,0 +1,172 @@
+package spdx
+
+import (
+	"regexp"
+	"strings"
+)
+
+// User-defined exceptions DetectRiders puts after WITH for the riders it
+// finds. EffectiveCategory reports the category of a license with them.
+const (
+	AdditionRefCommonsClause = "AdditionRef-commons-clause"
+	AdditionRefNonCommercial = "AdditionRef-non-commercial"
+)
+
+// Rider is a clause added to a standard license that restricts it, such as
+// the Commons Clause, which forbids selling the software.
+type Rider struct {
+	ID       string   // the AdditionRef for the rider, such as AdditionRefCommonsClause
+	Name     string   // such as "Commons Clause"
+	Category Category // the category of a license with this rider
+	Span     TextSpan // where the rider is in the text
+}
+
+// RiderDetection is the result of DetectRiders.
+type RiderDetection struct {
+	License    string   // the base license
+	Expression string   // the base license with its riders, such as "Apache-2.0 WITH AdditionRef-commons-clause"
+	Category   Category // the category of Expression, downgraded by the riders
+	Riders     []Rider
+}
+
+// riderPatterns lists the riders DetectRiders knows, with the phrases that
+// identify them.
+var riderPatterns = []struct {
+	id, name string
+	category Category
+	re       *regexp.Regexp
+}{
+	{AdditionRefCommonsClause, "Commons Clause", CategorySourceAvailable,
+		regexp.MustCompile(`(?i)"?commons\s+clause"?\s+license\s+condition|the\s+right\s+to\s+sell\s+the\s+software`)},
+	{AdditionRefNonCommercial, "Non-commercial use only", CategoryFreeRestricted,
+		regexp.MustCompile(`(?i)\bfor\s+non-?\s?commercial\s+(?:use|purposes)|\b(?:may|shall)\s+not\s+be\s+used\s+for\s+(?:any\s+)?commercial\s+purposes?`)},
+}
+
+// riderRestrictiveness orders the categories a rider can downgrade from
+// and to, least restrictive first.
+var riderRestrictiveness = map[Category]int{
+	CategoryPublicDomain:    0,
+	CategoryPermissive:      1,
+	CategoryCopyleftLimited: 2,
+	CategoryCopyleft:        3,
+	CategoryFreeRestricted:  4,
+	CategorySourceAvailable: 5,
+	CategoryProprietaryFree: 6,
+	CategoryCommercial:      7,
+}
+
+// riderCategory returns the category of the rider with the given
+// AdditionRef, or the LicenseRef DetectRiders uses for a rider it cannot
+// put after WITH, if it is one DetectRiders knows.
+func riderCategory(id string) (Category, bool) {
+	for _, r := range riderPatterns {
+		if strings.EqualFold(r.id, id) || strings.EqualFold(riderLicenseRef(r.id), id) {
+			return r.category, true
+		}
+	}
+	return "", false
+}
+
+// riderLicenseRef returns the LicenseRef for a rider's AdditionRef, for a
+// rider joined with AND. An AdditionRef is only valid after WITH.
+func riderLicenseRef(id string) string {
+	return "LicenseRef-" + strings.TrimPrefix(id, "AdditionRef-")
+}
+
+// DetectRiders finds the license a license file's text is based on and the
+// riders appended to it, such as the Commons Clause or a "for
+// non-commercial use only" addendum. The base license comes from
+// MatchLicenseText when the text matches a template, and from
+// ParseLicenseText otherwise. The first rider goes after WITH as a
+// user-defined exception, an AdditionRef, and any others are added with
+// AND as LicenseRefs. A rider only
+// counts if it makes the license more restrictive, so the non-commercial
+// terms of a license like CC-BY-NC-4.0 are not a rider.
+//
+// Example:
+//
+//	d, err := DetectRiders(apacheText + "\n\"Commons Clause\" License Condition v1.0 ...")
+//	d.Expression  // "Apache-2.0 WITH AdditionRef-commons-clause"
+//	d.Category    // CategorySourceAvailable, not CategoryPermissive
+//	d.Riders[0].Span.Text  // "\"Commons Clause\" License Condition v1.0 ..."
+func DetectRiders(text string) (RiderDetection, error) {
+	cfg := loadConfig()
+
+	// Riders are searched for in the text the template does not cover,
+	// or in the whole text when the license was found by its title
+	var base Expression
+	var regions []TextSpan
+	if m, err := MatchLicenseText(text); err == nil {
+		base = &License{ID: m.License}
+		regions = m.Extra
+	} else {
+		expr, _, err := parseLicenseText(text, cfg)
+		if err != nil {
+			return RiderDetection{}, err
+		}
+		base = expr
+		regions = paragraphs(text)
+	}
+
+	d := RiderDetection{License: base.String(), Category: mostRestrictive(base, cfg)}
+	baseRank := riderRestrictiveness[d.Category]
+	for _, r := range riderPatterns {
+		rank := riderRestrictiveness[r.category]
+		if rank <= baseRank {
+			continue
+		}
+		for _, region := range regions {
+			if r.re.MatchString(region.Text) {
+				d.Riders = append(d.Riders, Rider{ID: r.id, Name: r.name, Category: r.category, Span: region})
+				if rank > riderRestrictiveness[d.Category] {
+					d.Category = r.category
+				}
+				break
+			}
+		}
+	}
+
+	expr := base
+	for i, r := range d.Riders {
+		if l, ok := expr.(*License); ok && i == 0 && l.Exception == "" {
+			expr = &License{ID: l.ID, Plus: l.Plus, Exception: r.ID}
+			continue
+		}
+		expr = &AndExpression{Left: expr, Right: parseLicenseRef(riderLicenseRef(r.ID))}
+	}
+	d.Expression = expr.String()
+	cfg.debug("spdx: detected riders", "license", d.License, "result", d.Expression, "category", string(d.Category))
+	return d, nil
+}
+
+// mostRestrictive returns the most restrictive category of the licenses
+// in expr, with exceptions applied.
+func mostRestrictive(expr Expression, cfg *config) Category {
+	cats, _ := treeCategories(expr.String(), cfg, true)
+	worst := CategoryUnknown
+	for _, c := range cats {
+		if rank, ok := riderRestrictiveness[c]; ok && (worst == CategoryUnknown || rank > riderRestrictiveness[worst]) {
+			worst = c
+		}
+	}
+	return worst
+}
+
+// paragraphs splits text at blank lines into spans with their offsets.
+func paragraphs(text string) []TextSpan {
+	var spans []TextSpan
+	for start := 0; start < len(text); {
+		end := strings.Index(text[start:], "\n\n")
+		if end < 0 {
+			end = len(text)
+		} else {
+			end += start
+		}
+		if p := strings.TrimSpace(text[start:end]); p != "" {
+			s := start + strings.Index(text[start:end], p)
+			spans = append(spans, TextSpan{Start: s, End: s + len(p), Text: p})
+		}
+		start = end + 2
+	}
+	return spans
+}
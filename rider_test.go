@@ -0,0 +1,125 @@
+package spdx
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// apacheHead is the top of the Apache-2.0 license text, enough for
+// ParseLicenseText to find it by its title.
+const apacheHead = `
+                                 Apache License
+                           Version 2.0, January 2004
+                        http://www.apache.org/licenses/
+
+   TERMS AND CONDITIONS FOR USE, REPRODUCTION, AND DISTRIBUTION
+
+   1. Definitions.
+`
+
+func TestDetectRiders(t *testing.T) {
+	mit := mustLicenseText(t, "MIT")
+	nonCommercial := "\nThis software may not be used for commercial purposes.\n"
+
+	tests := []struct {
+		name     string
+		text     string
+		license  string
+		want     string
+		category Category
+		riders   []string
+	}{
+		{"plain MIT", mit, "MIT", "MIT", CategoryPermissive, nil},
+		{"MIT with Commons Clause", mit + "\n" + commonsClause, "MIT",
+			"MIT WITH AdditionRef-commons-clause", CategorySourceAvailable, []string{AdditionRefCommonsClause}},
+		{"Apache-2.0 with Commons Clause", apacheHead + "\n" + commonsClause, "Apache-2.0",
+			"Apache-2.0 WITH AdditionRef-commons-clause", CategorySourceAvailable, []string{AdditionRefCommonsClause}},
+		{"MIT for non-commercial use", mit + nonCommercial, "MIT",
+			"MIT WITH AdditionRef-non-commercial", CategoryFreeRestricted, []string{AdditionRefNonCommercial}},
+		{"inserted restriction", strings.Replace(mit, "without restriction", "for non-commercial purposes only", 1), "MIT",
+			"MIT WITH AdditionRef-non-commercial", CategoryFreeRestricted, []string{AdditionRefNonCommercial}},
+		{"both riders", mit + nonCommercial + "\n" + commonsClause, "MIT",
+			"MIT WITH AdditionRef-commons-clause AND LicenseRef-non-commercial", CategorySourceAvailable,
+			[]string{AdditionRefCommonsClause, AdditionRefNonCommercial}},
+		{"LicenseRef base", "SPDX-License-Identifier: LicenseRef-Internal\n\nInternal use only." + nonCommercial, "LicenseRef-Internal",
+			"LicenseRef-Internal AND LicenseRef-non-commercial", CategoryFreeRestricted, []string{AdditionRefNonCommercial}},
+		{"restriction of the license itself", "SPDX-License-Identifier: CC-BY-NC-4.0\n\nYou may use the Material for non-commercial purposes only.\n", "CC-BY-NC-4.0",
+			"CC-BY-NC-4.0", LicenseCategory("CC-BY-NC-4.0"), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := DetectRiders(tt.text)
+			if err != nil {
+				t.Fatalf("DetectRiders error: %v", err)
+			}
+			if d.License != tt.license || d.Expression != tt.want || d.Category != tt.category {
+				t.Errorf("got %q, %q, %q; want %q, %q, %q", d.License, d.Expression, d.Category, tt.license, tt.want, tt.category)
+			}
+			var ids []string
+			for _, r := range d.Riders {
+				ids = append(ids, r.ID)
+				if r.Span.Text != tt.text[r.Span.Start:r.Span.End] {
+					t.Errorf("rider %s span %q does not match its offsets", r.ID, r.Span.Text)
+				}
+			}
+			if strings.Join(ids, ",") != strings.Join(tt.riders, ",") {
+				t.Errorf("Riders = %v, want %v", ids, tt.riders)
+			}
+			if _, err := ParseStrict(d.Expression); err != nil {
+				t.Errorf("ParseStrict(%q) error: %v", d.Expression, err)
+			}
+		})
+	}
+}
+
+func TestDetectRidersSpan(t *testing.T) {
+	mit := mustLicenseText(t, "MIT")
+	d, err := DetectRiders(mit + "\n" + commonsClause)
+	if err != nil {
+		t.Fatalf("DetectRiders error: %v", err)
+	}
+	if len(d.Riders) != 1 {
+		t.Fatalf("Riders = %v, want one", d.Riders)
+	}
+	span := d.Riders[0].Span
+	if span.Start < len(mit) || !strings.HasSuffix(span.Text, "the right to Sell the Software") {
+		t.Errorf("Span = %+v, want the Commons Clause after the MIT text", span)
+	}
+}
+
+func TestDetectRidersUnknown(t *testing.T) {
+	if _, err := DetectRiders("Do whatever you like with this."); !errors.Is(err, ErrInvalidLicense) {
+		t.Errorf("error = %v, want ErrInvalidLicense", err)
+	}
+}
+
+func TestRiderCategories(t *testing.T) {
+	if got := EffectiveCategory("Apache-2.0", AdditionRefCommonsClause); got != CategorySourceAvailable {
+		t.Errorf("EffectiveCategory(Apache-2.0, commons clause) = %q", got)
+	}
+	if got := EffectiveCategory("MIT", AdditionRefNonCommercial); got != CategoryFreeRestricted {
+		t.Errorf("EffectiveCategory(MIT, non-commercial) = %q", got)
+	}
+
+	expr, err := Parse("Apache-2.0 WITH AdditionRef-commons-clause")
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	if got := expr.String(); got != "Apache-2.0 WITH AdditionRef-commons-clause" {
+		t.Errorf("String() = %q", got)
+	}
+	if got, err := ExpressionCategories("MIT WITH AdditionRef-commons-clause AND LicenseRef-non-commercial"); err != nil || !slices.Equal(got, []Category{CategoryFreeRestricted, CategoryPermissive}) {
+		t.Errorf("ExpressionCategories of both riders = %v, %v", got, err)
+	}
+	data, err := MarshalExpression(expr)
+	if err != nil {
+		t.Fatalf("MarshalExpression error: %v", err)
+	}
+	back, err := UnmarshalExpression(data)
+	if err != nil || back.String() != expr.String() {
+		t.Errorf("JSON round trip = %v, %v", back, err)
+	}
+}
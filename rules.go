@@ -0,0 +1,108 @@
+package spdx
+
+// Rule ID prefixes. A rule ID is the prefix followed by the exception rule
+// or transform name, or by the text the rule matches, exactly as it
+// appears in the rule table.
+const (
+	exceptionRulePrefix     = "exception:"
+	transformRulePrefix     = "transform:"
+	transpositionRulePrefix = "transposition:"
+	lastResortRulePrefix    = "last-resort:"
+)
+
+// Rule stages, in the order Normalize tries them. Exception rules only
+// apply to expressions in lax parsing.
+const (
+	StageException     = "exception"
+	StageTransform     = "transform"
+	StageTransposition = "transposition"
+	StageLastResort    = "last-resort"
+)
+
+// Rule describes one normalization rule.
+type Rule struct {
+	ID       string `json:"id"`                // pass to Options.DisabledRules to turn it off
+	Stage    string `json:"stage"`             // StageException, StageTransform, StageTransposition or StageLastResort
+	Priority int    `json:"priority"`          // position within the stage; lower is tried first
+	Match    string `json:"match,omitempty"`   // text a transposition or last resort looks for
+	Result   string `json:"result,omitempty"`  // replacement text or license ID it produces
+	Example  string `json:"example,omitempty"` // sample rewrite, for exception rules and transforms
+}
+
+// DumpRules returns the exception, transform, transposition and last-resort rules
+// Normalize currently applies, in the order it tries them. Rules listed in
+// Options.DisabledRules are left out. The result marshals to JSON, so it
+// can be saved and diffed across versions of this package.
+//
+// Example:
+//
+//	rules := spdx.DumpRules()
+//	rules[0]  // {ID: "transform:uppercase", Stage: "transform", Priority: 0, Example: "mit -> MIT"}
+//	json.NewEncoder(os.Stdout).Encode(rules)
+func DumpRules() []Rule {
+	cfg := loadConfig()
+	rules := make([]Rule, 0, len(cfg.exceptionShorthands())+len(cfg.transforms())+len(transpositions)+len(lastResorts))
+	for i, sh := range cfg.exceptionShorthands() {
+		if !cfg.ruleDisabled(exceptionRulePrefix, sh.name) {
+			rules = append(rules, Rule{
+				ID:       exceptionRulePrefix + sh.name,
+				Stage:    StageException,
+				Priority: i,
+				Example:  sh.example,
+			})
+		}
+	}
+	for i, t := range cfg.transforms() {
+		if !cfg.ruleDisabled(transformRulePrefix, t.name) {
+			rules = append(rules, Rule{
+				ID:       transformRulePrefix + t.name,
+				Stage:    StageTransform,
+				Priority: i,
+				Example:  t.example,
+			})
+		}
+	}
+	for i, t := range transpositions {
+		if !cfg.ruleDisabled(transpositionRulePrefix, t.from) {
+			rules = append(rules, Rule{
+				ID:       transpositionRulePrefix + t.from,
+				Stage:    StageTransposition,
+				Priority: i,
+				Match:    t.from,
+				Result:   t.to,
+			})
+		}
+	}
+	for i, lr := range lastResorts {
+		if !cfg.ruleDisabled(lastResortRulePrefix, lr.substring) {
+			rules = append(rules, Rule{
+				ID:       lastResortRulePrefix + lr.substring,
+				Stage:    StageLastResort,
+				Priority: i,
+				Match:    lr.substring,
+				Result:   lr.license,
+			})
+		}
+	}
+	return rules
+}
+
+// ActiveRules returns the IDs of the rules Normalize currently applies, in
+// the order it tries them. Rules listed in Options.DisabledRules are left
+// out. Use DumpRules for what each rule does.
+//
+// Example:
+//
+//	spdx.SetDefaultOptions(spdx.Options{
+//		DisabledRules: []string{"transposition:GNU", "last-resort:GNU"},
+//	})
+//	spdx.Normalize("GNU")  // returns "", ErrInvalidLicense
+//	spdx.ActiveRules()     // ["transform:uppercase", ...], without the GNU rules
+func ActiveRules() []string {
+	rules := DumpRules()
+	ids := make([]string, len(rules))
+	for i, r := range rules {
+		ids[i] = r.ID
+	}
+	return ids
+}
@@ -0,0 +1,123 @@
+package spdx
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestActiveRules(t *testing.T) {
+	rules := ActiveRules()
+	total := len(loadConfig().exceptionShorthands()) + len(loadConfig().transforms()) + len(transpositions) + len(lastResorts)
+	if len(rules) != total {
+		t.Fatalf("ActiveRules() has %d rules, want %d", len(rules), total)
+	}
+
+	seen := make(map[string]bool)
+	for _, id := range rules {
+		if seen[id] {
+			t.Errorf("duplicate rule ID %q", id)
+		}
+		seen[id] = true
+	}
+	for _, id := range []string{"transform:remove-dots", "transposition:GNU", "transposition:MTI", "last-resort:GNU", "last-resort:MIT"} {
+		if !seen[id] {
+			t.Errorf("ActiveRules() missing %q", id)
+		}
+	}
+}
+
+func TestDisabledRules(t *testing.T) {
+	if got, _ := Normalize("MTI"); got != "MIT" {
+		t.Fatalf("default Normalize(MTI) = %q, want MIT", got)
+	}
+
+	disabled := []string{"transposition:GNU", "last-resort:GNU", "transposition:MTI", "not-a-rule"}
+	withOptions(t, Options{DisabledRules: disabled})
+
+	for _, input := range []string{"GNU", "MTI"} {
+		if _, err := Normalize(input); !errors.Is(err, ErrInvalidLicense) {
+			t.Errorf("Normalize(%q) error = %v, want ErrInvalidLicense", input, err)
+		}
+	}
+
+	// Other rules still apply
+	tests := map[string]string{
+		"GNU General Public License v2": "GPL-2.0-only",
+		"The MIT License":               "MIT",
+		"Apache 2":                      "Apache-2.0",
+	}
+	for input, want := range tests {
+		if got, err := Normalize(input); err != nil || got != want {
+			t.Errorf("Normalize(%q) = %q, %v, want %q", input, got, err, want)
+		}
+	}
+
+	rules := ActiveRules()
+	if slices.Contains(rules, "transposition:GNU") || slices.Contains(rules, "last-resort:GNU") {
+		t.Error("ActiveRules() lists disabled rules")
+	}
+	if want := len(loadConfig().exceptionShorthands()) + len(loadConfig().transforms()) + len(transpositions) + len(lastResorts) - 3; len(rules) != want {
+		t.Errorf("ActiveRules() has %d rules, want %d", len(rules), want)
+	}
+}
+
+func TestDumpRules(t *testing.T) {
+	rules := DumpRules()
+	if len(rules) != len(ActiveRules()) {
+		t.Fatalf("DumpRules() and ActiveRules() disagree: %d vs %d", len(rules), len(ActiveRules()))
+	}
+
+	stages := []string{StageException, StageTransform, StageTransposition, StageLastResort}
+	stage, priority := 0, -1
+	for _, r := range rules {
+		for stages[stage] != r.Stage {
+			stage++
+			priority = -1
+		}
+		if r.Priority <= priority {
+			t.Errorf("rule %q priority %d not after %d", r.ID, r.Priority, priority)
+		}
+		priority = r.Priority
+	}
+
+	byID := make(map[string]Rule)
+	for _, r := range rules {
+		byID[r.ID] = r
+	}
+	if r := byID["transposition:MTI"]; r.Match != "MTI" || r.Result != "MIT" {
+		t.Errorf("transposition:MTI = %+v", r)
+	}
+	if r := byID["last-resort:GNU"]; r.Stage != StageLastResort || r.Result != "GPL-3.0-or-later" {
+		t.Errorf("last-resort:GNU = %+v", r)
+	}
+	if r := byID["transform:remove-dots"]; r.Example != "M.I.T. -> MIT" {
+		t.Errorf("transform:remove-dots = %+v", r)
+	}
+
+	data, err := json.Marshal(rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded []Rule
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(decoded, rules) {
+		t.Error("DumpRules() does not round-trip through JSON")
+	}
+}
+
+func TestDisabledTransform(t *testing.T) {
+	withOptions(t, Options{DisabledRules: []string{"transform:remove-dots"}})
+
+	if _, err := Normalize("M.I.T."); err == nil {
+		t.Error("Normalize(M.I.T.) should fail with remove-dots disabled")
+	}
+	for _, r := range DumpRules() {
+		if r.ID == "transform:remove-dots" {
+			t.Error("DumpRules() lists disabled transform")
+		}
+	}
+}
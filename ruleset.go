@@ -0,0 +1,133 @@
+package spdx
+
+// Ruleset selects a version of the normalization heuristics. Normalized
+// results often end up in legal records, so a released ruleset keeps its
+// behavior: new heuristics land in a new ruleset, and users who need
+// stable results pin the one they validated against. Updates to the
+// embedded license list still apply to every ruleset.
+type Ruleset int
+
+const (
+	// RulesetLatest selects the newest ruleset. It is the zero value, so
+	// Options without a Ruleset follow new heuristics as they land.
+	RulesetLatest Ruleset = iota
+	// RulesetV1 is the spdx-correct pipeline: case-insensitive lookup,
+	// transforms, transpositions and last-resort substring matching.
+	RulesetV1
+	// RulesetV2 adds encoding repair, boilerplate phrase stripping, and
+	// parenthetical annotations in lax parsing.
+	RulesetV2
+	// RulesetV3 adds reordering of inverted version phrases, such as
+	// "version 2 of the GPL", and folds trailing qualifiers like "or any
+	// later version" and "only" into the identifier. Bare public domain
+	// statements score ConfidencePublicDomain and follow
+	// Options.PublicDomain. Quotes and brackets around licenses, as in
+	// `["MIT"]` or 'GPL-3.0', are stripped, and quoted lists become AND.
+	// Identifiers written without separators, like "apache20" and
+	// "bsd3clause", are split.
+	RulesetV3
+)
+
+// rulesetNewest is the ruleset RulesetLatest currently resolves to.
+const rulesetNewest = RulesetV3
+
+// String returns "v1", "v2" and so on, or "latest".
+func (r Ruleset) String() string {
+	switch r {
+	case RulesetV1:
+		return "v1"
+	case RulesetV2:
+		return "v2"
+	case RulesetV3:
+		return "v3"
+	default:
+		return "latest"
+	}
+}
+
+// Normalize is Normalize using ruleset r, regardless of the ruleset in
+// the package defaults. Other options still come from the defaults.
+//
+// Example:
+//
+//	spdx.RulesetV1.Normalize("Licensed under LGPL 2.1")  // "LGPL-3.0-or-later", nil
+//	spdx.RulesetV2.Normalize("Licensed under LGPL 2.1")  // "LGPL-2.1-only", nil
+func (r Ruleset) Normalize(license string) (string, error) {
+	return normalize(license, loadConfig().withRuleset(r))
+}
+
+// Parse is Parse using ruleset r, regardless of the ruleset in the
+// package defaults. Other options still come from the defaults.
+func (r Ruleset) Parse(expression string) (Expression, error) {
+	return parseConfig(expression, false, loadConfig().withRuleset(r))
+}
+
+// resolve maps RulesetLatest and unknown values to the newest ruleset.
+func (r Ruleset) resolve() Ruleset {
+	if r > RulesetLatest && r <= rulesetNewest {
+		return r
+	}
+	return rulesetNewest
+}
+
+// ruleset returns the ruleset in effect.
+func (c *config) ruleset() Ruleset {
+	return c.opts.Ruleset.resolve()
+}
+
+// withRuleset returns c with its ruleset replaced by r. The copy has no
+// Normalize cache, since cached results depend on the ruleset.
+func (c *config) withRuleset(r Ruleset) *config {
+	if c.ruleset() == r.resolve() {
+		return c
+	}
+	copied := *c
+	copied.opts.Ruleset = r
+	copied.cache = nil
+	return &copied
+}
+
+// stripBoilerplate applies stripBoilerplate from RulesetV2 on.
+func (c *config) stripBoilerplate(s string) string {
+	if c.ruleset() < RulesetV2 {
+		return s
+	}
+	return stripBoilerplate(s)
+}
+
+// foldQualifier applies foldQualifier from RulesetV3 on.
+func (c *config) foldQualifier(s string) (string, int) {
+	if c.ruleset() < RulesetV3 {
+		return s, qualifierNone
+	}
+	return foldQualifier(s)
+}
+
+// reorderVersion applies reorderVersion from RulesetV3 on.
+func (c *config) reorderVersion(s string) string {
+	if c.ruleset() < RulesetV3 {
+		return s
+	}
+	return reorderVersion(s)
+}
+
+// transforms returns the transforms the ruleset applies, in order.
+func (c *config) transforms() []transformRule {
+	return transformsByRuleset[c.ruleset()]
+}
+
+// unquote applies unquote from RulesetV3 on.
+func (c *config) unquote(s string) string {
+	if c.ruleset() < RulesetV3 {
+		return s
+	}
+	return unquote(s)
+}
+
+// unquoteExpression applies unquoteExpression from RulesetV3 on.
+func (c *config) unquoteExpression(s string) string {
+	if c.ruleset() < RulesetV3 {
+		return s
+	}
+	return unquoteExpression(s)
+}
@@ -0,0 +1,80 @@
+package spdx
+
+import "testing"
+
+func TestRulesetNormalize(t *testing.T) {
+	tests := []struct {
+		input      string
+		v1, v2, v3 string
+	}{
+		{"MIT", "MIT", "MIT", "MIT"},
+		{"Apache 2", "Apache-2.0", "Apache-2.0", "Apache-2.0"},
+		{"Licensed under LGPL 2.1", "LGPL-3.0-or-later", "LGPL-2.1-only", "LGPL-2.1-only"},
+		{"LGPL\u00a02.1", "LGPL-3.0-or-later", "LGPL-2.1-only", "LGPL-2.1-only"},
+		{"version 2 of the GNU General Public License", "GPL-3.0-or-later", "GPL-3.0-or-later", "GPL-2.0-only"},
+		{"GPL v2 or any later version", "GPL-3.0-or-later", "GPL-3.0-or-later", "GPL-2.0-or-later"},
+		{"[GPL-2.0+]", "GPL-2.0-only", "GPL-2.0-only", "GPL-2.0-or-later"},
+		{"bsd3clause", "BSD-2-Clause", "BSD-2-Clause", "BSD-3-Clause"},
+		{"lgpl21", "LGPL-3.0-or-later", "LGPL-3.0-or-later", "LGPL-2.1-only"},
+	}
+
+	for _, tt := range tests {
+		got, _ := RulesetV1.Normalize(tt.input)
+		if got != tt.v1 {
+			t.Errorf("RulesetV1.Normalize(%q) = %q, want %q", tt.input, got, tt.v1)
+		}
+		got, _ = RulesetV2.Normalize(tt.input)
+		if got != tt.v2 {
+			t.Errorf("RulesetV2.Normalize(%q) = %q, want %q", tt.input, got, tt.v2)
+		}
+		got, _ = RulesetV3.Normalize(tt.input)
+		if got != tt.v3 {
+			t.Errorf("RulesetV3.Normalize(%q) = %q, want %q", tt.input, got, tt.v3)
+		}
+		if latest, _ := RulesetLatest.Normalize(tt.input); latest != tt.v3 {
+			t.Errorf("RulesetLatest.Normalize(%q) = %q, want %q", tt.input, latest, tt.v3)
+		}
+	}
+}
+
+func TestRulesetParse(t *testing.T) {
+	if _, err := RulesetV1.Parse("BSD (3-clause)"); err == nil {
+		t.Error("RulesetV1.Parse should not interpret annotations")
+	}
+	expr, err := RulesetV2.Parse("BSD (3-clause)")
+	if err != nil || expr.String() != "BSD-3-Clause" {
+		t.Errorf("RulesetV2.Parse(BSD (3-clause)) = %v, %v", expr, err)
+	}
+	if expr, err := RulesetV2.Parse("GPLv2+CE"); err == nil && expr.String() == "GPL-2.0-only WITH Classpath-exception-2.0" {
+		t.Error("RulesetV2.Parse should not expand exception shorthand")
+	}
+}
+
+func TestRulesetOption(t *testing.T) {
+	withOptions(t, Options{Ruleset: RulesetV1, CacheSize: 10})
+
+	for _, r := range DumpRules() {
+		if r.ID == "transform:concatenated" {
+			t.Error("DumpRules() with RulesetV1 lists a RulesetV3 transform")
+		}
+	}
+
+	if got, _ := Normalize("Licensed under LGPL 2.1"); got != "LGPL-3.0-or-later" {
+		t.Errorf("Normalize with RulesetV1 = %q, want LGPL-3.0-or-later", got)
+	}
+	// The per-call ruleset must not read the pinned ruleset's cache
+	if got, _ := RulesetV2.Normalize("Licensed under LGPL 2.1"); got != "LGPL-2.1-only" {
+		t.Errorf("RulesetV2.Normalize = %q, want LGPL-2.1-only", got)
+	}
+	if _, err := Parse("Free for non-commercial use (GPL)"); err == nil {
+		t.Error("Parse with RulesetV1 should not strip boilerplate")
+	}
+}
+
+func TestRulesetString(t *testing.T) {
+	for r, want := range map[Ruleset]string{RulesetLatest: "latest", RulesetV1: "v1", RulesetV2: "v2", RulesetV3: "v3"} {
+		if got := r.String(); got != want {
+			t.Errorf("Ruleset(%d).String() = %q, want %q", r, got, want)
+		}
+	}
+}
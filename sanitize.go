@@ -0,0 +1,116 @@
+package spdx
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// cp1252Bytes maps the characters Windows-1252 assigns to bytes 0x80-0x9F
+// back to those bytes. Other bytes up to 0xFF decode to the rune of the
+// same value in both Windows-1252 and Latin-1.
+var cp1252Bytes = map[rune]byte{
+	'€': 0x80, '‚': 0x82, 'ƒ': 0x83, '„': 0x84, '…': 0x85, '†': 0x86,
+	'‡': 0x87, 'ˆ': 0x88, '‰': 0x89, 'Š': 0x8A, '‹': 0x8B, 'Œ': 0x8C,
+	'Ž': 0x8E, '‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95,
+	'–': 0x96, '—': 0x97, '˜': 0x98, '™': 0x99, 'š': 0x9A, '›': 0x9B,
+	'œ': 0x9C, 'ž': 0x9E, 'Ÿ': 0x9F,
+}
+
+// sanitizeReplacer folds typographic punctuation and odd spaces to the
+// ASCII the normalization tables are written in.
+var sanitizeReplacer = strings.NewReplacer(
+	"\u00a0", " ", // no-break space
+	"\u2007", " ", // figure space
+	"\u202f", " ", // narrow no-break space
+	"\u2010", "-", // hyphen
+	"\u2011", "-", // non-breaking hyphen
+	"\u2012", "-", // figure dash
+	"\u2013", "-", // en dash
+	"\u2014", "-", // em dash
+	"\u2212", "-", // minus sign
+	"‘", "'",
+	"’", "'",
+	"“", `"`,
+	"”", `"`,
+)
+
+// sanitizeInput repairs encoding damage that upstream registries introduce
+// into license strings, so the same logical string normalizes the same
+// way. It removes byte order marks, zero-width characters, soft hyphens
+// and control characters, repairs UTF-8 that was decoded as Windows-1252
+// ("Â©" becomes "©"), and folds typographic dashes, quotes and spaces to
+// ASCII. Plain ASCII input is returned unchanged.
+//
+// Example:
+//
+//	sanitizeInput("\ufeffMIT\u200b License")  // "MIT License"
+//	sanitizeInput("GPL â€“ 2.0")             // "GPL - 2.0"
+func sanitizeInput(s string) string {
+	if isPlainASCII(s) {
+		return s
+	}
+
+	// Text can be mis-decoded more than once on its way through registries
+	for range 2 {
+		repaired, ok := repairMojibake(s)
+		if !ok {
+			break
+		}
+		s = repaired
+	}
+
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case r == utf8.RuneError:
+			return -1
+		case r == '\ufeff', r == '\u00ad', r == '\u2060', r >= '\u200b' && r <= '\u200d':
+			return -1
+		case unicode.IsSpace(r):
+			return r
+		case unicode.IsControl(r):
+			return -1
+		}
+		return r
+	}, s)
+
+	return sanitizeReplacer.Replace(s)
+}
+
+// isPlainASCII reports whether s is printable ASCII and ordinary whitespace.
+func isPlainASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 0x7f || (c < 0x20 && c != ' ' && c != '\t' && c != '\n' && c != '\r') {
+			return false
+		}
+	}
+	return true
+}
+
+// repairMojibake undoes one round of UTF-8 being decoded as Windows-1252
+// or Latin-1. It re-encodes s as single bytes and accepts the result only
+// if those bytes form valid UTF-8 with at least one multi-byte character,
+// which ordinary Latin-1 text like "café" does not.
+func repairMojibake(s string) (string, bool) {
+	if !strings.ContainsAny(s, "ÃÂâ") {
+		return "", false
+	}
+
+	b := make([]byte, 0, len(s))
+	for _, r := range s {
+		switch {
+		case r < 0x100:
+			b = append(b, byte(r))
+		case cp1252Bytes[r] != 0:
+			b = append(b, cp1252Bytes[r])
+		default:
+			return "", false
+		}
+	}
+
+	if !utf8.Valid(b) || isPlainASCII(string(b)) {
+		return "", false
+	}
+	return string(b), true
+}
@@ -0,0 +1,66 @@
+package spdx
+
+import "testing"
+
+func TestSanitizeInput(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"MIT", "MIT"},
+		{"\ufeffMIT", "MIT"},
+		{"Apache\u200b-2.0", "Apache-2.0"},
+		{"GPL\u00ad-2.0", "GPL-2.0"},
+		{"MIT\x00\x1b", "MIT"},
+		{"Apache\u00a0License\u00a02.0", "Apache License 2.0"},
+		{"BSD\u20133-Clause", "BSD-3-Clause"},
+		{"“MIT”", `"MIT"`},
+		{"Copyright Â© 2020", "Copyright © 2020"},
+		{"BSDâ€“3-Clause", "BSD-3-Clause"},
+		{"Ã‚Â© 2020", "© 2020"}, // mis-decoded twice
+		{"café", "café"},        // valid Latin-1 text is left alone
+		{"Ã© MIT", "é MIT"},
+		{"\xffMIT", "MIT"},
+	}
+
+	for _, tt := range tests {
+		if got := sanitizeInput(tt.input); got != tt.want {
+			t.Errorf("sanitizeInput(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeDamagedInput(t *testing.T) {
+	tests := map[string]string{
+		"\ufeffMIT":                  "MIT",
+		"MIT\u200b License":          "MIT",
+		"Apache\u00a0License 2.0":    "Apache-2.0",
+		"BSD\u20133-Clause":          "BSD-3-Clause",
+		"BSDâ€“3-Clause":             "BSD-3-Clause",
+		"GPL\u00ad v3":               "GPL-3.0-or-later",
+		"Licensed under MIT\r\n\x00": "MIT",
+	}
+
+	for input, want := range tests {
+		got, err := Normalize(input)
+		if err != nil {
+			t.Errorf("Normalize(%q) error = %v", input, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("Normalize(%q) = %q, want %q", input, got, want)
+		}
+	}
+
+	expr, err := Parse("\ufeffMIT OR\u00a0Apache\u20132.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := expr.String(); got != "MIT OR Apache-2.0" {
+		t.Errorf("Parse() = %q, want %q", got, "MIT OR Apache-2.0")
+	}
+
+	if _, err := ParseStrict("\ufeffMIT"); err == nil {
+		t.Error("ParseStrict should not repair damaged input")
+	}
+}
@@ -0,0 +1,177 @@
+package spdx
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// satisfies implements Satisfies: expression is satisfied if some choice
+// of OR alternatives leaves only licenses the allowed list covers.
+func satisfies(expression string, allowed []string, cfg *config) (bool, error) {
+	if len(allowed) == 0 {
+		return false, fmt.Errorf("%w: allowed list is empty", ErrInvalidLicense)
+	}
+	expr, err := parseStrict(expression, cfg)
+	if err != nil {
+		return false, err
+	}
+
+	allow := make([]Expression, len(allowed))
+	for i, s := range allowed {
+		a, err := parseStrict(s, cfg)
+		if err != nil {
+			return false, fmt.Errorf("allowed license %q: %w", s, err)
+		}
+		switch a.(type) {
+		case *AndExpression, *OrExpression:
+			return false, fmt.Errorf("%w: allowed license %q is an expression", ErrInvalidLicense, s)
+		}
+		allow[i] = a
+	}
+	return satisfiedBy(expr, allow, cfg.registry()), nil
+}
+
+// satisfiedBy reports whether the allowed licenses cover expr.
+func satisfiedBy(expr Expression, allowed []Expression, reg *registry) bool {
+	switch e := expr.(type) {
+	case *AndExpression:
+		return satisfiedBy(e.Left, allowed, reg) && satisfiedBy(e.Right, allowed, reg)
+	case *OrExpression:
+		return satisfiedBy(e.Left, allowed, reg) || satisfiedBy(e.Right, allowed, reg)
+	}
+	return slices.ContainsFunc(allowed, func(a Expression) bool {
+		return covers(a, expr, reg)
+	})
+}
+
+// covers reports whether the allowed license a covers the license l.
+func covers(a, l Expression, reg *registry) bool {
+	switch l := l.(type) {
+	case *License:
+		a, ok := a.(*License)
+		return ok && licenseCovers(a, l, reg)
+	case *LicenseRef:
+		a, ok := a.(*LicenseRef)
+		return ok && a.LicenseRef == l.LicenseRef && a.DocumentRef == l.DocumentRef
+	case *SpecialValue:
+		a, ok := a.(*SpecialValue)
+		return ok && a.Value == l.Value
+	}
+	return false
+}
+
+// licenseCovers reports whether the allowed license a covers l. The
+// exceptions must be the same. Versions of a license compare as numbers,
+// and an "or later" form on either side reaches the later versions, so
+// GPL-2.0-or-later covers GPL-3.0-only, and is covered by GPL-3.0-only,
+// since its licensee may choose GPL-3.0.
+func licenseCovers(a, l *License, reg *registry) bool {
+	if !strings.EqualFold(a.Exception, l.Exception) {
+		return false
+	}
+	aBase, aVersion, aLater := licenseRange(a, reg)
+	lBase, lVersion, lLater := licenseRange(l, reg)
+	if !strings.EqualFold(aBase, lBase) {
+		return false
+	}
+	cmp := compareVersions(lVersion, aVersion)
+	switch {
+	case aLater && lLater:
+		return true
+	case aLater:
+		return cmp >= 0
+	case lLater:
+		return cmp <= 0
+	default:
+		return cmp == 0
+	}
+}
+
+// reVersionedID matches a license identifier ending in a version number,
+// such as GPL-2.0 or CC-BY-4.0.
+var reVersionedID = regexp.MustCompile(`^(.+)-(\d+(?:\.\d+)*)$`)
+
+// licenseRange splits a license into the name its versions share, its
+// version as numbers, and whether it is an "or later" form. GPL-2.0-only
+// and GPL-2.0 are the same version. Identifiers that are not part of a
+// version family, such as MIT-0, have an empty version and are their own
+// name.
+func licenseRange(l *License, reg *registry) (base string, version []int, orLater bool) {
+	id, orLater := strings.CutSuffix(l.ID, "-or-later")
+	if !orLater {
+		id, _ = strings.CutSuffix(id, "-only")
+	}
+	orLater = orLater || l.Plus
+
+	name, v, ok := splitVersion(id)
+	if !ok || !reg.versionFamily(name) {
+		return id, nil, orLater
+	}
+	for _, part := range strings.Split(v, ".") {
+		n, _ := strconv.Atoi(part)
+		version = append(version, n)
+	}
+	return name, version, orLater
+}
+
+// splitVersion splits an identifier, without its -only or -or-later
+// suffix, into a name and the version number it ends with.
+func splitVersion(id string) (name, version string, ok bool) {
+	m := reVersionedID.FindStringSubmatch(id)
+	if m == nil {
+		return id, "", false
+	}
+	return m[1], m[2], true
+}
+
+// versionFamily reports whether the license list has more than one
+// version of the license name, such as GPL or CC-BY. A name with a single
+// numbered identifier is a license of its own: MIT-0 is not MIT at
+// version 0.
+func (r *registry) versionFamily(name string) bool {
+	r.familiesOnce.Do(func() {
+		versions := make(map[string]map[string]bool)
+		for _, id := range r.licenses {
+			id = strings.TrimSuffix(id, "+")
+			id, later := strings.CutSuffix(id, "-or-later")
+			if !later {
+				id, _ = strings.CutSuffix(id, "-only")
+			}
+			name, version, ok := splitVersion(id)
+			if !ok {
+				continue
+			}
+			name = strings.ToLower(name)
+			if versions[name] == nil {
+				versions[name] = make(map[string]bool)
+			}
+			versions[name][version] = true
+		}
+		r.families = make(map[string]int, len(versions))
+		for name, v := range versions {
+			r.families[name] = len(v)
+		}
+	})
+	return r.families[strings.ToLower(name)] > 1
+}
+
+// compareVersions compares two versions part by part, treating missing
+// parts as zero, so 2 and 2.0 are the same version.
+func compareVersions(a, b []int) int {
+	for i := 0; i < max(len(a), len(b)); i++ {
+		var x, y int
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if x != y {
+			return x - y
+		}
+	}
+	return 0
+}
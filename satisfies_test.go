@@ -0,0 +1,111 @@
+package spdx
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSatisfies(t *testing.T) {
+	tests := []struct {
+		expression string
+		allowed    []string
+		want       bool
+	}{
+		{"MIT", []string{"MIT"}, true},
+		{"mit", []string{"MIT"}, true},
+		{"MIT", []string{"Apache-2.0"}, false},
+		{"MIT OR Apache-2.0", []string{"Apache-2.0"}, true},
+		{"MIT AND Apache-2.0", []string{"MIT"}, false},
+		{"MIT AND Apache-2.0", []string{"Apache-2.0", "MIT"}, true},
+		{"(MIT AND BSD-3-Clause) OR GPL-3.0-only", []string{"MIT", "BSD-3-Clause"}, true},
+		{"MIT AND (ISC OR GPL-3.0-only)", []string{"MIT", "GPL-3.0-only"}, true},
+		{"MIT AND (ISC OR GPL-3.0-only)", []string{"ISC", "GPL-3.0-only"}, false},
+
+		// Versions and "or later"
+		{"GPL-2.0-only", []string{"GPL-2.0"}, true},
+		{"GPL-2.0", []string{"GPL-2.0-only"}, true},
+		{"GPL-3.0-only", []string{"GPL-2.0-or-later"}, true},
+		{"GPL-3.0-only", []string{"GPL-2.0+"}, true},
+		{"GPL-2.0-only", []string{"GPL-3.0-or-later"}, false},
+		{"GPL-2.0-or-later", []string{"GPL-3.0-only"}, true},
+		{"GPL-2.0+", []string{"GPL-3.0-only"}, true},
+		{"GPL-3.0-or-later", []string{"GPL-2.0-only"}, false},
+		{"GPL-2.0-or-later", []string{"GPL-3.0-or-later"}, true},
+		{"GPL-2.0-only", []string{"LGPL-2.0-only"}, false},
+		{"Apache-1.1", []string{"Apache-2.0"}, false},
+		{"Apache-2.0+", []string{"Apache-2.0"}, true},
+
+		// Identifiers ending in a number that are not a version family
+		{"MIT-0", []string{"MIT"}, false},
+		{"MIT", []string{"MIT-0"}, false},
+		{"MIT-0", []string{"MIT-0"}, true},
+		{"CERN-OHL-P-2.0", []string{"CERN-OHL-P-2.0"}, true},
+		{"Sendmail-8.23", []string{"Sendmail"}, false},
+
+		// Exceptions
+		{"GPL-2.0-only WITH Classpath-exception-2.0", []string{"GPL-2.0-only WITH Classpath-exception-2.0"}, true},
+		{"GPL-2.0-only WITH Classpath-exception-2.0", []string{"GPL-2.0-only"}, false},
+		{"GPL-2.0-only", []string{"GPL-2.0-only WITH Classpath-exception-2.0"}, false},
+		{"GPL-3.0-only WITH Classpath-exception-2.0", []string{"GPL-2.0+ WITH Classpath-exception-2.0"}, true},
+
+		// LicenseRefs
+		{"LicenseRef-Internal", []string{"LicenseRef-Internal"}, true},
+		{"LicenseRef-Internal", []string{"LicenseRef-Other"}, false},
+		{"DocumentRef-spdx:LicenseRef-Internal", []string{"LicenseRef-Internal"}, false},
+		{"DocumentRef-spdx:LicenseRef-Internal OR MIT", []string{"DocumentRef-spdx:LicenseRef-Internal"}, true},
+
+		{"NONE", []string{"MIT"}, false},
+	}
+
+	for _, tt := range tests {
+		got, err := Satisfies(tt.expression, tt.allowed)
+		if err != nil {
+			t.Errorf("Satisfies(%q, %v) error: %v", tt.expression, tt.allowed, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Satisfies(%q, %v) = %v, want %v", tt.expression, tt.allowed, got, tt.want)
+		}
+	}
+}
+
+func TestSatisfiesErrors(t *testing.T) {
+	tests := []struct {
+		expression string
+		allowed    []string
+		want       error
+	}{
+		{"MIT", nil, ErrInvalidLicense},
+		{"", []string{"MIT"}, ErrEmptyExpression},
+		{"MIT OR", []string{"MIT"}, ErrMissingOperand},
+		{"Apache 2", []string{"Apache-2.0"}, ErrInvalidLicenseID},
+		{"MIT Apache-2.0", []string{"MIT"}, ErrUnexpectedToken},
+		{"FAKEYLICENSE", []string{"MIT"}, ErrInvalidLicenseID},
+		{"MIT", []string{"FAKEYLICENSE"}, ErrInvalidLicenseID},
+		{"MIT", []string{"MIT OR Apache-2.0"}, ErrInvalidLicense},
+	}
+
+	for _, tt := range tests {
+		_, err := Satisfies(tt.expression, tt.allowed)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("Satisfies(%q, %v) error = %v, want %v", tt.expression, tt.allowed, err, tt.want)
+		}
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b []int
+		want int
+	}{
+		{[]int{2}, []int{2, 0}, 0},
+		{[]int{2, 1}, []int{2, 0}, 1},
+		{[]int{1, 1}, []int{2}, -1},
+		{nil, nil, 0},
+	}
+	for _, tt := range tests {
+		if got := compareVersions(tt.a, tt.b); (got > 0) != (tt.want > 0) || (got < 0) != (tt.want < 0) {
+			t.Errorf("compareVersions(%v, %v) = %d, want sign of %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+// Package schema publishes JSON Schema and protocol buffer definitions for
+// the structures github.com/git-pkgs/spdx emits as JSON, so services in
+// other languages can validate them and generate bindings. The files are
+// in this directory and embedded in FS:
+//
+//   - license-info.schema.json: spdx.LicenseInfo
+//   - rule.schema.json: the output of spdx.DumpRules
+//   - check-result.schema.json: the spdx command's --format json output
+//   - normalization-record.schema.json: spdx.NormalizationRecord, and the
+//     spdx command's --format record output
+//   - expression.schema.json: the expression tree spdx.MarshalExpression
+//     writes
+//   - spdx.proto: the first four as messages
+//
+// The tests check each schema's properties against the Go types, so the
+// definitions change in the same commit as the structures they describe.
+//
+// Example:
+//
+//	b, err := fs.ReadFile(schema.FS, "license-info.schema.json")
+package schema
+
+import "embed"
+
+// FS holds the schema files.
+//
+//go:embed *.schema.json spdx.proto
+var FS embed.FS
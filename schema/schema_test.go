@@ -0,0 +1,200 @@
+package schema
+
+import (
+	"encoding/json"
+	"io/fs"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/git-pkgs/spdx"
+)
+
+type jsonSchema struct {
+	Enum       []string `json:"enum"`
+	Properties map[string]struct {
+		Enum []string `json:"enum"`
+	} `json:"properties"`
+	Items *struct {
+		Ref string `json:"$ref"`
+	} `json:"items"`
+	Defs map[string]*jsonSchema `json:"$defs"`
+}
+
+// loadSchema reads name and returns the object schema it describes,
+// following a top-level array's items reference.
+func loadSchema(t *testing.T, name string) *jsonSchema {
+	t.Helper()
+	b, err := fs.ReadFile(FS, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s jsonSchema
+	if err := json.Unmarshal(b, &s); err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	if s.Items != nil {
+		def := s.Defs[strings.TrimPrefix(s.Items.Ref, "#/$defs/")]
+		if def == nil {
+			t.Fatalf("%s: unresolved items reference %q", name, s.Items.Ref)
+		}
+		return def
+	}
+	return &s
+}
+
+// jsonFields returns the JSON member names encoding/json uses for typ.
+func jsonFields(typ reflect.Type) []string {
+	var names []string
+	for i := range typ.NumField() {
+		f := typ.Field(i)
+		name := f.Name
+		if tag, ok := f.Tag.Lookup("json"); ok {
+			name, _, _ = strings.Cut(tag, ",")
+		}
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func propertyNames(s *jsonSchema) []string {
+	var names []string
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func TestSchemasMatchTypes(t *testing.T) {
+	tests := []struct {
+		file string
+		typ  reflect.Type
+	}{
+		{"license-info.schema.json", reflect.TypeFor[spdx.LicenseInfo]()},
+		{"rule.schema.json", reflect.TypeFor[spdx.Rule]()},
+		{"normalization-record.schema.json", reflect.TypeFor[spdx.NormalizationRecord]()},
+	}
+
+	for _, tt := range tests {
+		got := propertyNames(loadSchema(t, tt.file))
+		if want := jsonFields(tt.typ); !slices.Equal(got, want) {
+			t.Errorf("%s properties = %v, want %v", tt.file, got, want)
+		}
+	}
+}
+
+func TestCategoryEnum(t *testing.T) {
+	enum := loadSchema(t, "license-info.schema.json").Defs["category"].Enum
+	for _, info := range spdx.ListLicenses() {
+		if !slices.Contains(enum, string(info.Category)) {
+			t.Errorf("category %q of %s is missing from the schema enum", info.Category, info.Key)
+		}
+	}
+}
+
+func TestRuleStageEnum(t *testing.T) {
+	stages := loadSchema(t, "rule.schema.json").Properties["stage"].Enum
+	want := []string{spdx.StageException, spdx.StageTransform, spdx.StageTransposition, spdx.StageLastResort}
+	if !slices.Equal(stages, want) {
+		t.Errorf("stage enum = %v, want %v", stages, want)
+	}
+}
+
+func TestProtoMatchesSchemas(t *testing.T) {
+	b, err := fs.ReadFile(FS, "spdx.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	messages := map[string]string{
+		"LicenseInfo":         "license-info.schema.json",
+		"Rule":                "rule.schema.json",
+		"CheckResult":         "check-result.schema.json",
+		"NormalizationRecord": "normalization-record.schema.json",
+	}
+	field := regexp.MustCompile(`^\s*(?:repeated\s+)?\w+\s+(\w+)\s*=\s*\d+;`)
+
+	for msg, file := range messages {
+		_, body, ok := strings.Cut(string(b), "message "+msg+" {")
+		if !ok {
+			t.Errorf("spdx.proto has no message %s", msg)
+			continue
+		}
+		body, _, _ = strings.Cut(body, "}")
+		var got []string
+		for line := range strings.Lines(body) {
+			if m := field.FindStringSubmatch(line); m != nil {
+				got = append(got, m[1])
+			}
+		}
+		slices.Sort(got)
+		if want := propertyNames(loadSchema(t, file)); !slices.Equal(got, want) {
+			t.Errorf("message %s fields = %v, want %v from %s", msg, got, want, file)
+		}
+	}
+}
+
+// expressionKeys collects the member names and node types of the JSON
+// form of an expression tree.
+func expressionKeys(t *testing.T, v any, keys, types map[string]bool) {
+	t.Helper()
+	node, ok := v.(map[string]any)
+	if !ok {
+		t.Fatalf("node %v is not an object", v)
+	}
+	for k, v := range node {
+		keys[k] = true
+		switch k {
+		case "type":
+			types[v.(string)] = true
+		case "operands":
+			for _, op := range v.([]any) {
+				expressionKeys(t, op, keys, types)
+			}
+		}
+	}
+}
+
+func TestExpressionSchema(t *testing.T) {
+	s := loadSchema(t, "expression.schema.json")
+	keys, types := make(map[string]bool), make(map[string]bool)
+	for _, input := range []string{
+		"DocumentRef-doc:LicenseRef-Foo OR (GPL-2.0+ WITH Classpath-exception-2.0 AND MIT)",
+		"NONE",
+	} {
+		expr, err := spdx.ParseStrict(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		spdx.Annotate(expr, "note", "schema test")
+		b, err := spdx.MarshalExpression(expr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var v any
+		if err := json.Unmarshal(b, &v); err != nil {
+			t.Fatal(err)
+		}
+		expressionKeys(t, v, keys, types)
+	}
+
+	var got []string
+	for k := range keys {
+		got = append(got, k)
+	}
+	slices.Sort(got)
+	if want := propertyNames(s); !slices.Equal(got, want) {
+		t.Errorf("expression.schema.json properties = %v, want %v", want, got)
+	}
+	for typ := range types {
+		if !slices.Contains(s.Properties["type"].Enum, typ) {
+			t.Errorf("node type %q is missing from the schema enum", typ)
+		}
+	}
+	if len(types) != len(s.Properties["type"].Enum) {
+		t.Errorf("node types %v, schema enum %v", types, s.Properties["type"].Enum)
+	}
+}
@@ -0,0 +1,151 @@
+package spdx
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// LicenseSegment is one license text within a file that holds several.
+type LicenseSegment struct {
+	Expression string   // the license of the segment
+	Span       TextSpan // where the segment is in the text
+	// Score is the similarity of the segment to its license template, as
+	// in TextMatch, or 0 if the license was found by its title or an
+	// SPDX-License-Identifier tag.
+	Score float64
+}
+
+// SegmentedLicense is the result of SegmentLicenseText.
+type SegmentedLicense struct {
+	// Expression is the AND of the segments' licenses, each once, in the
+	// order they first appear.
+	Expression string
+	Segments   []LicenseSegment
+	// Unidentified are the sections of the text that name no license,
+	// such as a note introducing third-party licenses.
+	Unidentified []TextSpan
+}
+
+// minSegmentScore is the Score a template match needs to identify a
+// section. Coverage alone is not enough: a long license shares many words
+// with a short template, in order, without being that license.
+const minSegmentScore = 0.5
+
+// reSeparatorLine matches a line drawn to separate sections of a file,
+// such as "-----" or "=====".
+var reSeparatorLine = regexp.MustCompile(`^\s*[-=*_#~]{3,}\s*$`)
+
+// SegmentLicenseText splits a license file that concatenates several
+// license texts, such as a project's MIT license followed by the Apache-2.0
+// license of a bundled component, and identifies each part. Sections end
+// at separator lines and where a paragraph starts with a license title. A
+// section is identified by MatchLicenseText, or failing that by its title
+// or SPDX-License-Identifier tag as ParseLicenseText does. It returns
+// ErrInvalidLicense if no section names a license.
+//
+// Example:
+//
+//	s, err := SegmentLicenseText(mitText + "\n---\n\n" + apacheText)
+//	s.Expression             // "MIT AND Apache-2.0"
+//	s.Segments[1].Span.Text  // "Apache License\n   Version 2.0, January 2004\n..."
+func SegmentLicenseText(text string) (SegmentedLicense, error) {
+	cfg := loadConfig()
+	var result SegmentedLicense
+	var exprs []Expression
+	seen := make(map[string]bool)
+
+	for _, section := range licenseSections(text, cfg) {
+		expr, score, ok := identifySection(section.Text, cfg)
+		if !ok {
+			result.Unidentified = append(result.Unidentified, section)
+			continue
+		}
+		id := expr.String()
+		result.Segments = append(result.Segments, LicenseSegment{Expression: id, Span: section, Score: score})
+		if !seen[id] {
+			seen[id] = true
+			exprs = append(exprs, expr)
+		}
+	}
+
+	if len(exprs) == 0 {
+		return SegmentedLicense{}, fmt.Errorf("%w: no license text found", ErrInvalidLicense)
+	}
+	result.Expression = joinAnd(exprs).String()
+	cfg.debug("spdx: segmented license text", "segments", len(result.Segments), "result", result.Expression)
+	return result, nil
+}
+
+// identifySection returns the license of one section of a license file,
+// with its template Score if it was found by template.
+func identifySection(section string, cfg *config) (Expression, float64, bool) {
+	if m, err := MatchLicenseText(section); err == nil && m.Score >= minSegmentScore {
+		return &License{ID: m.License}, m.Score, true
+	}
+	if expr, _, err := parseLicenseText(section, cfg); err == nil {
+		return expr, 0, true
+	}
+	return nil, 0, false
+}
+
+// licenseSections splits text at separator lines and at paragraphs that
+// start with a license title, and returns the non-blank sections trimmed
+// of surrounding whitespace.
+func licenseSections(text string, cfg *config) []TextSpan {
+	var sections []TextSpan
+	add := func(start, end int) {
+		s := text[start:end]
+		trimmed := strings.TrimSpace(s)
+		if trimmed == "" {
+			return
+		}
+		start += strings.Index(s, trimmed)
+		sections = append(sections, TextSpan{Start: start, End: start + len(trimmed), Text: trimmed})
+	}
+
+	start := 0    // start of the current section
+	blank := true // the previous line was blank, so a paragraph starts here
+	for offset := 0; offset < len(text); {
+		end := strings.IndexByte(text[offset:], '\n')
+		if end < 0 {
+			end = len(text)
+		} else {
+			end += offset
+		}
+		line := text[offset:end]
+
+		switch {
+		case reSeparatorLine.MatchString(line):
+			add(start, offset)
+			start = end
+			blank = true
+		case strings.TrimSpace(line) == "":
+			blank = true
+		default:
+			if blank && offset > start && startsWithTitle(text[offset:], cfg) {
+				add(start, offset)
+				start = offset
+			}
+			blank = false
+		}
+		offset = end + 1
+	}
+	add(start, len(text))
+	return sections
+}
+
+// startsWithTitle reports whether the paragraph at the start of s begins
+// with a license title, such as "Apache License" or "MIT License". The
+// first line must be short and not end like a sentence, so the prose of a
+// license that names another license doesn't count.
+func startsWithTitle(s string, cfg *config) bool {
+	first, _, _ := strings.Cut(s, "\n")
+	first = strings.TrimSpace(first)
+	if len(first) > 80 || strings.ContainsAny(first[len(first)-1:], ".,;:") {
+		return false
+	}
+	paragraph, _, _ := strings.Cut(s, "\n\n")
+	_, _, err := parseLicenseText(paragraph, cfg)
+	return err == nil
+}
@@ -0,0 +1,86 @@
+package spdx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSegmentLicenseText(t *testing.T) {
+	mit := mustLicenseText(t, "MIT")
+	bsd := mustLicenseText(t, "BSD-3-Clause")
+	isc := mustLicenseText(t, "ISC")
+	preamble := "This product bundles libfoo, which is available under the following license.\n\n"
+
+	tests := []struct {
+		name         string
+		text         string
+		want         string
+		segments     []string
+		unidentified int
+	}{
+		{"single license", mit, "MIT", []string{"MIT"}, 0},
+		{"separator line", mit + "\n----------\n\n" + apacheHead, "MIT AND Apache-2.0", []string{"MIT", "Apache-2.0"}, 0},
+		{"title without separator", mit + "\n\n" + bsd, "MIT AND BSD-3-Clause", []string{"MIT", "BSD-3-Clause"}, 0},
+		{"three licenses", isc + "\n=====\n" + mit + "\n=====\n" + bsd, "ISC AND MIT AND BSD-3-Clause", []string{"ISC", "MIT", "BSD-3-Clause"}, 0},
+		{"note before a component license", mit + "\n---\n\n" + preamble + bsd, "MIT AND BSD-3-Clause", []string{"MIT", "BSD-3-Clause"}, 1},
+		{"same license twice", mit + "\n---\n\n" + mit, "MIT", []string{"MIT", "MIT"}, 0},
+		{"tagged section", mit + "\n---\n\nSPDX-License-Identifier: LicenseRef-Internal\n\nInternal terms.\n", "MIT AND LicenseRef-Internal", []string{"MIT", "LicenseRef-Internal"}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SegmentLicenseText(tt.text)
+			if err != nil {
+				t.Fatalf("SegmentLicenseText error: %v", err)
+			}
+			if got.Expression != tt.want {
+				t.Errorf("Expression = %q, want %q", got.Expression, tt.want)
+			}
+			var ids []string
+			for _, seg := range got.Segments {
+				ids = append(ids, seg.Expression)
+				if tt.text[seg.Span.Start:seg.Span.End] != seg.Span.Text {
+					t.Errorf("segment %s text does not match its offsets", seg.Expression)
+				}
+			}
+			if strings.Join(ids, ",") != strings.Join(tt.segments, ",") {
+				t.Errorf("Segments = %v, want %v", ids, tt.segments)
+			}
+			if len(got.Unidentified) != tt.unidentified {
+				t.Errorf("Unidentified = %v, want %d", got.Unidentified, tt.unidentified)
+			}
+		})
+	}
+}
+
+func TestSegmentLicenseTextSpans(t *testing.T) {
+	mit := mustLicenseText(t, "MIT")
+	text := mit + "\n---\n\n" + apacheHead
+	got, err := SegmentLicenseText(text)
+	if err != nil {
+		t.Fatalf("SegmentLicenseText error: %v", err)
+	}
+	if len(got.Segments) != 2 {
+		t.Fatalf("Segments = %v, want 2", got.Segments)
+	}
+	first, second := got.Segments[0], got.Segments[1]
+	if first.Span.Start != 0 || first.Span.Text != strings.TrimSpace(mit) {
+		t.Errorf("first segment = %+v, want the MIT text", first.Span)
+	}
+	if first.Score != 1 {
+		t.Errorf("first segment Score = %v, want 1 for an unmodified template", first.Score)
+	}
+	if !strings.HasPrefix(second.Span.Text, "Apache License") || second.Span.Start <= len(mit) {
+		t.Errorf("second segment = %+v, want the Apache text", second.Span)
+	}
+	if second.Score != 0 {
+		t.Errorf("second segment Score = %v, want 0 for a license found by title", second.Score)
+	}
+}
+
+func TestSegmentLicenseTextNone(t *testing.T) {
+	if _, err := SegmentLicenseText("Nothing to see here.\n\n---\n\nStill nothing."); !errors.Is(err, ErrInvalidLicense) {
+		t.Errorf("error = %v, want ErrInvalidLicense", err)
+	}
+}
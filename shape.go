@@ -0,0 +1,107 @@
+package spdx
+
+import "strings"
+
+// Shape is the rough form of a license string, as guessed by Classify.
+type Shape string
+
+const (
+	// ShapeLicense is a single license name or identifier, such as "MIT",
+	// "GPL-2.0+" or "Apache License, Version 2.0".
+	ShapeLicense Shape = "license"
+	// ShapeExpression has AND, OR or WITH operators, or parentheses.
+	ShapeExpression Shape = "expression"
+	// ShapeRef is a single LicenseRef or DocumentRef identifier.
+	ShapeRef Shape = "ref"
+	// ShapeSpecial is NONE or NOASSERTION.
+	ShapeSpecial Shape = "special"
+	// ShapeJunk is empty, has no letters, has characters no license name
+	// uses, or reads like prose.
+	ShapeJunk Shape = "junk"
+)
+
+// maxLicenseWords is the most words Classify accepts between operators.
+// The longest common names, like "GNU Lesser General Public License
+// version 2.1", are well under it; longer runs are prose.
+const maxLicenseWords = 12
+
+// Classify guesses the shape of s from its characters and word counts,
+// without looking identifiers up or parsing. It makes no allocations, so
+// it can route millions of strings to the right stage: ShapeLicense to
+// ParseID or Normalize, ShapeExpression to Parse, ShapeRef and
+// ShapeSpecial to their own handling, and ShapeJunk nowhere. It is a
+// guess: ShapeLicense doesn't mean the license exists. Use a Classifier
+// for a full answer.
+//
+// Example:
+//
+//	Classify("MIT")                    // ShapeLicense
+//	Classify("Apache 2")               // ShapeLicense
+//	Classify("(MIT OR ISC)")           // ShapeExpression
+//	Classify("LicenseRef-Acme")        // ShapeRef
+//	Classify("NOASSERTION")            // ShapeSpecial
+//	Classify("see https://x.example")  // ShapeJunk
+func Classify(s string) Shape {
+	s = strings.TrimSpace(s)
+	if strings.EqualFold(s, "NONE") || strings.EqualFold(s, "NOASSERTION") {
+		return ShapeSpecial
+	}
+
+	var letters, words, operators, parens, run, longest int
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '(' || c == ')':
+			parens++
+			i++
+			continue
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+			continue
+		}
+
+		start := i
+		for i < len(s) && s[i] != ' ' && s[i] != '\t' && s[i] != '\n' && s[i] != '\r' && s[i] != '(' && s[i] != ')' {
+			if s[i] < ' ' || s[i] == 0x7f || strings.IndexByte(`<>{}[]"=;|\`, s[i]) >= 0 {
+				return ShapeJunk
+			}
+			if b := s[i] | 0x20; b >= 'a' && b <= 'z' || s[i] >= 0x80 {
+				letters++
+			}
+			i++
+		}
+		word := s[start:i]
+		words++
+		if strings.EqualFold(word, "AND") || strings.EqualFold(word, "OR") || strings.EqualFold(word, "WITH") {
+			operators++
+			run = 0
+		} else {
+			run++
+			longest = max(longest, run)
+		}
+		if strings.Contains(word, "://") {
+			return ShapeJunk
+		}
+	}
+
+	switch {
+	case letters == 0 || words == operators || longest > maxLicenseWords:
+		return ShapeJunk
+	case operators > 0 || parens > 0:
+		return ShapeExpression
+	case words == 1 && hasRefPrefix(s):
+		return ShapeRef
+	}
+	return ShapeLicense
+}
+
+// hasRefPrefix reports whether s starts with LicenseRef- or DocumentRef-,
+// ignoring case.
+func hasRefPrefix(s string) bool {
+	for _, prefix := range []string{"LicenseRef-", "DocumentRef-"} {
+		if len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package spdx
+
+import "testing"
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Shape
+	}{
+		{"MIT", ShapeLicense},
+		{"GPL-2.0+", ShapeLicense},
+		{"Apache 2", ShapeLicense},
+		{"Apache License, Version 2.0", ShapeLicense},
+		{"GNU Lesser General Public License v2.1 or later", ShapeExpression},
+		{"Ruby's", ShapeLicense},
+		{"MIT OR Apache-2.0", ShapeExpression},
+		{"mit and isc", ShapeExpression},
+		{"(MIT)", ShapeExpression},
+		{"GPL-2.0-only WITH Classpath-exception-2.0", ShapeExpression},
+		{"LicenseRef-Acme", ShapeRef},
+		{"documentref-spdx:LicenseRef-x", ShapeRef},
+		{"LicenseRef-Acme OR MIT", ShapeExpression},
+		{" NOASSERTION ", ShapeSpecial},
+		{"none", ShapeSpecial},
+		{"", ShapeJunk},
+		{"   ", ShapeJunk},
+		{"1.0", ShapeJunk},
+		{"()", ShapeJunk},
+		{"OR", ShapeJunk},
+		{"https://opensource.org/licenses/MIT", ShapeJunk},
+		{`{"type": "MIT"}`, ShapeJunk},
+		{"<MIT>", ShapeJunk},
+		{"MIT\x00", ShapeJunk},
+		{"This software is provided as is, you may use it however you like as long as you keep this notice", ShapeJunk},
+		{"Licença MIT", ShapeLicense},
+		{"MIT OR This software is provided as is, you may use it however you like as long as you keep this notice", ShapeJunk},
+	}
+
+	for _, tt := range tests {
+		if got := Classify(tt.input); got != tt.want {
+			t.Errorf("Classify(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestClassifyAllocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		Classify("Apache License 2.0 OR (MIT AND LicenseRef-x)")
+	})
+	if allocs != 0 {
+		t.Errorf("Classify allocates %v times per call", allocs)
+	}
+}
+
+func BenchmarkClassify(b *testing.B) {
+	inputs := []string{"MIT", "Apache 2", "MIT OR Apache-2.0", "LicenseRef-Acme", "NOASSERTION", "https://example.com"}
+	for i := 0; i < b.N; i++ {
+		for _, s := range inputs {
+			Classify(s)
+		}
+	}
+}
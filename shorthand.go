@@ -0,0 +1,85 @@
+package spdx
+
+import (
+	"regexp"
+	"strings"
+)
+
+// exceptionShorthand rewrites one shorthand for a license with an
+// exception into a WITH clause.
+type exceptionShorthand struct {
+	name    string
+	example string
+	re      *regexp.Regexp
+	with    string // replacement, with $1 for the exception words
+}
+
+// exceptionShorthands are the shorthands Java and LLVM metadata use for a
+// license with an exception, such as OpenJDK's "GPLv2+CE". The + in them
+// joins the exception rather than meaning "or later", so they have to be
+// rewritten before or-later clauses and + are interpreted.
+var exceptionShorthands = []exceptionShorthand{
+	{
+		"classpath-abbreviation", "GPL-2.0+CE -> GPL-2.0 WITH Classpath-exception-2.0",
+		regexp.MustCompile(`(?i)\s*(?:\+|\bw/|\bwith\b)\s*(?:the\s+)?(?:CE|CPE)\b`),
+		" WITH Classpath-exception-2.0",
+	},
+	{
+		"plus-exception", "GPLv2 + classpath exception -> GPLv2 WITH classpath exception",
+		regexp.MustCompile(`(?i)\s*(?:\+|\bw/)\s*((?:[a-z0-9.]+[\s-]+){1,4}?exceptions?(?:[\s-]+v?\d+(?:\.\d+)*)?)\b`),
+		" WITH $1",
+	},
+	{
+		"comma-with", "GPL, version 2, with the Classpath Exception -> GPL, version 2 WITH the Classpath Exception",
+		regexp.MustCompile(`(?i),\s*with\s+((?:the\s+)?(?:[a-z0-9.]+[\s-]+){1,4}?exceptions?)\b`),
+		" WITH $1",
+	},
+}
+
+// expandExceptionShorthand applies the exception shorthand rules from
+// RulesetV3 on.
+//
+// Example:
+//
+//	expandExceptionShorthand("GPLv2+CE")                    // "GPLv2 WITH Classpath-exception-2.0"
+//	expandExceptionShorthand("Apache 2 w/ LLVM exception")  // "Apache 2 WITH LLVM exception"
+func (c *config) expandExceptionShorthand(s string) string {
+	for _, sh := range c.exceptionShorthands() {
+		if c.ruleDisabled(exceptionRulePrefix, sh.name) || !sh.re.MatchString(s) {
+			continue
+		}
+		expanded := sh.re.ReplaceAllStringFunc(s, func(m string) string {
+			sub := sh.re.FindStringSubmatchIndex(m)
+			// "GPL-2.0+ WITH Classpath-exception-2.0" is already a WITH
+			if len(sub) > 2 && startsWithOperator(m[sub[2]:sub[3]]) {
+				return m
+			}
+			return string(sh.re.ExpandString(nil, sh.with, m, sub))
+		})
+		if expanded == s {
+			continue
+		}
+		traceRule(c, exceptionRulePrefix+sh.name, s, expanded)
+		s = expanded
+	}
+	return s
+}
+
+// exceptionShorthands returns the exception shorthand rules the ruleset
+// applies, which is none before RulesetV3.
+func (c *config) exceptionShorthands() []exceptionShorthand {
+	if c.ruleset() < RulesetV3 {
+		return nil
+	}
+	return exceptionShorthands
+}
+
+// startsWithOperator reports whether s starts with AND, OR or WITH.
+func startsWithOperator(s string) bool {
+	word, _, _ := strings.Cut(s, " ")
+	switch strings.ToUpper(word) {
+	case "AND", "OR", "WITH":
+		return true
+	}
+	return false
+}
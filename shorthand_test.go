@@ -0,0 +1,54 @@
+package spdx
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestExceptionShorthand(t *testing.T) {
+	tests := map[string]string{
+		"GPL-2.0+CE":                        "GPL-2.0-only WITH Classpath-exception-2.0",
+		"GPLv2+CE":                          "GPL-2.0-only WITH Classpath-exception-2.0",
+		"GPLv2+CPE":                         "GPL-2.0-only WITH Classpath-exception-2.0",
+		"GPL2 w/ CPE":                       "GPL-2.0-only WITH Classpath-exception-2.0",
+		"GPL-2.0 with CE":                   "GPL-2.0-only WITH Classpath-exception-2.0",
+		"GPLv2 with classpath exception":    "GPL-2.0-only WITH Classpath-exception-2.0",
+		"GPLv2 + classpath exception":       "GPL-2.0-only WITH Classpath-exception-2.0",
+		"GPL-2.0 + classpath exception 2.0": "GPL-2.0-only WITH Classpath-exception-2.0",
+		"GNU General Public License, version 2, with the Classpath Exception": "GPL-2.0-only WITH Classpath-exception-2.0",
+		"Apache 2 with LLVM exception":                                        "Apache-2.0 WITH LLVM-exception",
+		"Apache 2 w/ LLVM exception":                                          "Apache-2.0 WITH LLVM-exception",
+		"Apache License v2.0 with LLVM Exceptions":                            "Apache-2.0 WITH LLVM-exception",
+		"CDDL-1.1 OR GPL-2.0+CE":                                              "CDDL-1.1 OR (GPL-2.0-only WITH Classpath-exception-2.0)",
+
+		// A + that means or-later is left alone
+		"GPL-2.0+ WITH Classpath-exception-2.0": "GPL-2.0-or-later WITH Classpath-exception-2.0",
+		"GPL-2.0+ with classpath exception":     "GPL-2.0-or-later WITH Classpath-exception-2.0",
+		"GPL-2.0+ OR MIT":                       "GPL-2.0-or-later OR MIT",
+	}
+
+	for input, want := range tests {
+		expr, err := Parse(input)
+		if err != nil || expr.String() != want {
+			t.Errorf("Parse(%q) = %v, %v; want %q", input, expr, err, want)
+		}
+	}
+
+	rec := NormalizeRecord("GPLv2+CE")
+	if !slices.Contains(rec.Rules, "exception:classpath-abbreviation") {
+		t.Errorf("NormalizeRecord(GPLv2+CE).Rules = %v, want the exception rule", rec.Rules)
+	}
+}
+
+func TestDisabledExceptionShorthand(t *testing.T) {
+	withOptions(t, Options{DisabledRules: []string{"exception:classpath-abbreviation"}})
+
+	if expr, err := Parse("GPL-2.0+CE"); err == nil && expr.String() == "GPL-2.0-only WITH Classpath-exception-2.0" {
+		t.Error("Parse(GPL-2.0+CE) should not expand with the rule disabled")
+	}
+	for _, r := range DumpRules() {
+		if r.ID == "exception:classpath-abbreviation" {
+			t.Error("DumpRules() lists a disabled exception rule")
+		}
+	}
+}
@@ -5,9 +5,8 @@ package spdx
 
 import (
 	"errors"
+	"slices"
 	"strings"
-
-	"github.com/github/go-spdx/v2/spdxexp"
 )
 
 // ErrInvalidLicense is returned when a license string cannot be normalized or validated.
@@ -23,46 +22,169 @@ var ErrInvalidLicense = errors.New("invalid license")
 //	Normalize("MIT License")        // returns "MIT", nil
 //	Normalize("GPL v3")             // returns "GPL-3.0-or-later", nil
 //	Normalize("UNKNOWN-LICENSE")    // returns "", ErrInvalidLicense
+//
+// The GPL rewriting and result caching follow SetDefaultOptions.
 func Normalize(license string) (string, error) {
+	return normalize(license, loadConfig())
+}
+
+// normalize implements Normalize using the given options snapshot.
+func normalize(license string, cfg *config) (string, error) {
+	if cfg.ruleset() >= RulesetV2 {
+		license = sanitizeInput(license)
+	}
 	license = strings.TrimSpace(license)
+	if unquoted := cfg.unquote(license); unquoted != license {
+		cfg.debug("spdx: unquoted input", "input", license, "unquoted", unquoted)
+		license = unquoted
+	}
 	if license == "" {
 		return "", ErrInvalidLicense
 	}
 
+	if cfg.cache == nil {
+		return normalizeLicense(license, cfg)
+	}
+	if e, ok := cfg.cache.get(license); ok {
+		return e.id, e.err
+	}
+	id, err := normalizeLicense(license, cfg)
+	cfg.cache.put(license, cacheEntry{id: id, err: err})
+	return id, err
+}
+
+// normalizeLicense runs the normalization pipeline on a trimmed,
+// non-empty license string and applies the confidence threshold and GPL
+// policy to the result.
+func normalizeLicense(license string, cfg *config) (string, error) {
+	id, confidence := guessLicense(license, cfg)
+	if id == "" {
+		if err := proprietaryError(license); err != nil {
+			return "", err
+		}
+		return "", ErrInvalidLicense
+	}
+	if confidence < cfg.opts.MinConfidence {
+		cfg.debug("spdx: guess below confidence threshold", "input", license, "guess", id, "confidence", confidence, "min", cfg.opts.MinConfidence)
+		return "", ErrLowConfidence
+	}
+	upgraded := cfg.upgrade(id)
+	cfg.debug("spdx: normalized license", "input", license, "result", upgraded, "confidence", confidence)
+	return upgraded, nil
+}
+
+// guessLicense returns the canonical ID for license, before the GPL policy
+// is applied, and the confidence of the stage that matched it. It returns
+// "" if no stage matches.
+func guessLicense(license string, cfg *config) (string, float64) {
+	// Fast path for input that is already a canonical ID
+	reg := cfg.registry()
+	if reg.isCanonicalLicense(license) {
+		return license, ConfidenceExact
+	}
+
 	// Try exact match first (case-insensitive)
-	if id := lookupLicense(license); id != "" {
-		return upgradeGPL(id), nil
+	if id := reg.lookupLicense(license); id != "" {
+		return id, ConfidenceExact
+	}
+
+	// A URL names a license page or file, which is only looked at when
+	// the caller opted in with a Resolver
+	if id := cfg.resolveURL(license); id != "" {
+		return id, ConfidenceTransform
 	}
 
 	// Try with trailing + removed, then upgrade the result
-	noPlus := strings.TrimSuffix(strings.TrimSpace(license), "+")
+	noPlus := strings.TrimSuffix(license, "+")
 	if noPlus != license {
-		if id := lookupLicense(noPlus); id != "" {
-			return upgradeGPL(id + "+"), nil
+		if id := reg.lookupLicense(noPlus); id != "" {
+			return id + "+", ConfidenceExact
+		}
+	}
+
+	// Prefer the statistically likely target for ambiguous strings
+	if id := cfg.opts.Frequencies.likeliest(license); id != "" {
+		cfg.debug("spdx: frequency table match", "input", license, "result", id)
+		return id, ConfidenceFrequency
+	}
+
+	// "Public domain" names no license, so map it by policy at its own
+	// confidence
+	if id := cfg.publicDomain(license); id != "" {
+		cfg.debug("spdx: public domain statement", "input", license, "result", id)
+		return id, ConfidencePublicDomain
+	}
+
+	// Drop wrapping phrases like "Licensed under" and retry on what is
+	// left. Stripping is a rewrite, so it scores no higher than a transform.
+	if stripped := cfg.stripBoilerplate(license); stripped != license && stripped != "" {
+		cfg.debug("spdx: stripped boilerplate", "input", license, "stripped", stripped)
+		if id, confidence := guessLicense(stripped, cfg); id != "" {
+			return id, min(confidence, ConfidenceTransform)
+		}
+	}
+
+	// Put the name before the version in phrases like "version 2 of the
+	// GPL", so transpositions can match the name.
+	if reordered := cfg.reorderVersion(license); reordered != license {
+		cfg.debug("spdx: reordered version phrase", "input", license, "reordered", reordered)
+		if id, confidence := guessLicense(reordered, cfg); id != "" {
+			return id, min(confidence, ConfidenceTransform)
+		}
+	}
+
+	// Fold qualifiers like "or (at your option) any later version" into
+	// the identifier's modifier
+	if base, qualifier := cfg.foldQualifier(license); qualifier != qualifierNone {
+		if id, confidence := guessLicense(base, cfg); id != "" {
+			folded := applyQualifier(reg, id, qualifier)
+			cfg.debug("spdx: folded qualifier", "input", license, "base", id, "result", folded)
+			return folded, min(confidence, ConfidenceTransform)
 		}
 	}
 
 	// Apply transforms
-	if result := tryTransforms(license); result != "" {
-		return result, nil
+	if result := tryTransforms(license, cfg); result != "" {
+		return result, ConfidenceTransform
 	}
 
 	// Apply transpositions with transforms
-	if result := tryTranspositions(license); result != "" {
-		return result, nil
+	if result := tryTranspositions(license, cfg); result != "" {
+		return result, ConfidenceTransposition
 	}
 
 	// Last resort: substring matching
-	if result := tryLastResorts(license); result != "" {
-		return result, nil
+	if result := tryLastResorts(license, cfg); result != "" {
+		return result, ConfidenceLastResort
 	}
 
 	// Transpositions with last resorts
-	if result := tryTranspositionsWithLastResorts(license); result != "" {
-		return result, nil
+	if result := tryTranspositionsWithLastResorts(license, cfg); result != "" {
+		return result, ConfidenceLastResort
 	}
 
-	return "", ErrInvalidLicense
+	return "", 0
+}
+
+// NormalizeException converts an informal exception name to a valid SPDX
+// exception identifier. Names without a version resolve to the version
+// most projects mean.
+//
+// Example:
+//
+//	NormalizeException("classpath exception 2.0")  // returns "Classpath-exception-2.0", nil
+//	NormalizeException("Classpath Exception")      // returns "Classpath-exception-2.0", nil
+//	NormalizeException("LLVM exception")           // returns "LLVM-exception", nil
+//	NormalizeException("MIT")                      // returns "", ErrInvalidException
+func NormalizeException(exception string) (string, error) {
+	cfg := loadConfig()
+	if cfg.ruleset() >= RulesetV2 {
+		exception = sanitizeInput(exception)
+	}
+	if id := normalizeException(exception, cfg); id != "" {
+		return id, nil
+	}
+	return "", ErrInvalidException
 }
 
 // NormalizeExpression normalizes an SPDX expression, converting each license
@@ -118,28 +240,98 @@ func ValidLicense(license string) bool {
 	return lookupLicense(license) != ""
 }
 
-// Satisfies checks if the allowed licenses satisfy the given SPDX expression.
-// This is a convenience wrapper around github.com/github/go-spdx/v2/spdxexp.Satisfies.
+// Satisfies checks if the allowed licenses satisfy the given SPDX expression:
+// some choice of OR alternatives must leave only allowed licenses. The
+// expression and each allowed license are parsed as ParseStrict parses
+// them, so deprecated and differently cased identifiers compare equal to
+// their canonical form. An allowed "or later" license covers the later
+// versions, as GPL-2.0-or-later covers GPL-3.0-only, and exceptions must
+// match. Errors are this package's parse errors; an empty allowed list,
+// or an expression in it, fails with ErrInvalidLicense.
+//
+// Example:
+//
+//	Satisfies("MIT OR Apache-2.0", []string{"MIT"})
+//	// returns true, nil
+//
+//	Satisfies("GPL-3.0-only", []string{"GPL-2.0-or-later"})
+//	// returns true, nil
 func Satisfies(expression string, allowed []string) (bool, error) {
-	return spdxexp.Satisfies(expression, allowed)
+	cfg := loadConfig()
+	ok, err := satisfies(expression, allowed, cfg)
+	cfg.debug("spdx: satisfies", "expression", expression, "allowed", allowed, "satisfied", ok, "error", err)
+	return ok, err
 }
 
 // ExtractLicenses extracts all unique license identifiers from an SPDX expression.
 // Returns a slice of license identifiers or an error if parsing fails.
+// The expression is parsed as ParseStrict parses it; identifiers are in
+// their canonical case, keep a trailing "+", and leave out any WITH
+// exception. LicenseRefs are included. The identifiers are sorted unless
+// Options.Order is OrderDocument, in which case they follow their first
+// appearance in the expression.
 //
 // Example:
 //
 //	ExtractLicenses("MIT OR Apache-2.0")
-//	// returns ["MIT", "Apache-2.0"], nil
+//	// returns ["Apache-2.0", "MIT"], nil
 //
 //	ExtractLicenses("(MIT AND GPL-2.0) OR Apache-2.0")
 //	// returns ["Apache-2.0", "GPL-2.0", "MIT"], nil
 func ExtractLicenses(expression string) ([]string, error) {
-	return spdxexp.ExtractLicenses(expression)
+	return extractLicenses(expression, loadConfig())
+}
+
+// extractLicenses implements ExtractLicenses using the given options snapshot.
+func extractLicenses(expression string, cfg *config) ([]string, error) {
+	expr, err := parseStrict(expression, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	licenses := []string{}
+	seen := make(map[string]bool)
+	var walk func(Expression)
+	walk = func(e Expression) {
+		var id string
+		switch n := e.(type) {
+		case *License:
+			id = n.ID
+			if n.Plus {
+				id += "+"
+			}
+		case *LicenseRef:
+			id = n.String()
+		case *AndExpression:
+			walk(n.Left)
+			walk(n.Right)
+		case *OrExpression:
+			walk(n.Left)
+			walk(n.Right)
+		}
+		if id != "" && !seen[id] {
+			seen[id] = true
+			licenses = append(licenses, id)
+		}
+	}
+	walk(expr)
+	if cfg.opts.Order != OrderDocument {
+		slices.Sort(licenses)
+	}
+	return licenses, nil
 }
 
 // ValidateLicenses checks if all given license identifiers are valid SPDX identifiers.
 // Returns true and nil if all are valid, or false and the list of invalid licenses.
+// Each entry is checked as ParseStrict checks an expression, so
+// "GPL-2.0-only WITH Classpath-exception-2.0" is valid too.
 func ValidateLicenses(licenses []string) (bool, []string) {
-	return spdxexp.ValidateLicenses(licenses)
+	cfg := loadConfig()
+	var invalid []string
+	for _, license := range licenses {
+		if _, err := parseStrict(license, cfg); err != nil {
+			invalid = append(invalid, license)
+		}
+	}
+	return len(invalid) == 0, invalid
 }
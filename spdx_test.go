@@ -244,6 +244,181 @@ func TestNormalizeInvalid(t *testing.T) {
 	}
 }
 
+func TestNormalizeBoilerplate(t *testing.T) {
+	tests := map[string]string{
+		"Released under the terms of the MIT license":      "MIT",
+		"Licensed under Apache 2.0, see LICENSE":           "Apache-2.0",
+		"Free for non-commercial use (GPL)":                "GPL-3.0-or-later",
+		"Licensed under the GNU General Public License v2": "GPL-2.0-only",
+		"Licensed under LGPL 2.1":                          "LGPL-2.1-only",
+		"This software is released under the ISC license.": "ISC",
+		"Distributed under the BSD 3-Clause License":       "BSD-3-Clause",
+	}
+
+	for input, want := range tests {
+		t.Run(input, func(t *testing.T) {
+			got, err := Normalize(input)
+			if err != nil {
+				t.Fatalf("Normalize(%q) error = %v", input, err)
+			}
+			if got != want {
+				t.Errorf("Normalize(%q) = %q, want %q", input, got, want)
+			}
+		})
+	}
+
+	// Boilerplate alone is not a license
+	for _, input := range []string{"Licensed under", "see LICENSE", "All rights reserved."} {
+		if _, err := Normalize(input); err == nil {
+			t.Errorf("Normalize(%q) should return error", input)
+		}
+	}
+}
+
+func TestNormalizeInvertedVersion(t *testing.T) {
+	tests := map[string]string{
+		"version 2 of the GNU General Public License":                                                 "GPL-2.0-only",
+		"v3 of the GPL or any later version":                                                          "GPL-3.0-or-later",
+		"Version 2.1 of the GNU Lesser General Public License, or (at your option) any later version": "LGPL-2.1-or-later",
+		"version 2 or later of the GPL":                                                               "GPL-2.0-or-later",
+		"version 2.0 of the Apache License":                                                           "Apache-2.0",
+		"Licensed under version 2 of the GNU General Public License":                                  "GPL-2.0-only",
+	}
+
+	for input, want := range tests {
+		got, err := Normalize(input)
+		if err != nil || got != want {
+			t.Errorf("Normalize(%q) = %q, %v; want %q", input, got, err, want)
+		}
+		expr, err := Parse(input)
+		if err != nil || expr.String() != want {
+			t.Errorf("Parse(%q) = %v, %v; want %q", input, expr, err, want)
+		}
+	}
+
+	// "the License" on its own names nothing
+	if got := reorderVersion("version 2 of the License"); got != "version 2 of the License" {
+		t.Errorf("reorderVersion(version 2 of the License) = %q, want it unchanged", got)
+	}
+}
+
+func TestNormalizeQualifiers(t *testing.T) {
+	tests := map[string]string{
+		"GPL-2.0 or (at your option) any later version":                           "GPL-2.0-or-later",
+		"GPL 2.0, or (at your option) any later version":                          "GPL-2.0-or-later",
+		"GPLv3 or any later version published by the Free Software Foundation":    "GPL-3.0-or-later",
+		"GNU General Public License v2 or any later version published by the FSF": "GPL-2.0-or-later",
+		"GPL 2 or newer":     "GPL-2.0-or-later",
+		"LGPL v3 or later":   "LGPL-3.0-or-later",
+		"GPL v2 only":        "GPL-2.0-only",
+		"GPL version 2 only": "GPL-2.0-only",
+		"GPL v3 (only)":      "GPL-3.0-only",
+		"MPL 2.0 or later":   "MPL-2.0+",
+		"MIT only":           "MIT",
+	}
+
+	for input, want := range tests {
+		got, err := Normalize(input)
+		if err != nil || got != want {
+			t.Errorf("Normalize(%q) = %q, %v; want %q", input, got, err, want)
+		}
+	}
+
+	// Inside an expression the clause must not split on OR
+	exprs := map[string]string{
+		"GPL-2.0 or later AND MIT":                       "GPL-2.0-or-later AND MIT",
+		"MIT OR GPL v2 or any later version":             "MIT OR GPL-2.0-or-later",
+		"LGPL-2.1 or later WITH Classpath-exception-2.0": "LGPL-2.1-or-later WITH Classpath-exception-2.0",
+		"Apache 2 or MIT":                                "Apache-2.0 OR MIT",
+	}
+	for input, want := range exprs {
+		expr, err := Parse(input)
+		if err != nil || expr.String() != want {
+			t.Errorf("Parse(%q) = %v, %v; want %q", input, expr, err, want)
+		}
+	}
+}
+
+func TestNormalizeConcatenated(t *testing.T) {
+	tests := map[string]string{
+		"apache20":    "Apache-2.0",
+		"apache11":    "Apache-1.1",
+		"gplv30":      "GPL-3.0-or-later",
+		"gpl20":       "GPL-2.0-only",
+		"gpl20+":      "GPL-2.0-or-later",
+		"lgplv21":     "LGPL-2.1-only",
+		"bsd3clause":  "BSD-3-Clause",
+		"bsd2clause":  "BSD-2-Clause",
+		"mpl20":       "MPL-2.0",
+		"epl10":       "EPL-1.0",
+		"cc-by-sa-40": "CC-BY-SA-4.0",
+	}
+
+	for input, want := range tests {
+		got, err := Normalize(input)
+		if err != nil || got != want {
+			t.Errorf("Normalize(%q) = %q, %v; want %q", input, got, err, want)
+		}
+	}
+
+	for input, want := range map[string]string{
+		"apache20":    "apache-2.0",
+		"gplv3":       "gpl-3.0",
+		"bsd3clause":  "bsd-3-Clause",
+		"bsd31clause": "bsd31clause",
+		"MIT":         "MIT",
+		"0bsd":        "0bsd",
+	} {
+		if got := splitConcatenated(input); got != want {
+			t.Errorf("splitConcatenated(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestNormalizeException(t *testing.T) {
+	tests := map[string]string{
+		"Classpath-exception-2.0":             "Classpath-exception-2.0",
+		"classpath-exception-2.0":             "Classpath-exception-2.0",
+		"classpath exception 2.0":             "Classpath-exception-2.0",
+		"Classpath Exception":                 "Classpath-exception-2.0",
+		"Classpath exception, version 2.0":    "Classpath-exception-2.0",
+		"Classpath 2.0":                       "Classpath-exception-2.0",
+		"LLVM exception":                      "LLVM-exception",
+		"GCC Runtime Library exception 3.1":   "GCC-exception-3.1",
+		"GCC exception":                       "GCC-exception-3.1",
+		"Bison exception":                     "Bison-exception-2.2",
+		"Font exception 2":                    "Font-exception-2.0",
+		"The wxWindows Library Exception 3.1": "WxWindows-exception-3.1",
+	}
+
+	for input, want := range tests {
+		t.Run(input, func(t *testing.T) {
+			got, err := NormalizeException(input)
+			if err != nil {
+				t.Fatalf("NormalizeException(%q) error = %v", input, err)
+			}
+			if got != want {
+				t.Errorf("NormalizeException(%q) = %q, want %q", input, got, want)
+			}
+		})
+	}
+
+	// Lowercasing changes the byte length of "Ⱥ", which must not throw
+	// off the transpositions
+	const nonASCII = "ȺȺȺȺȺȺȺȺȺȺȺȺȺȺȺȺȺȺ Runtime Library"
+	for _, input := range []string{"", "MIT", "not an exception", nonASCII} {
+		if _, err := NormalizeException(input); err != ErrInvalidException {
+			t.Errorf("NormalizeException(%q) error = %v, want ErrInvalidException", input, err)
+		}
+	}
+	if _, err := Parse("GPL-2.0-only WITH " + nonASCII); err == nil {
+		t.Errorf("Parse(GPL-2.0-only WITH %s) succeeded, want an error", nonASCII)
+	}
+	if got := foldPattern("runtime library").ReplaceAllLiteralString("ȺȺ Runtime Library ȺȺ", "RL"); got != "ȺȺ RL ȺȺ" {
+		t.Errorf("foldPattern replace = %q, want %q", got, "ȺȺ RL ȺȺ")
+	}
+}
+
 func TestValid(t *testing.T) {
 	validCases := []string{
 		"MIT",
@@ -261,6 +436,8 @@ func TestValid(t *testing.T) {
 		"GPL-2.0-only WITH Classpath-exception-2.0",
 		"LicenseRef-custom",
 		"DocumentRef-doc:LicenseRef-custom",
+		"Apache-2.0 WITH AdditionRef-commons-clause",
+		"Apache-2.0 WITH DocumentRef-doc:AdditionRef-custom",
 		"NONE",
 		"NOASSERTION",
 	}
@@ -282,6 +459,9 @@ func TestInvalid(t *testing.T) {
 		" WITH ",
 		"MIT AND ",
 		"MIT OR FAKEYLICENSE",
+		"MIT WITH LicenseRef-x",
+		"MIT WITH DocumentRef-doc:LicenseRef-x",
+		"MIT WITH AdditionRef-",
 		"MIT (MIT)",
 		"MIT OR MIT AND OR",
 		"((MIT)",
@@ -465,6 +645,17 @@ func BenchmarkNormalizeBatch(b *testing.B) {
 	}
 }
 
+func BenchmarkNormalizeCanonical(b *testing.B) {
+	inputs := []string{"MIT", "Apache-2.0", "GPL-3.0-only", "BSD-3-Clause", "ISC"}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, input := range inputs {
+			_, _ = Normalize(input)
+		}
+	}
+}
+
 func BenchmarkParse(b *testing.B) {
 	expressions := []string{
 		"MIT",
@@ -0,0 +1,36 @@
+// Package spdxexp mirrors the API of github.com/github/go-spdx/v2/spdxexp,
+// backed by github.com/git-pkgs/spdx. Code written against go-spdx can
+// switch by changing the import path:
+//
+//	import "github.com/git-pkgs/spdx/spdxexp"
+//
+//	ok, err := spdxexp.Satisfies("MIT OR Apache-2.0", []string{"MIT"})
+//
+// The functions take strict SPDX input, as go-spdx does. For informal
+// license names, use spdx.Normalize or spdx.Parse first. Unlike go-spdx,
+// they accept a user-defined AdditionRef after WITH, as the SPDX grammar
+// allows.
+package spdxexp
+
+import "github.com/git-pkgs/spdx"
+
+// Satisfies reports whether the licenses in allowedList satisfy
+// testExpression. An invalid expression or allow list returns an error.
+func Satisfies(testExpression string, allowedList []string) (bool, error) {
+	return spdx.Satisfies(testExpression, allowedList)
+}
+
+// ValidateLicenses reports whether every entry in licenses is a valid
+// SPDX expression, such as "MIT" or "GPL-2.0-only WITH
+// Classpath-exception-2.0". A bare exception identifier is not an
+// expression and is rejected, as in go-spdx. When some entries are
+// invalid, it returns false and those entries.
+func ValidateLicenses(licenses []string) (bool, []string) {
+	return spdx.ValidateLicenses(licenses)
+}
+
+// ExtractLicenses returns the unique license identifiers in expression,
+// sorted unless spdx.Options.Order is spdx.OrderDocument.
+func ExtractLicenses(expression string) ([]string, error) {
+	return spdx.ExtractLicenses(expression)
+}
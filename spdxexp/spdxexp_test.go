@@ -0,0 +1,62 @@
+package spdxexp
+
+import (
+	"slices"
+	"testing"
+
+	upstream "github.com/github/go-spdx/v2/spdxexp"
+)
+
+// The shim must give the same answers as the library it replaces.
+
+func TestSatisfiesMatchesUpstream(t *testing.T) {
+	tests := []struct {
+		expression string
+		allowed    []string
+	}{
+		{"MIT", []string{"MIT"}},
+		{"MIT OR Apache-2.0", []string{"Apache-2.0"}},
+		{"MIT AND Apache-2.0", []string{"MIT"}},
+		{"(MIT AND BSD-3-Clause) OR GPL-3.0-only", []string{"MIT", "BSD-3-Clause"}},
+		{"GPL-3.0-only", []string{"MIT"}},
+	}
+
+	for _, tt := range tests {
+		got, gotErr := Satisfies(tt.expression, tt.allowed)
+		want, wantErr := upstream.Satisfies(tt.expression, tt.allowed)
+		if got != want || (gotErr == nil) != (wantErr == nil) {
+			t.Errorf("Satisfies(%q, %v) = %v, %v; go-spdx gives %v, %v", tt.expression, tt.allowed, got, gotErr, want, wantErr)
+		}
+	}
+}
+
+func TestValidateLicensesMatchesUpstream(t *testing.T) {
+	for _, licenses := range [][]string{
+		{"MIT", "Apache-2.0"},
+		{"MIT", "Apache 2", "not-a-license"},
+		{"GPL-2.0", "Classpath-exception-2.0"},
+		{"MIT WITH LicenseRef-x"},
+		{},
+	} {
+		ok, invalid := ValidateLicenses(licenses)
+		wantOK, wantInvalid := upstream.ValidateLicenses(licenses)
+		if ok != wantOK || !slices.Equal(invalid, wantInvalid) {
+			t.Errorf("ValidateLicenses(%v) = %v, %v; go-spdx gives %v, %v", licenses, ok, invalid, wantOK, wantInvalid)
+		}
+	}
+}
+
+func TestExtractLicensesMatchesUpstream(t *testing.T) {
+	for _, expression := range []string{
+		"MIT",
+		"MIT OR Apache-2.0",
+		"(MIT AND GPL-2.0-only) OR Apache-2.0 OR MIT",
+		"",
+	} {
+		got, gotErr := ExtractLicenses(expression)
+		want, wantErr := upstream.ExtractLicenses(expression)
+		if !slices.Equal(got, want) || (gotErr == nil) != (wantErr == nil) {
+			t.Errorf("ExtractLicenses(%q) = %v, %v; go-spdx gives %v, %v", expression, got, gotErr, want, wantErr)
+		}
+	}
+}
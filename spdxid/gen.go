@@ -0,0 +1,98 @@
+//go:build ignore
+
+// gen writes ids.go from the license list embedded in the spdx package.
+// Run it with go generate after updating the license data.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/git-pkgs/spdx"
+)
+
+func main() {
+	data := spdx.EmbeddedLicenseData()
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen.go; DO NOT EDIT.\n\npackage spdxid\n")
+
+	seen := make(map[string]string)
+	section := func(title string, ids []string, typ string, deprecated bool) {
+		ids = slices.Clone(ids)
+		slices.SortFunc(ids, func(a, b string) int {
+			return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+		})
+		fmt.Fprintf(&buf, "\n// %s\nconst (\n", title)
+		for _, id := range ids {
+			name := constName(id)
+			if prev, ok := seen[name]; ok {
+				log.Fatalf("%s and %s both map to %s", prev, id, name)
+			}
+			seen[name] = id
+			if deprecated {
+				fmt.Fprintf(&buf, "\t// Deprecated: %s is deprecated in the SPDX license list.\n", id)
+			}
+			fmt.Fprintf(&buf, "\t%s %s = %q\n", name, typ, id)
+		}
+		buf.WriteString(")\n")
+	}
+	// The lists can overlap. An identifier is generated once: as
+	// deprecated if it is, otherwise as a license before an exception.
+	without := func(ids []string, exclude ...[]string) []string {
+		var out []string
+		for _, id := range ids {
+			if !slices.ContainsFunc(exclude, func(ex []string) bool { return slices.Contains(ex, id) }) {
+				out = append(out, id)
+			}
+		}
+		return out
+	}
+	section("Licenses.", without(data.Licenses, data.Deprecated), "ID", false)
+	section("Deprecated licenses.", data.Deprecated, "ID", true)
+	section("Exceptions.", without(data.Exceptions, data.Licenses, data.Deprecated), "ExceptionID", false)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("ids.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// constName turns an identifier into a Go name: each run of letters and
+// digits starts with a capital and separators are dropped, so Apache-2.0
+// becomes Apache20 and a trailing + becomes Plus. Names that would start
+// with a digit get an L prefix.
+func constName(id string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range id {
+		if r == '+' {
+			b.WriteString("Plus")
+			upper = true
+			continue
+		}
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	name := b.String()
+	if name == "" || unicode.IsDigit(rune(name[0])) {
+		name = "L" + name
+	}
+	return name
+}
@@ -0,0 +1,816 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package spdxid
+
+// Licenses.
+const (
+	L0BSD                             ID = "0BSD"
+	L3DSlicer10                       ID = "3D-Slicer-1.0"
+	AAL                               ID = "AAL"
+	Abstyles                          ID = "Abstyles"
+	AdaCoreDoc                        ID = "AdaCore-doc"
+	Adobe2006                         ID = "Adobe-2006"
+	AdobeDisplayPostScript            ID = "Adobe-Display-PostScript"
+	AdobeGlyph                        ID = "Adobe-Glyph"
+	AdobeUtopia                       ID = "Adobe-Utopia"
+	ADSL                              ID = "ADSL"
+	AFL11                             ID = "AFL-1.1"
+	AFL12                             ID = "AFL-1.2"
+	AFL20                             ID = "AFL-2.0"
+	AFL21                             ID = "AFL-2.1"
+	AFL30                             ID = "AFL-3.0"
+	Afmparse                          ID = "Afmparse"
+	AGPL10Only                        ID = "AGPL-1.0-only"
+	AGPL10OrLater                     ID = "AGPL-1.0-or-later"
+	AGPL30Only                        ID = "AGPL-3.0-only"
+	AGPL30OrLater                     ID = "AGPL-3.0-or-later"
+	Aladdin                           ID = "Aladdin"
+	AMDNewlib                         ID = "AMD-newlib"
+	AMDPLPA                           ID = "AMDPLPA"
+	AML                               ID = "AML"
+	AMLGlslang                        ID = "AML-glslang"
+	AMPAS                             ID = "AMPAS"
+	ANTLRPD                           ID = "ANTLR-PD"
+	ANTLRPDFallback                   ID = "ANTLR-PD-fallback"
+	Apache10                          ID = "Apache-1.0"
+	Apache11                          ID = "Apache-1.1"
+	Apache20                          ID = "Apache-2.0"
+	APAFML                            ID = "APAFML"
+	APL10                             ID = "APL-1.0"
+	AppS2p                            ID = "App-s2p"
+	APSL10                            ID = "APSL-1.0"
+	APSL11                            ID = "APSL-1.1"
+	APSL12                            ID = "APSL-1.2"
+	APSL20                            ID = "APSL-2.0"
+	Arphic1999                        ID = "Arphic-1999"
+	Artistic10                        ID = "Artistic-1.0"
+	Artistic10Cl8                     ID = "Artistic-1.0-cl8"
+	Artistic10Perl                    ID = "Artistic-1.0-Perl"
+	Artistic20                        ID = "Artistic-2.0"
+	ArtisticDist                      ID = "Artistic-dist"
+	AspellRU                          ID = "Aspell-RU"
+	ASWFDigitalAssets10               ID = "ASWF-Digital-Assets-1.0"
+	ASWFDigitalAssets11               ID = "ASWF-Digital-Assets-1.1"
+	Baekmuk                           ID = "Baekmuk"
+	Bahyph                            ID = "Bahyph"
+	Barr                              ID = "Barr"
+	BcryptSolarDesigner               ID = "bcrypt-Solar-Designer"
+	Beerware                          ID = "Beerware"
+	BitstreamCharter                  ID = "Bitstream-Charter"
+	BitstreamVera                     ID = "Bitstream-Vera"
+	BitTorrent10                      ID = "BitTorrent-1.0"
+	BitTorrent11                      ID = "BitTorrent-1.1"
+	Blessing                          ID = "blessing"
+	BlueOak100                        ID = "BlueOak-1.0.0"
+	BoehmGC                           ID = "Boehm-GC"
+	BoehmGCWithoutFee                 ID = "Boehm-GC-without-fee"
+	Borceux                           ID = "Borceux"
+	BrianGladman2Clause               ID = "Brian-Gladman-2-Clause"
+	BrianGladman3Clause               ID = "Brian-Gladman-3-Clause"
+	BSD1Clause                        ID = "BSD-1-Clause"
+	BSD2Clause                        ID = "BSD-2-Clause"
+	BSD2ClauseDarwin                  ID = "BSD-2-Clause-Darwin"
+	BSD2ClauseFirstLines              ID = "BSD-2-Clause-first-lines"
+	BSD2ClausePatent                  ID = "BSD-2-Clause-Patent"
+	BSD2ClausePkgconfDisclaimer       ID = "BSD-2-Clause-pkgconf-disclaimer"
+	BSD2ClauseViews                   ID = "BSD-2-Clause-Views"
+	BSD3Clause                        ID = "BSD-3-Clause"
+	BSD3ClauseAcpica                  ID = "BSD-3-Clause-acpica"
+	BSD3ClauseAttribution             ID = "BSD-3-Clause-Attribution"
+	BSD3ClauseClear                   ID = "BSD-3-Clause-Clear"
+	BSD3ClauseFlex                    ID = "BSD-3-Clause-flex"
+	BSD3ClauseHP                      ID = "BSD-3-Clause-HP"
+	BSD3ClauseLBNL                    ID = "BSD-3-Clause-LBNL"
+	BSD3ClauseModification            ID = "BSD-3-Clause-Modification"
+	BSD3ClauseNoMilitaryLicense       ID = "BSD-3-Clause-No-Military-License"
+	BSD3ClauseNoNuclearLicense        ID = "BSD-3-Clause-No-Nuclear-License"
+	BSD3ClauseNoNuclearLicense2014    ID = "BSD-3-Clause-No-Nuclear-License-2014"
+	BSD3ClauseNoNuclearWarranty       ID = "BSD-3-Clause-No-Nuclear-Warranty"
+	BSD3ClauseOpenMPI                 ID = "BSD-3-Clause-Open-MPI"
+	BSD3ClauseSun                     ID = "BSD-3-Clause-Sun"
+	BSD4Clause                        ID = "BSD-4-Clause"
+	BSD4ClauseShortened               ID = "BSD-4-Clause-Shortened"
+	BSD4ClauseUC                      ID = "BSD-4-Clause-UC"
+	BSD43RENO                         ID = "BSD-4.3RENO"
+	BSD43TAHOE                        ID = "BSD-4.3TAHOE"
+	BSDAdvertisingAcknowledgement     ID = "BSD-Advertising-Acknowledgement"
+	BSDAttributionHPNDDisclaimer      ID = "BSD-Attribution-HPND-disclaimer"
+	BSDInfernoNettverk                ID = "BSD-Inferno-Nettverk"
+	BSDProtection                     ID = "BSD-Protection"
+	BSDSourceBeginningFile            ID = "BSD-Source-beginning-file"
+	BSDSourceCode                     ID = "BSD-Source-Code"
+	BSDSystemics                      ID = "BSD-Systemics"
+	BSDSystemicsW3Works               ID = "BSD-Systemics-W3Works"
+	BSL10                             ID = "BSL-1.0"
+	BUSL11                            ID = "BUSL-1.1"
+	Bzip2106                          ID = "bzip2-1.0.6"
+	CUDA10                            ID = "C-UDA-1.0"
+	CAL10                             ID = "CAL-1.0"
+	CAL10CombinedWorkException        ID = "CAL-1.0-Combined-Work-Exception"
+	Caldera                           ID = "Caldera"
+	CalderaNoPreamble                 ID = "Caldera-no-preamble"
+	Catharon                          ID = "Catharon"
+	CATOSL11                          ID = "CATOSL-1.1"
+	CCBY10                            ID = "CC-BY-1.0"
+	CCBY20                            ID = "CC-BY-2.0"
+	CCBY25                            ID = "CC-BY-2.5"
+	CCBY25AU                          ID = "CC-BY-2.5-AU"
+	CCBY30                            ID = "CC-BY-3.0"
+	CCBY30AT                          ID = "CC-BY-3.0-AT"
+	CCBY30AU                          ID = "CC-BY-3.0-AU"
+	CCBY30DE                          ID = "CC-BY-3.0-DE"
+	CCBY30IGO                         ID = "CC-BY-3.0-IGO"
+	CCBY30NL                          ID = "CC-BY-3.0-NL"
+	CCBY30US                          ID = "CC-BY-3.0-US"
+	CCBY40                            ID = "CC-BY-4.0"
+	CCBYNC10                          ID = "CC-BY-NC-1.0"
+	CCBYNC20                          ID = "CC-BY-NC-2.0"
+	CCBYNC25                          ID = "CC-BY-NC-2.5"
+	CCBYNC30                          ID = "CC-BY-NC-3.0"
+	CCBYNC30DE                        ID = "CC-BY-NC-3.0-DE"
+	CCBYNC40                          ID = "CC-BY-NC-4.0"
+	CCBYNCND10                        ID = "CC-BY-NC-ND-1.0"
+	CCBYNCND20                        ID = "CC-BY-NC-ND-2.0"
+	CCBYNCND25                        ID = "CC-BY-NC-ND-2.5"
+	CCBYNCND30                        ID = "CC-BY-NC-ND-3.0"
+	CCBYNCND30DE                      ID = "CC-BY-NC-ND-3.0-DE"
+	CCBYNCND30IGO                     ID = "CC-BY-NC-ND-3.0-IGO"
+	CCBYNCND40                        ID = "CC-BY-NC-ND-4.0"
+	CCBYNCSA10                        ID = "CC-BY-NC-SA-1.0"
+	CCBYNCSA20                        ID = "CC-BY-NC-SA-2.0"
+	CCBYNCSA20DE                      ID = "CC-BY-NC-SA-2.0-DE"
+	CCBYNCSA20FR                      ID = "CC-BY-NC-SA-2.0-FR"
+	CCBYNCSA20UK                      ID = "CC-BY-NC-SA-2.0-UK"
+	CCBYNCSA25                        ID = "CC-BY-NC-SA-2.5"
+	CCBYNCSA30                        ID = "CC-BY-NC-SA-3.0"
+	CCBYNCSA30DE                      ID = "CC-BY-NC-SA-3.0-DE"
+	CCBYNCSA30IGO                     ID = "CC-BY-NC-SA-3.0-IGO"
+	CCBYNCSA40                        ID = "CC-BY-NC-SA-4.0"
+	CCBYND10                          ID = "CC-BY-ND-1.0"
+	CCBYND20                          ID = "CC-BY-ND-2.0"
+	CCBYND25                          ID = "CC-BY-ND-2.5"
+	CCBYND30                          ID = "CC-BY-ND-3.0"
+	CCBYND30DE                        ID = "CC-BY-ND-3.0-DE"
+	CCBYND40                          ID = "CC-BY-ND-4.0"
+	CCBYSA10                          ID = "CC-BY-SA-1.0"
+	CCBYSA20                          ID = "CC-BY-SA-2.0"
+	CCBYSA20UK                        ID = "CC-BY-SA-2.0-UK"
+	CCBYSA21JP                        ID = "CC-BY-SA-2.1-JP"
+	CCBYSA25                          ID = "CC-BY-SA-2.5"
+	CCBYSA30                          ID = "CC-BY-SA-3.0"
+	CCBYSA30AT                        ID = "CC-BY-SA-3.0-AT"
+	CCBYSA30DE                        ID = "CC-BY-SA-3.0-DE"
+	CCBYSA30IGO                       ID = "CC-BY-SA-3.0-IGO"
+	CCBYSA40                          ID = "CC-BY-SA-4.0"
+	CCPDDC                            ID = "CC-PDDC"
+	CCPDM10                           ID = "CC-PDM-1.0"
+	CCSA10                            ID = "CC-SA-1.0"
+	CC010                             ID = "CC0-1.0"
+	CDDL10                            ID = "CDDL-1.0"
+	CDDL11                            ID = "CDDL-1.1"
+	CDL10                             ID = "CDL-1.0"
+	CDLAPermissive10                  ID = "CDLA-Permissive-1.0"
+	CDLAPermissive20                  ID = "CDLA-Permissive-2.0"
+	CDLASharing10                     ID = "CDLA-Sharing-1.0"
+	CECILL10                          ID = "CECILL-1.0"
+	CECILL11                          ID = "CECILL-1.1"
+	CECILL20                          ID = "CECILL-2.0"
+	CECILL21                          ID = "CECILL-2.1"
+	CECILLB                           ID = "CECILL-B"
+	CECILLC                           ID = "CECILL-C"
+	CERNOHL11                         ID = "CERN-OHL-1.1"
+	CERNOHL12                         ID = "CERN-OHL-1.2"
+	CERNOHLP20                        ID = "CERN-OHL-P-2.0"
+	CERNOHLS20                        ID = "CERN-OHL-S-2.0"
+	CERNOHLW20                        ID = "CERN-OHL-W-2.0"
+	CFITSIO                           ID = "CFITSIO"
+	CheckCvs                          ID = "check-cvs"
+	Checkmk                           ID = "checkmk"
+	ClArtistic                        ID = "ClArtistic"
+	Clips                             ID = "Clips"
+	CMUMach                           ID = "CMU-Mach"
+	CMUMachNodoc                      ID = "CMU-Mach-nodoc"
+	CNRIJython                        ID = "CNRI-Jython"
+	CNRIPython                        ID = "CNRI-Python"
+	CNRIPythonGPLCompatible           ID = "CNRI-Python-GPL-Compatible"
+	COIL10                            ID = "COIL-1.0"
+	CommunitySpec10                   ID = "Community-Spec-1.0"
+	Condor11                          ID = "Condor-1.1"
+	CopyleftNext030                   ID = "copyleft-next-0.3.0"
+	CopyleftNext031                   ID = "copyleft-next-0.3.1"
+	CornellLosslessJPEG               ID = "Cornell-Lossless-JPEG"
+	CPAL10                            ID = "CPAL-1.0"
+	CPL10                             ID = "CPL-1.0"
+	CPOL102                           ID = "CPOL-1.02"
+	Cronyx                            ID = "Cronyx"
+	Crossword                         ID = "Crossword"
+	CryptoSwift                       ID = "CryptoSwift"
+	CrystalStacker                    ID = "CrystalStacker"
+	CUAOPL10                          ID = "CUA-OPL-1.0"
+	Cube                              ID = "Cube"
+	Curl                              ID = "curl"
+	CveTou                            ID = "cve-tou"
+	DFSL10                            ID = "D-FSL-1.0"
+	DEC3Clause                        ID = "DEC-3-Clause"
+	Diffmark                          ID = "diffmark"
+	DLDEBY20                          ID = "DL-DE-BY-2.0"
+	DLDEZERO20                        ID = "DL-DE-ZERO-2.0"
+	DOC                               ID = "DOC"
+	DocBookDTD                        ID = "DocBook-DTD"
+	DocBookSchema                     ID = "DocBook-Schema"
+	DocBookStylesheet                 ID = "DocBook-Stylesheet"
+	DocBookXML                        ID = "DocBook-XML"
+	Dotseqn                           ID = "Dotseqn"
+	DRL10                             ID = "DRL-1.0"
+	DRL11                             ID = "DRL-1.1"
+	DSDP                              ID = "DSDP"
+	Dtoa                              ID = "dtoa"
+	Dvipdfm                           ID = "dvipdfm"
+	ECL10                             ID = "ECL-1.0"
+	ECL20                             ID = "ECL-2.0"
+	EFL10                             ID = "EFL-1.0"
+	EFL20                             ID = "EFL-2.0"
+	EGenix                            ID = "eGenix"
+	Elastic20                         ID = "Elastic-2.0"
+	Entessa                           ID = "Entessa"
+	EPICS                             ID = "EPICS"
+	EPL10                             ID = "EPL-1.0"
+	EPL20                             ID = "EPL-2.0"
+	ErlPL11                           ID = "ErlPL-1.1"
+	Etalab20                          ID = "etalab-2.0"
+	EUDatagrid                        ID = "EUDatagrid"
+	EUPL10                            ID = "EUPL-1.0"
+	EUPL11                            ID = "EUPL-1.1"
+	EUPL12                            ID = "EUPL-1.2"
+	Eurosym                           ID = "Eurosym"
+	Fair                              ID = "Fair"
+	FBM                               ID = "FBM"
+	FDKAAC                            ID = "FDK-AAC"
+	FergusonTwofish                   ID = "Ferguson-Twofish"
+	Frameworx10                       ID = "Frameworx-1.0"
+	FreeBSDDOC                        ID = "FreeBSD-DOC"
+	FreeImage                         ID = "FreeImage"
+	FSFAP                             ID = "FSFAP"
+	FSFAPNoWarrantyDisclaimer         ID = "FSFAP-no-warranty-disclaimer"
+	FSFUL                             ID = "FSFUL"
+	FSFULLR                           ID = "FSFULLR"
+	FSFULLRSD                         ID = "FSFULLRSD"
+	FSFULLRWD                         ID = "FSFULLRWD"
+	FSL11ALv2                         ID = "FSL-1.1-ALv2"
+	FSL11MIT                          ID = "FSL-1.1-MIT"
+	FTL                               ID = "FTL"
+	Furuseth                          ID = "Furuseth"
+	Fwlw                              ID = "fwlw"
+	GameProgrammingGems               ID = "Game-Programming-Gems"
+	GCRDocs                           ID = "GCR-docs"
+	GD                                ID = "GD"
+	GenericXts                        ID = "generic-xts"
+	GFDL11InvariantsOnly              ID = "GFDL-1.1-invariants-only"
+	GFDL11InvariantsOrLater           ID = "GFDL-1.1-invariants-or-later"
+	GFDL11NoInvariantsOnly            ID = "GFDL-1.1-no-invariants-only"
+	GFDL11NoInvariantsOrLater         ID = "GFDL-1.1-no-invariants-or-later"
+	GFDL11Only                        ID = "GFDL-1.1-only"
+	GFDL11OrLater                     ID = "GFDL-1.1-or-later"
+	GFDL12InvariantsOnly              ID = "GFDL-1.2-invariants-only"
+	GFDL12InvariantsOrLater           ID = "GFDL-1.2-invariants-or-later"
+	GFDL12NoInvariantsOnly            ID = "GFDL-1.2-no-invariants-only"
+	GFDL12NoInvariantsOrLater         ID = "GFDL-1.2-no-invariants-or-later"
+	GFDL12Only                        ID = "GFDL-1.2-only"
+	GFDL12OrLater                     ID = "GFDL-1.2-or-later"
+	GFDL13InvariantsOnly              ID = "GFDL-1.3-invariants-only"
+	GFDL13InvariantsOrLater           ID = "GFDL-1.3-invariants-or-later"
+	GFDL13NoInvariantsOnly            ID = "GFDL-1.3-no-invariants-only"
+	GFDL13NoInvariantsOrLater         ID = "GFDL-1.3-no-invariants-or-later"
+	GFDL13Only                        ID = "GFDL-1.3-only"
+	GFDL13OrLater                     ID = "GFDL-1.3-or-later"
+	Giftware                          ID = "Giftware"
+	GL2PS                             ID = "GL2PS"
+	Glide                             ID = "Glide"
+	Glulxe                            ID = "Glulxe"
+	GLWTPL                            ID = "GLWTPL"
+	Gnuplot                           ID = "gnuplot"
+	GPL10Only                         ID = "GPL-1.0-only"
+	GPL10OrLater                      ID = "GPL-1.0-or-later"
+	GPL20Only                         ID = "GPL-2.0-only"
+	GPL20OrLater                      ID = "GPL-2.0-or-later"
+	GPL30Only                         ID = "GPL-3.0-only"
+	GPL30OrLater                      ID = "GPL-3.0-or-later"
+	GraphicsGems                      ID = "Graphics-Gems"
+	GSOAP13b                          ID = "gSOAP-1.3b"
+	Gtkbook                           ID = "gtkbook"
+	Gutmann                           ID = "Gutmann"
+	HaskellReport                     ID = "HaskellReport"
+	HDF5                              ID = "HDF5"
+	Hdparm                            ID = "hdparm"
+	HIDAPI                            ID = "HIDAPI"
+	Hippocratic21                     ID = "Hippocratic-2.1"
+	HP1986                            ID = "HP-1986"
+	HP1989                            ID = "HP-1989"
+	HPND                              ID = "HPND"
+	HPNDDEC                           ID = "HPND-DEC"
+	HPNDDoc                           ID = "HPND-doc"
+	HPNDDocSell                       ID = "HPND-doc-sell"
+	HPNDExportUS                      ID = "HPND-export-US"
+	HPNDExportUSAcknowledgement       ID = "HPND-export-US-acknowledgement"
+	HPNDExportUSModify                ID = "HPND-export-US-modify"
+	HPNDExport2US                     ID = "HPND-export2-US"
+	HPNDFennebergLivingston           ID = "HPND-Fenneberg-Livingston"
+	HPNDINRIAIMAG                     ID = "HPND-INRIA-IMAG"
+	HPNDIntel                         ID = "HPND-Intel"
+	HPNDKevlinHenney                  ID = "HPND-Kevlin-Henney"
+	HPNDMarkusKuhn                    ID = "HPND-Markus-Kuhn"
+	HPNDMerchantabilityVariant        ID = "HPND-merchantability-variant"
+	HPNDMITDisclaimer                 ID = "HPND-MIT-disclaimer"
+	HPNDNetrek                        ID = "HPND-Netrek"
+	HPNDPbmplus                       ID = "HPND-Pbmplus"
+	HPNDSellMITDisclaimerXserver      ID = "HPND-sell-MIT-disclaimer-xserver"
+	HPNDSellRegexpr                   ID = "HPND-sell-regexpr"
+	HPNDSellVariant                   ID = "HPND-sell-variant"
+	HPNDSellVariantMITDisclaimer      ID = "HPND-sell-variant-MIT-disclaimer"
+	HPNDSellVariantMITDisclaimerRev   ID = "HPND-sell-variant-MIT-disclaimer-rev"
+	HPNDUC                            ID = "HPND-UC"
+	HPNDUCExportUS                    ID = "HPND-UC-export-US"
+	HTMLTIDY                          ID = "HTMLTIDY"
+	IBMPibs                           ID = "IBM-pibs"
+	ICU                               ID = "ICU"
+	IECCodeComponentsEULA             ID = "IEC-Code-Components-EULA"
+	IJG                               ID = "IJG"
+	IJGShort                          ID = "IJG-short"
+	ImageMagick                       ID = "ImageMagick"
+	IMatix                            ID = "iMatix"
+	Imlib2                            ID = "Imlib2"
+	InfoZIP                           ID = "Info-ZIP"
+	InnerNet20                        ID = "Inner-Net-2.0"
+	InnoSetup                         ID = "InnoSetup"
+	Intel                             ID = "Intel"
+	IntelACPI                         ID = "Intel-ACPI"
+	Interbase10                       ID = "Interbase-1.0"
+	IPA                               ID = "IPA"
+	IPL10                             ID = "IPL-1.0"
+	ISC                               ID = "ISC"
+	ISCVeillard                       ID = "ISC-Veillard"
+	Jam                               ID = "Jam"
+	JasPer20                          ID = "JasPer-2.0"
+	Jove                              ID = "jove"
+	JPLImage                          ID = "JPL-image"
+	JPNIC                             ID = "JPNIC"
+	JSON                              ID = "JSON"
+	Kastrup                           ID = "Kastrup"
+	Kazlib                            ID = "Kazlib"
+	KnuthCTAN                         ID = "Knuth-CTAN"
+	LAL12                             ID = "LAL-1.2"
+	LAL13                             ID = "LAL-1.3"
+	Latex2e                           ID = "Latex2e"
+	Latex2eTranslatedNotice           ID = "Latex2e-translated-notice"
+	Leptonica                         ID = "Leptonica"
+	LGPL20Only                        ID = "LGPL-2.0-only"
+	LGPL20OrLater                     ID = "LGPL-2.0-or-later"
+	LGPL21Only                        ID = "LGPL-2.1-only"
+	LGPL21OrLater                     ID = "LGPL-2.1-or-later"
+	LGPL30Only                        ID = "LGPL-3.0-only"
+	LGPL30OrLater                     ID = "LGPL-3.0-or-later"
+	LGPLLR                            ID = "LGPLLR"
+	Libpng                            ID = "Libpng"
+	Libpng1635                        ID = "libpng-1.6.35"
+	Libpng20                          ID = "libpng-2.0"
+	Libselinux10                      ID = "libselinux-1.0"
+	Libtiff                           ID = "libtiff"
+	LibutilDavidNugent                ID = "libutil-David-Nugent"
+	LiLiQP11                          ID = "LiLiQ-P-1.1"
+	LiLiQR11                          ID = "LiLiQ-R-1.1"
+	LiLiQRplus11                      ID = "LiLiQ-Rplus-1.1"
+	LinuxManPages1Para                ID = "Linux-man-pages-1-para"
+	LinuxManPagesCopyleft             ID = "Linux-man-pages-copyleft"
+	LinuxManPagesCopyleft2Para        ID = "Linux-man-pages-copyleft-2-para"
+	LinuxManPagesCopyleftVar          ID = "Linux-man-pages-copyleft-var"
+	LinuxOpenIB                       ID = "Linux-OpenIB"
+	LOOP                              ID = "LOOP"
+	LPDDocument                       ID = "LPD-document"
+	LPL10                             ID = "LPL-1.0"
+	LPL102                            ID = "LPL-1.02"
+	LPPL10                            ID = "LPPL-1.0"
+	LPPL11                            ID = "LPPL-1.1"
+	LPPL12                            ID = "LPPL-1.2"
+	LPPL13a                           ID = "LPPL-1.3a"
+	LPPL13c                           ID = "LPPL-1.3c"
+	Lsof                              ID = "lsof"
+	LucidaBitmapFonts                 ID = "Lucida-Bitmap-Fonts"
+	LZMASDK911To920                   ID = "LZMA-SDK-9.11-to-9.20"
+	LZMASDK922                        ID = "LZMA-SDK-9.22"
+	Mackerras3Clause                  ID = "Mackerras-3-Clause"
+	Mackerras3ClauseAcknowledgment    ID = "Mackerras-3-Clause-acknowledgment"
+	Magaz                             ID = "magaz"
+	Mailprio                          ID = "mailprio"
+	MakeIndex                         ID = "MakeIndex"
+	Man2html                          ID = "man2html"
+	MartinBirgmeier                   ID = "Martin-Birgmeier"
+	McPheeSlideshow                   ID = "McPhee-slideshow"
+	Metamail                          ID = "metamail"
+	Minpack                           ID = "Minpack"
+	MIPS                              ID = "MIPS"
+	MirOS                             ID = "MirOS"
+	MIT                               ID = "MIT"
+	MIT0                              ID = "MIT-0"
+	MITAdvertising                    ID = "MIT-advertising"
+	MITClick                          ID = "MIT-Click"
+	MITCMU                            ID = "MIT-CMU"
+	MITEnna                           ID = "MIT-enna"
+	MITFeh                            ID = "MIT-feh"
+	MITFestival                       ID = "MIT-Festival"
+	MITKhronosOld                     ID = "MIT-Khronos-old"
+	MITModernVariant                  ID = "MIT-Modern-Variant"
+	MITOpenGroup                      ID = "MIT-open-group"
+	MITTestregex                      ID = "MIT-testregex"
+	MITWu                             ID = "MIT-Wu"
+	MITNFA                            ID = "MITNFA"
+	MMIXware                          ID = "MMIXware"
+	Motosoto                          ID = "Motosoto"
+	MPEGSSG                           ID = "MPEG-SSG"
+	MpiPermissive                     ID = "mpi-permissive"
+	Mpich2                            ID = "mpich2"
+	MPL10                             ID = "MPL-1.0"
+	MPL11                             ID = "MPL-1.1"
+	MPL20                             ID = "MPL-2.0"
+	MPL20NoCopyleftException          ID = "MPL-2.0-no-copyleft-exception"
+	Mplus                             ID = "mplus"
+	MSLPL                             ID = "MS-LPL"
+	MSPL                              ID = "MS-PL"
+	MSRL                              ID = "MS-RL"
+	MTLL                              ID = "MTLL"
+	MulanPSL10                        ID = "MulanPSL-1.0"
+	MulanPSL20                        ID = "MulanPSL-2.0"
+	Multics                           ID = "Multics"
+	Mup                               ID = "Mup"
+	NAIST2003                         ID = "NAIST-2003"
+	NASA13                            ID = "NASA-1.3"
+	Naumen                            ID = "Naumen"
+	NBPL10                            ID = "NBPL-1.0"
+	NCBIPD                            ID = "NCBI-PD"
+	NCGLUK20                          ID = "NCGL-UK-2.0"
+	NCL                               ID = "NCL"
+	NCSA                              ID = "NCSA"
+	NetCDF                            ID = "NetCDF"
+	Newsletr                          ID = "Newsletr"
+	NGPL                              ID = "NGPL"
+	Ngrep                             ID = "ngrep"
+	NICTA10                           ID = "NICTA-1.0"
+	NISTPD                            ID = "NIST-PD"
+	NISTPDFallback                    ID = "NIST-PD-fallback"
+	NISTSoftware                      ID = "NIST-Software"
+	NLOD10                            ID = "NLOD-1.0"
+	NLOD20                            ID = "NLOD-2.0"
+	NLPL                              ID = "NLPL"
+	Nokia                             ID = "Nokia"
+	NOSL                              ID = "NOSL"
+	Noweb                             ID = "Noweb"
+	NPL10                             ID = "NPL-1.0"
+	NPL11                             ID = "NPL-1.1"
+	NPOSL30                           ID = "NPOSL-3.0"
+	NRL                               ID = "NRL"
+	NTIAPD                            ID = "NTIA-PD"
+	NTP                               ID = "NTP"
+	NTP0                              ID = "NTP-0"
+	OUDA10                            ID = "O-UDA-1.0"
+	OAR                               ID = "OAR"
+	OCCTPL                            ID = "OCCT-PL"
+	OCLC20                            ID = "OCLC-2.0"
+	ODbL10                            ID = "ODbL-1.0"
+	ODCBy10                           ID = "ODC-By-1.0"
+	OFFIS                             ID = "OFFIS"
+	OFL10                             ID = "OFL-1.0"
+	OFL10NoRFN                        ID = "OFL-1.0-no-RFN"
+	OFL10RFN                          ID = "OFL-1.0-RFN"
+	OFL11                             ID = "OFL-1.1"
+	OFL11NoRFN                        ID = "OFL-1.1-no-RFN"
+	OFL11RFN                          ID = "OFL-1.1-RFN"
+	OGC10                             ID = "OGC-1.0"
+	OGDLTaiwan10                      ID = "OGDL-Taiwan-1.0"
+	OGLCanada20                       ID = "OGL-Canada-2.0"
+	OGLUK10                           ID = "OGL-UK-1.0"
+	OGLUK20                           ID = "OGL-UK-2.0"
+	OGLUK30                           ID = "OGL-UK-3.0"
+	OGTSL                             ID = "OGTSL"
+	OLDAP11                           ID = "OLDAP-1.1"
+	OLDAP12                           ID = "OLDAP-1.2"
+	OLDAP13                           ID = "OLDAP-1.3"
+	OLDAP14                           ID = "OLDAP-1.4"
+	OLDAP20                           ID = "OLDAP-2.0"
+	OLDAP201                          ID = "OLDAP-2.0.1"
+	OLDAP21                           ID = "OLDAP-2.1"
+	OLDAP22                           ID = "OLDAP-2.2"
+	OLDAP221                          ID = "OLDAP-2.2.1"
+	OLDAP222                          ID = "OLDAP-2.2.2"
+	OLDAP23                           ID = "OLDAP-2.3"
+	OLDAP24                           ID = "OLDAP-2.4"
+	OLDAP25                           ID = "OLDAP-2.5"
+	OLDAP26                           ID = "OLDAP-2.6"
+	OLDAP27                           ID = "OLDAP-2.7"
+	OLDAP28                           ID = "OLDAP-2.8"
+	OLFL13                            ID = "OLFL-1.3"
+	OML                               ID = "OML"
+	OpenPBS23                         ID = "OpenPBS-2.3"
+	OpenSSL                           ID = "OpenSSL"
+	OpenSSLStandalone                 ID = "OpenSSL-standalone"
+	OpenVision                        ID = "OpenVision"
+	OPL10                             ID = "OPL-1.0"
+	OPLUK30                           ID = "OPL-UK-3.0"
+	OPUBL10                           ID = "OPUBL-1.0"
+	OSETPL21                          ID = "OSET-PL-2.1"
+	OSL10                             ID = "OSL-1.0"
+	OSL11                             ID = "OSL-1.1"
+	OSL20                             ID = "OSL-2.0"
+	OSL21                             ID = "OSL-2.1"
+	OSL30                             ID = "OSL-3.0"
+	PADL                              ID = "PADL"
+	Parity600                         ID = "Parity-6.0.0"
+	Parity700                         ID = "Parity-7.0.0"
+	PDDL10                            ID = "PDDL-1.0"
+	PHP30                             ID = "PHP-3.0"
+	PHP301                            ID = "PHP-3.01"
+	Pixar                             ID = "Pixar"
+	Pkgconf                           ID = "pkgconf"
+	Plexus                            ID = "Plexus"
+	Pnmstitch                         ID = "pnmstitch"
+	PolyFormNoncommercial100          ID = "PolyForm-Noncommercial-1.0.0"
+	PolyFormSmallBusiness100          ID = "PolyForm-Small-Business-1.0.0"
+	PostgreSQL                        ID = "PostgreSQL"
+	PPL                               ID = "PPL"
+	PSF20                             ID = "PSF-2.0"
+	Psfrag                            ID = "psfrag"
+	Psutils                           ID = "psutils"
+	Python20                          ID = "Python-2.0"
+	Python201                         ID = "Python-2.0.1"
+	PythonLdap                        ID = "python-ldap"
+	Qhull                             ID = "Qhull"
+	QPL10                             ID = "QPL-1.0"
+	QPL10INRIA2004                    ID = "QPL-1.0-INRIA-2004"
+	Radvd                             ID = "radvd"
+	Rdisc                             ID = "Rdisc"
+	RHeCos11                          ID = "RHeCos-1.1"
+	RPL11                             ID = "RPL-1.1"
+	RPL15                             ID = "RPL-1.5"
+	RPSL10                            ID = "RPSL-1.0"
+	RSAMD                             ID = "RSA-MD"
+	RSCPL                             ID = "RSCPL"
+	Ruby                              ID = "Ruby"
+	RubyPty                           ID = "Ruby-pty"
+	SAXPD                             ID = "SAX-PD"
+	SAXPD20                           ID = "SAX-PD-2.0"
+	Saxpath                           ID = "Saxpath"
+	SCEA                              ID = "SCEA"
+	SchemeReport                      ID = "SchemeReport"
+	Sendmail                          ID = "Sendmail"
+	Sendmail823                       ID = "Sendmail-8.23"
+	SendmailOpenSource11              ID = "Sendmail-Open-Source-1.1"
+	SGIB10                            ID = "SGI-B-1.0"
+	SGIB11                            ID = "SGI-B-1.1"
+	SGIB20                            ID = "SGI-B-2.0"
+	SGIOpenGL                         ID = "SGI-OpenGL"
+	SGP4                              ID = "SGP4"
+	SHL05                             ID = "SHL-0.5"
+	SHL051                            ID = "SHL-0.51"
+	SimPL20                           ID = "SimPL-2.0"
+	SL                                ID = "SL"
+	Sleepycat                         ID = "Sleepycat"
+	SMAILGPL                          ID = "SMAIL-GPL"
+	SMLNJ                             ID = "SMLNJ"
+	SMPPL                             ID = "SMPPL"
+	SNIA                              ID = "SNIA"
+	Snprintf                          ID = "snprintf"
+	SoftSurfer                        ID = "softSurfer"
+	Soundex                           ID = "Soundex"
+	Spencer86                         ID = "Spencer-86"
+	Spencer94                         ID = "Spencer-94"
+	Spencer99                         ID = "Spencer-99"
+	SPL10                             ID = "SPL-1.0"
+	SshKeyscan                        ID = "ssh-keyscan"
+	SSHOpenSSH                        ID = "SSH-OpenSSH"
+	SSHShort                          ID = "SSH-short"
+	SSLeayStandalone                  ID = "SSLeay-standalone"
+	SSPL10                            ID = "SSPL-1.0"
+	SugarCRM113                       ID = "SugarCRM-1.1.3"
+	SUL10                             ID = "SUL-1.0"
+	SunPPP                            ID = "Sun-PPP"
+	SunPPP2000                        ID = "Sun-PPP-2000"
+	SunPro                            ID = "SunPro"
+	SWL                               ID = "SWL"
+	Swrule                            ID = "swrule"
+	Symlinks                          ID = "Symlinks"
+	TAPROHL10                         ID = "TAPR-OHL-1.0"
+	TCL                               ID = "TCL"
+	TCPWrappers                       ID = "TCP-wrappers"
+	TermReadKey                       ID = "TermReadKey"
+	TGPPL10                           ID = "TGPPL-1.0"
+	ThirdEye                          ID = "ThirdEye"
+	Threeparttable                    ID = "threeparttable"
+	TMate                             ID = "TMate"
+	TORQUE11                          ID = "TORQUE-1.1"
+	TOSL                              ID = "TOSL"
+	TPDL                              ID = "TPDL"
+	TPL10                             ID = "TPL-1.0"
+	TrustedQSL                        ID = "TrustedQSL"
+	TTWL                              ID = "TTWL"
+	TTYP0                             ID = "TTYP0"
+	TUBerlin10                        ID = "TU-Berlin-1.0"
+	TUBerlin20                        ID = "TU-Berlin-2.0"
+	UbuntuFont10                      ID = "Ubuntu-font-1.0"
+	UCAR                              ID = "UCAR"
+	UCL10                             ID = "UCL-1.0"
+	Ulem                              ID = "ulem"
+	UMichMerit                        ID = "UMich-Merit"
+	Unicode30                         ID = "Unicode-3.0"
+	UnicodeDFS2015                    ID = "Unicode-DFS-2015"
+	UnicodeDFS2016                    ID = "Unicode-DFS-2016"
+	UnixCrypt                         ID = "UnixCrypt"
+	Unlicense                         ID = "Unlicense"
+	UnlicenseLibtelnet                ID = "Unlicense-libtelnet"
+	UnlicenseLibwhirlpool             ID = "Unlicense-libwhirlpool"
+	UPL10                             ID = "UPL-1.0"
+	URTRLE                            ID = "URT-RLE"
+	Vim                               ID = "Vim"
+	VOSTROM                           ID = "VOSTROM"
+	VSL10                             ID = "VSL-1.0"
+	W3C                               ID = "W3C"
+	W3C19980720                       ID = "W3C-19980720"
+	W3C20150513                       ID = "W3C-20150513"
+	W3m                               ID = "w3m"
+	WidgetWorkshop                    ID = "Widget-Workshop"
+	Wsuipa                            ID = "Wsuipa"
+	WTFPL                             ID = "WTFPL"
+	Wwl                               ID = "wwl"
+	X11                               ID = "X11"
+	X11DistributeModificationsVariant ID = "X11-distribute-modifications-variant"
+	X11Swapped                        ID = "X11-swapped"
+	Xdebug103                         ID = "Xdebug-1.03"
+	Xerox                             ID = "Xerox"
+	Xfig                              ID = "Xfig"
+	XFree8611                         ID = "XFree86-1.1"
+	Xinetd                            ID = "xinetd"
+	XkeyboardConfigZinoviev           ID = "xkeyboard-config-Zinoviev"
+	Xlock                             ID = "xlock"
+	Xnet                              ID = "Xnet"
+	Xpp                               ID = "xpp"
+	XSkat                             ID = "XSkat"
+	Xzoom                             ID = "xzoom"
+	YPL10                             ID = "YPL-1.0"
+	YPL11                             ID = "YPL-1.1"
+	Zed                               ID = "Zed"
+	Zeeff                             ID = "Zeeff"
+	Zend20                            ID = "Zend-2.0"
+	Zimbra13                          ID = "Zimbra-1.3"
+	Zimbra14                          ID = "Zimbra-1.4"
+	Zlib                              ID = "Zlib"
+	ZlibAcknowledgement               ID = "zlib-acknowledgement"
+	ZPL11                             ID = "ZPL-1.1"
+	ZPL20                             ID = "ZPL-2.0"
+	ZPL21                             ID = "ZPL-2.1"
+)
+
+// Deprecated licenses.
+const (
+	// Deprecated: AGPL-1.0 is deprecated in the SPDX license list.
+	AGPL10 ID = "AGPL-1.0"
+	// Deprecated: AGPL-3.0 is deprecated in the SPDX license list.
+	AGPL30 ID = "AGPL-3.0"
+	// Deprecated: BSD-2-Clause-FreeBSD is deprecated in the SPDX license list.
+	BSD2ClauseFreeBSD ID = "BSD-2-Clause-FreeBSD"
+	// Deprecated: BSD-2-Clause-NetBSD is deprecated in the SPDX license list.
+	BSD2ClauseNetBSD ID = "BSD-2-Clause-NetBSD"
+	// Deprecated: bzip2-1.0.5 is deprecated in the SPDX license list.
+	Bzip2105 ID = "bzip2-1.0.5"
+	// Deprecated: eCos-2.0 is deprecated in the SPDX license list.
+	ECos20 ID = "eCos-2.0"
+	// Deprecated: GFDL-1.1 is deprecated in the SPDX license list.
+	GFDL11 ID = "GFDL-1.1"
+	// Deprecated: GFDL-1.2 is deprecated in the SPDX license list.
+	GFDL12 ID = "GFDL-1.2"
+	// Deprecated: GFDL-1.3 is deprecated in the SPDX license list.
+	GFDL13 ID = "GFDL-1.3"
+	// Deprecated: GPL-1.0 is deprecated in the SPDX license list.
+	GPL10 ID = "GPL-1.0"
+	// Deprecated: GPL-1.0+ is deprecated in the SPDX license list.
+	GPL10Plus ID = "GPL-1.0+"
+	// Deprecated: GPL-2.0 is deprecated in the SPDX license list.
+	GPL20 ID = "GPL-2.0"
+	// Deprecated: GPL-2.0+ is deprecated in the SPDX license list.
+	GPL20Plus ID = "GPL-2.0+"
+	// Deprecated: GPL-2.0-with-autoconf-exception is deprecated in the SPDX license list.
+	GPL20WithAutoconfException ID = "GPL-2.0-with-autoconf-exception"
+	// Deprecated: GPL-2.0-with-bison-exception is deprecated in the SPDX license list.
+	GPL20WithBisonException ID = "GPL-2.0-with-bison-exception"
+	// Deprecated: GPL-2.0-with-classpath-exception is deprecated in the SPDX license list.
+	GPL20WithClasspathException ID = "GPL-2.0-with-classpath-exception"
+	// Deprecated: GPL-2.0-with-font-exception is deprecated in the SPDX license list.
+	GPL20WithFontException ID = "GPL-2.0-with-font-exception"
+	// Deprecated: GPL-2.0-with-GCC-exception is deprecated in the SPDX license list.
+	GPL20WithGCCException ID = "GPL-2.0-with-GCC-exception"
+	// Deprecated: GPL-3.0 is deprecated in the SPDX license list.
+	GPL30 ID = "GPL-3.0"
+	// Deprecated: GPL-3.0+ is deprecated in the SPDX license list.
+	GPL30Plus ID = "GPL-3.0+"
+	// Deprecated: GPL-3.0-with-autoconf-exception is deprecated in the SPDX license list.
+	GPL30WithAutoconfException ID = "GPL-3.0-with-autoconf-exception"
+	// Deprecated: GPL-3.0-with-GCC-exception is deprecated in the SPDX license list.
+	GPL30WithGCCException ID = "GPL-3.0-with-GCC-exception"
+	// Deprecated: LGPL-2.0 is deprecated in the SPDX license list.
+	LGPL20 ID = "LGPL-2.0"
+	// Deprecated: LGPL-2.0+ is deprecated in the SPDX license list.
+	LGPL20Plus ID = "LGPL-2.0+"
+	// Deprecated: LGPL-2.1 is deprecated in the SPDX license list.
+	LGPL21 ID = "LGPL-2.1"
+	// Deprecated: LGPL-2.1+ is deprecated in the SPDX license list.
+	LGPL21Plus ID = "LGPL-2.1+"
+	// Deprecated: LGPL-3.0 is deprecated in the SPDX license list.
+	LGPL30 ID = "LGPL-3.0"
+	// Deprecated: LGPL-3.0+ is deprecated in the SPDX license list.
+	LGPL30Plus ID = "LGPL-3.0+"
+	// Deprecated: Net-SNMP is deprecated in the SPDX license list.
+	NetSNMP ID = "Net-SNMP"
+	// Deprecated: Nunit is deprecated in the SPDX license list.
+	Nunit ID = "Nunit"
+	// Deprecated: StandardML-NJ is deprecated in the SPDX license list.
+	StandardMLNJ ID = "StandardML-NJ"
+	// Deprecated: wxWindows is deprecated in the SPDX license list.
+	WxWindows ID = "wxWindows"
+)
+
+// Exceptions.
+const (
+	L389Exception                     ExceptionID = "389-exception"
+	AsteriskException                 ExceptionID = "Asterisk-exception"
+	AsteriskLinkingProtocolsException ExceptionID = "Asterisk-linking-protocols-exception"
+	AutoconfException20               ExceptionID = "Autoconf-exception-2.0"
+	AutoconfException30               ExceptionID = "Autoconf-exception-3.0"
+	AutoconfExceptionGeneric          ExceptionID = "Autoconf-exception-generic"
+	AutoconfExceptionGeneric30        ExceptionID = "Autoconf-exception-generic-3.0"
+	AutoconfExceptionMacro            ExceptionID = "Autoconf-exception-macro"
+	BisonException124                 ExceptionID = "Bison-exception-1.24"
+	BisonException22                  ExceptionID = "Bison-exception-2.2"
+	BootloaderException               ExceptionID = "Bootloader-exception"
+	CGALLinkingException              ExceptionID = "CGAL-linking-exception"
+	ClasspathException20              ExceptionID = "Classpath-exception-2.0"
+	CLISPException20                  ExceptionID = "CLISP-exception-2.0"
+	CryptsetupOpenSSLException        ExceptionID = "cryptsetup-OpenSSL-exception"
+	DigiaQtLGPLException11            ExceptionID = "Digia-Qt-LGPL-exception-1.1"
+	DigiRuleFOSSException             ExceptionID = "DigiRule-FOSS-exception"
+	ECosException20                   ExceptionID = "eCos-exception-2.0"
+	ErlangOtpLinkingException         ExceptionID = "erlang-otp-linking-exception"
+	FawkesRuntimeException            ExceptionID = "Fawkes-Runtime-exception"
+	FLTKException                     ExceptionID = "FLTK-exception"
+	FmtException                      ExceptionID = "fmt-exception"
+	FontException20                   ExceptionID = "Font-exception-2.0"
+	FreertosException20               ExceptionID = "freertos-exception-2.0"
+	GCCException20                    ExceptionID = "GCC-exception-2.0"
+	GCCException20Note                ExceptionID = "GCC-exception-2.0-note"
+	GCCException31                    ExceptionID = "GCC-exception-3.1"
+	GmshException                     ExceptionID = "Gmsh-exception"
+	GNATException                     ExceptionID = "GNAT-exception"
+	GNOMEExamplesException            ExceptionID = "GNOME-examples-exception"
+	GNUCompilerException              ExceptionID = "GNU-compiler-exception"
+	GnuJavamailException              ExceptionID = "gnu-javamail-exception"
+	GPL30389DsBaseException           ExceptionID = "GPL-3.0-389-ds-base-exception"
+	GPL30InterfaceException           ExceptionID = "GPL-3.0-interface-exception"
+	GPL30LinkingException             ExceptionID = "GPL-3.0-linking-exception"
+	GPL30LinkingSourceException       ExceptionID = "GPL-3.0-linking-source-exception"
+	GPLCC10                           ExceptionID = "GPL-CC-1.0"
+	GStreamerException2005            ExceptionID = "GStreamer-exception-2005"
+	GStreamerException2008            ExceptionID = "GStreamer-exception-2008"
+	HarbourException                  ExceptionID = "harbour-exception"
+	I2pGplJavaException               ExceptionID = "i2p-gpl-java-exception"
+	IndependentModulesException       ExceptionID = "Independent-modules-exception"
+	KiCadLibrariesException           ExceptionID = "KiCad-libraries-exception"
+	LGPL30LinkingException            ExceptionID = "LGPL-3.0-linking-exception"
+	LibpriOpenH323Exception           ExceptionID = "libpri-OpenH323-exception"
+	LibtoolException                  ExceptionID = "Libtool-exception"
+	LinuxSyscallNote                  ExceptionID = "Linux-syscall-note"
+	LLGPL                             ExceptionID = "LLGPL"
+	LLVMException                     ExceptionID = "LLVM-exception"
+	LZMAException                     ExceptionID = "LZMA-exception"
+	MifException                      ExceptionID = "mif-exception"
+	MxmlException                     ExceptionID = "mxml-exception"
+	OCamlLGPLLinkingException         ExceptionID = "OCaml-LGPL-linking-exception"
+	OCCTException10                   ExceptionID = "OCCT-exception-1.0"
+	OpenJDKAssemblyException10        ExceptionID = "OpenJDK-assembly-exception-1.0"
+	OpenvpnOpensslException           ExceptionID = "openvpn-openssl-exception"
+	PolyparseException                ExceptionID = "polyparse-exception"
+	PSOrPDFFontException20170817      ExceptionID = "PS-or-PDF-font-exception-20170817"
+	QPL10INRIA2004Exception           ExceptionID = "QPL-1.0-INRIA-2004-exception"
+	QtGPLException10                  ExceptionID = "Qt-GPL-exception-1.0"
+	QtLGPLException11                 ExceptionID = "Qt-LGPL-exception-1.1"
+	QwtException10                    ExceptionID = "Qwt-exception-1.0"
+	RomicException                    ExceptionID = "romic-exception"
+	RRDtoolFLOSSException20           ExceptionID = "RRDtool-FLOSS-exception-2.0"
+	SANEException                     ExceptionID = "SANE-exception"
+	SHL20                             ExceptionID = "SHL-2.0"
+	SHL21                             ExceptionID = "SHL-2.1"
+	StunnelException                  ExceptionID = "stunnel-exception"
+	SWIException                      ExceptionID = "SWI-exception"
+	SwiftException                    ExceptionID = "Swift-exception"
+	TexinfoException                  ExceptionID = "Texinfo-exception"
+	UBootException20                  ExceptionID = "u-boot-exception-2.0"
+	UBDLException                     ExceptionID = "UBDL-exception"
+	UniversalFOSSException10          ExceptionID = "Universal-FOSS-exception-1.0"
+	VsftpdOpensslException            ExceptionID = "vsftpd-openssl-exception"
+	WxWindowsException31              ExceptionID = "WxWindows-exception-3.1"
+	X11vncOpensslException            ExceptionID = "x11vnc-openssl-exception"
+)
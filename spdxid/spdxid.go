@@ -0,0 +1,39 @@
+// Package spdxid provides constants for every SPDX license and exception
+// identifier in the license list embedded in github.com/git-pkgs/spdx, so
+// allow lists and policies written in Go are checked at compile time.
+// License constants are spdx.LicenseID values; exceptions are ExceptionID.
+//
+// Names drop the separators of the identifier and capitalize each part:
+// Apache-2.0 is Apache20, GPL-3.0-or-later is GPL30OrLater and
+// Classpath-exception-2.0 is ClasspathException20. A + is spelled out, so
+// GPL-2.0+ is GPL20Plus, and identifiers that start with a digit get an L
+// prefix, so 0BSD is L0BSD. Deprecated identifiers are marked Deprecated
+// so linters flag them.
+//
+// Example:
+//
+//	allowed := spdxid.Strings(spdxid.MIT, spdxid.Apache20, spdxid.BSD3Clause)
+//	ok, err := spdx.Satisfies(expr, allowed)
+package spdxid
+
+//go:generate go run gen.go
+
+import "github.com/git-pkgs/spdx"
+
+// ID is an SPDX license identifier. The constants are valid by
+// construction, so they can be passed wherever a spdx.LicenseID is taken.
+type ID = spdx.LicenseID
+
+// ExceptionID is an SPDX exception identifier, for use after WITH.
+type ExceptionID string
+
+// String returns the identifier.
+func (id ExceptionID) String() string {
+	return string(id)
+}
+
+// Strings converts identifiers to the []string that functions such as
+// spdx.Satisfies and spdx.Prune take.
+func Strings(ids ...ID) []string {
+	return spdx.IDStrings(ids...)
+}
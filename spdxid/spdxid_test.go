@@ -0,0 +1,31 @@
+package spdxid
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/git-pkgs/spdx"
+)
+
+func TestConstantsAreValid(t *testing.T) {
+	for _, id := range []ID{MIT, Apache20, BSD3Clause, GPL30OrLater, L0BSD, GPL20Plus} {
+		if got, err := spdx.ParseID(string(id)); err != nil || got != id {
+			t.Errorf("ParseID(%s) = %q, %v", id, got, err)
+		}
+	}
+	if got, err := spdx.NormalizeException(ClasspathException20.String()); err != nil || got != "Classpath-exception-2.0" {
+		t.Errorf("NormalizeException(%s) = %q, %v", ClasspathException20, got, err)
+	}
+}
+
+func TestStrings(t *testing.T) {
+	got := Strings(MIT, Apache20)
+	if want := []string{"MIT", "Apache-2.0"}; !slices.Equal(got, want) {
+		t.Errorf("Strings() = %v, want %v", got, want)
+	}
+
+	ok, err := spdx.Satisfies("MIT OR GPL-3.0-only", Strings(MIT))
+	if err != nil || !ok {
+		t.Errorf("Satisfies with Strings(MIT) = %v, %v", ok, err)
+	}
+}
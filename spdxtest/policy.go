@@ -0,0 +1,232 @@
+package spdxtest
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/git-pkgs/spdx"
+)
+
+// Verdict is the outcome of checking an expression against a Policy.
+type Verdict string
+
+const (
+	VerdictAllowed Verdict = "allowed"
+	VerdictReview  Verdict = "review"
+	VerdictDenied  Verdict = "denied"
+)
+
+// rank orders verdicts from best to worst.
+func (v Verdict) rank() int {
+	switch v {
+	case VerdictAllowed:
+		return 0
+	case VerdictReview:
+		return 1
+	}
+	return 2
+}
+
+// Policy is a license policy of the kind most compliance tools use.
+// Identifiers are matched before categories, and at the same level a
+// denial outranks an allowance. Licenses the policy doesn't mention,
+// LicenseRefs, NONE and NOASSERTION require review.
+//
+// An AND expression gets the worst verdict of its operands, and an OR
+// expression the best, since the licensee may choose.
+type Policy struct {
+	Allow           []string // license identifiers, in any case
+	Deny            []string
+	AllowCategories []spdx.Category
+	DenyCategories  []spdx.Category
+}
+
+// Check returns the verdict for a strict SPDX expression.
+//
+// Example:
+//
+//	p := Policy{Allow: []string{"MIT"}, DenyCategories: []spdx.Category{spdx.CategoryCopyleft}}
+//	p.Check("MIT OR GPL-3.0-only")   // VerdictAllowed, nil
+//	p.Check("MIT AND GPL-3.0-only")  // VerdictDenied, nil
+func (p Policy) Check(expression string) (Verdict, error) {
+	expr, err := spdx.ParseStrict(expression)
+	if err != nil {
+		return "", err
+	}
+	return p.check(expr), nil
+}
+
+func (p Policy) check(expr spdx.Expression) Verdict {
+	switch e := expr.(type) {
+	case *spdx.License:
+		return p.license(e.ID)
+	case *spdx.AndExpression:
+		left, right := p.check(e.Left), p.check(e.Right)
+		if right.rank() > left.rank() {
+			return right
+		}
+		return left
+	case *spdx.OrExpression:
+		left, right := p.check(e.Left), p.check(e.Right)
+		if right.rank() < left.rank() {
+			return right
+		}
+		return left
+	}
+	return VerdictReview
+}
+
+// license returns the verdict for a single license identifier.
+func (p Policy) license(id string) Verdict {
+	match := func(ids []string) bool {
+		return slices.ContainsFunc(ids, func(s string) bool { return strings.EqualFold(s, id) })
+	}
+	switch {
+	case match(p.Deny):
+		return VerdictDenied
+	case match(p.Allow):
+		return VerdictAllowed
+	}
+	cat := spdx.LicenseCategory(id)
+	switch {
+	case slices.Contains(p.DenyCategories, cat):
+		return VerdictDenied
+	case slices.Contains(p.AllowCategories, cat):
+		return VerdictAllowed
+	}
+	return VerdictReview
+}
+
+// Fixture is a generated test case for a policy.
+type Fixture struct {
+	Expression string
+	Want       Verdict
+	Reason     string // the boundary the fixture exercises
+}
+
+// PolicyFixtures generates boundary expressions for p: licenses that are
+// only just allowed or denied, combinations where one operand decides
+// the verdict, and licenses the policy leaves for review. Licenses for
+// categories are picked from the embedded license data, so each category
+// in the policy is exercised. The result is deterministic.
+//
+// Run the fixtures through the policy engine under test and compare with
+// Want:
+//
+//	for _, f := range spdxtest.PolicyFixtures(policy) {
+//		if got := engine.Evaluate(f.Expression); got != f.Want {
+//			t.Errorf("%s (%s) = %s, want %s", f.Expression, f.Reason, got, f.Want)
+//		}
+//	}
+func PolicyFixtures(p Policy) []Fixture {
+	loadIDs()
+
+	var allowed, denied []string
+	add := func(list *[]string, id string) {
+		if id != "" && !slices.Contains(*list, id) {
+			*list = append(*list, id)
+		}
+	}
+	for _, id := range p.Allow {
+		if p.license(id) == VerdictAllowed {
+			add(&allowed, canonical(id))
+		}
+	}
+	for _, id := range p.Deny {
+		add(&denied, canonical(id))
+	}
+	for _, cat := range p.AllowCategories {
+		add(&allowed, p.representative(cat, VerdictAllowed))
+	}
+	for _, cat := range p.DenyCategories {
+		add(&denied, p.representative(cat, VerdictDenied))
+	}
+	review := p.representative("", VerdictReview)
+
+	var fixtures []Fixture
+	seen := map[string]bool{}
+	emit := func(expression, reason string) {
+		if seen[expression] {
+			return
+		}
+		seen[expression] = true
+		want, err := p.Check(expression)
+		if err != nil {
+			return
+		}
+		fixtures = append(fixtures, Fixture{Expression: expression, Want: want, Reason: reason})
+	}
+
+	for _, a := range allowed {
+		emit(a, p.reason(a, "allowed"))
+	}
+	for _, d := range denied {
+		emit(d, p.reason(d, "denied"))
+	}
+	if len(allowed) > 0 {
+		a := allowed[0]
+		if len(allowed) > 1 {
+			emit(a+" AND "+allowed[1], "every AND operand allowed")
+		}
+		if len(exceptions) > 0 {
+			emit(a+" WITH "+exceptions[0], "exception doesn't change the verdict")
+		}
+		for _, d := range denied {
+			emit(a+" AND "+d, "one denied AND operand")
+			emit(a+" OR "+d, "allowed OR alternative")
+		}
+		if review != "" {
+			emit(a+" AND "+review, "one AND operand needs review")
+			emit(a+" OR "+review, "allowed OR alternative")
+		}
+	}
+	if review != "" {
+		emit(review, "not covered by the policy")
+		if len(denied) > 0 {
+			emit(denied[0]+" OR "+review, "best OR alternative needs review")
+			emit(denied[0]+" AND "+review, "one denied AND operand")
+		}
+	}
+	emit("LicenseRef-custom", "LicenseRef needs review")
+	emit("NOASSERTION", "no license asserted")
+	return fixtures
+}
+
+// representative returns the first embedded license with the verdict
+// want, in category cat if cat is set.
+func (p Policy) representative(cat spdx.Category, want Verdict) string {
+	for _, id := range licenseIDs {
+		if cat != "" && spdx.LicenseCategory(id) != cat {
+			continue
+		}
+		if p.license(id) == want {
+			return id
+		}
+	}
+	return ""
+}
+
+// reason explains a single-license verdict.
+func (p Policy) reason(id, verdict string) string {
+	cat := spdx.LicenseCategory(id)
+	listed, opposed := p.Allow, p.DenyCategories
+	if verdict == "denied" {
+		listed, opposed = p.Deny, p.AllowCategories
+	}
+	if slices.ContainsFunc(listed, func(s string) bool { return strings.EqualFold(s, id) }) {
+		if slices.Contains(opposed, cat) {
+			return fmt.Sprintf("%s by identifier, overriding category %s", verdict, cat)
+		}
+		return verdict + " by identifier"
+	}
+	return fmt.Sprintf("%s by category %s", verdict, cat)
+}
+
+// canonical returns id in its canonical case, or id itself if unknown.
+func canonical(id string) string {
+	if parsed, err := spdx.ParseID(id); err == nil {
+		return parsed.String()
+	}
+	return id
+}
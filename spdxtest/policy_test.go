@@ -0,0 +1,95 @@
+package spdxtest
+
+import (
+	"testing"
+
+	"github.com/git-pkgs/spdx"
+)
+
+func TestPolicyCheck(t *testing.T) {
+	p := Policy{
+		Allow:           []string{"lgpl-2.1-only"},
+		Deny:            []string{"WTFPL"},
+		AllowCategories: []spdx.Category{spdx.CategoryPermissive},
+		DenyCategories:  []spdx.Category{spdx.CategoryCopyleft, spdx.CategoryCopyleftLimited},
+	}
+
+	tests := []struct {
+		expression string
+		want       Verdict
+	}{
+		{"MIT", VerdictAllowed},
+		{"GPL-3.0-only", VerdictDenied},
+		{"LGPL-2.1-only", VerdictAllowed}, // identifier beats category
+		{"WTFPL", VerdictDenied},
+		{"MIT AND GPL-3.0-only", VerdictDenied},
+		{"MIT OR GPL-3.0-only", VerdictAllowed},
+		{"GPL-3.0-only OR LicenseRef-x", VerdictReview},
+		{"MIT AND LicenseRef-x", VerdictReview},
+		{"NOASSERTION", VerdictReview},
+	}
+
+	for _, tt := range tests {
+		got, err := p.Check(tt.expression)
+		if err != nil || got != tt.want {
+			t.Errorf("Check(%q) = %q, %v; want %q", tt.expression, got, err, tt.want)
+		}
+	}
+
+	if _, err := p.Check("Apache 2"); err == nil {
+		t.Error("Check should take strict expressions only")
+	}
+}
+
+func TestPolicyFixtures(t *testing.T) {
+	p := Policy{
+		Allow:           []string{"lgpl-2.1-only"},
+		AllowCategories: []spdx.Category{spdx.CategoryPermissive},
+		DenyCategories:  []spdx.Category{spdx.CategoryCopyleft, spdx.CategoryCopyleftLimited},
+	}
+	fixtures := PolicyFixtures(p)
+
+	counts := map[Verdict]int{}
+	seen := map[string]bool{}
+	for _, f := range fixtures {
+		if seen[f.Expression] {
+			t.Errorf("duplicate fixture %q", f.Expression)
+		}
+		seen[f.Expression] = true
+		counts[f.Want]++
+
+		if f.Reason == "" {
+			t.Errorf("fixture %q has no reason", f.Expression)
+		}
+		if got, err := p.Check(f.Expression); err != nil || got != f.Want {
+			t.Errorf("Check(%q) = %q, %v; fixture wants %q", f.Expression, got, err, f.Want)
+		}
+	}
+	for _, v := range []Verdict{VerdictAllowed, VerdictDenied, VerdictReview} {
+		if counts[v] == 0 {
+			t.Errorf("no %s fixtures in %+v", v, fixtures)
+		}
+	}
+
+	if !seen["LGPL-2.1-only"] {
+		t.Error("fixtures should include the allowed identifier in canonical case")
+	}
+	for _, f := range fixtures {
+		if f.Expression == "LGPL-2.1-only" && f.Reason != "allowed by identifier, overriding category Copyleft Limited" {
+			t.Errorf("reason = %q", f.Reason)
+		}
+	}
+
+	again := PolicyFixtures(p)
+	if len(again) != len(fixtures) || again[0] != fixtures[0] {
+		t.Error("PolicyFixtures should be deterministic")
+	}
+}
+
+func TestPolicyFixturesEmptyPolicy(t *testing.T) {
+	for _, f := range PolicyFixtures(Policy{}) {
+		if f.Want != VerdictReview {
+			t.Errorf("fixture %q wants %q; an empty policy reviews everything", f.Expression, f.Want)
+		}
+	}
+}
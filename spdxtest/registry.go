@@ -0,0 +1,139 @@
+package spdxtest
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/git-pkgs/spdx"
+)
+
+// registryLicenses is the tiny license list NewRegistry installs: a few
+// common licenses in each category, the GPL family with its deprecated
+// identifiers, and one exception, with their scancode keys.
+var registryLicenses = []struct {
+	id         string
+	key        string
+	category   spdx.Category
+	deprecated bool
+	exception  bool
+}{
+	{"MIT", "mit", spdx.CategoryPermissive, false, false},
+	{"Apache-2.0", "apache-2.0", spdx.CategoryPermissive, false, false},
+	{"BSD-3-Clause", "bsd-new", spdx.CategoryPermissive, false, false},
+	{"ISC", "isc", spdx.CategoryPermissive, false, false},
+	{"MPL-2.0", "mpl-2.0", spdx.CategoryCopyleftLimited, false, false},
+	{"LGPL-2.1-only", "lgpl-2.1", spdx.CategoryCopyleftLimited, false, false},
+	{"LGPL-2.1-or-later", "lgpl-2.1-plus", spdx.CategoryCopyleftLimited, false, false},
+	{"GPL-2.0-only", "gpl-2.0", spdx.CategoryCopyleft, false, false},
+	{"GPL-2.0-or-later", "gpl-2.0-plus", spdx.CategoryCopyleft, false, false},
+	{"GPL-3.0-only", "gpl-3.0", spdx.CategoryCopyleft, false, false},
+	{"GPL-3.0-or-later", "gpl-3.0-plus", spdx.CategoryCopyleft, false, false},
+	{"CC0-1.0", "cc0-1.0", spdx.CategoryPublicDomain, false, false},
+	{"LGPL-2.1", "", "", true, false},
+	{"LGPL-2.1+", "", "", true, false},
+	{"GPL-2.0", "", "", true, false},
+	{"GPL-2.0+", "", "", true, false},
+	{"GPL-3.0", "", "", true, false},
+	{"GPL-3.0+", "", "", true, false},
+	{"Classpath-exception-2.0", "classpath-exception-2.0", spdx.CategoryCopyleftLimited, false, true},
+}
+
+// RegistryData returns the license data NewRegistry installs. It is the
+// same on every call and does not change with the embedded SPDX list.
+func RegistryData() spdx.LicenseData {
+	data := spdx.LicenseData{
+		Licenses:   []string{},
+		Deprecated: []string{},
+		Exceptions: []string{},
+		Source:     "spdxtest",
+	}
+
+	var scancode strings.Builder
+	scancode.WriteString("[")
+	for _, l := range registryLicenses {
+		switch {
+		case l.exception:
+			data.Exceptions = append(data.Exceptions, l.id)
+		case l.deprecated:
+			data.Deprecated = append(data.Deprecated, l.id)
+		default:
+			data.Licenses = append(data.Licenses, l.id)
+		}
+		if l.key == "" {
+			continue
+		}
+		if scancode.Len() > 1 {
+			scancode.WriteString(",")
+		}
+		scancode.WriteString(`{"license_key":"` + l.key + `","category":"` + string(l.category) +
+			`","spdx_license_key":"` + l.id + `","other_spdx_license_keys":[],"is_exception":`)
+		if l.exception {
+			scancode.WriteString("true")
+		} else {
+			scancode.WriteString("false")
+		}
+		scancode.WriteString(`,"is_deprecated":false}`)
+	}
+	scancode.WriteString("]")
+	data.Scancode = []byte(scancode.String())
+	return data
+}
+
+var (
+	registryMu    sync.Mutex // held by the test that installed the registry
+	registryState sync.Mutex // guards registryOwner
+	registryOwner string
+)
+
+// NewRegistry replaces the package's license data with RegistryData for
+// the rest of the test, so unit tests of code built on this package run
+// fast and don't depend on the version of the embedded SPDX list. The
+// previous data comes back when the test finishes.
+//
+// The license data is process-wide, so tests that call NewRegistry run
+// one at a time: a parallel test that calls it waits until the test
+// holding the registry finishes. Subtests of that test share it. Tests
+// that don't call NewRegistry but run in parallel with one that does see
+// the tiny list.
+//
+// Example:
+//
+//	func TestPolicy(t *testing.T) {
+//		spdxtest.NewRegistry(t)
+//		if spdx.ValidLicense("0BSD") {
+//			t.Fatal("0BSD is not in the test registry")
+//		}
+//	}
+func NewRegistry(t testing.TB) spdx.LicenseData {
+	t.Helper()
+	data := RegistryData()
+
+	registryState.Lock()
+	owner := registryOwner
+	registryState.Unlock()
+	if owner != "" && strings.HasPrefix(t.Name(), owner+"/") {
+		return data
+	}
+
+	registryMu.Lock()
+	prev := spdx.CurrentLicenseData()
+	if err := spdx.Reload(data); err != nil {
+		registryMu.Unlock()
+		t.Fatalf("spdxtest: install registry: %v", err)
+	}
+	registryState.Lock()
+	registryOwner = t.Name()
+	registryState.Unlock()
+
+	t.Cleanup(func() {
+		registryState.Lock()
+		registryOwner = ""
+		registryState.Unlock()
+		if err := spdx.Reload(prev); err != nil {
+			t.Errorf("spdxtest: restore license data: %v", err)
+		}
+		registryMu.Unlock()
+	})
+	return data
+}
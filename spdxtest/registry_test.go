@@ -0,0 +1,81 @@
+package spdxtest
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/git-pkgs/spdx"
+)
+
+func TestNewRegistry(t *testing.T) {
+	embedded := len(spdx.CurrentLicenseData().Licenses)
+
+	t.Run("installed", func(t *testing.T) {
+		data := NewRegistry(t)
+		if got := len(spdx.CurrentLicenseData().Licenses); got != len(data.Licenses) {
+			t.Fatalf("%d licenses installed, want %d", got, len(data.Licenses))
+		}
+
+		tests := []struct {
+			input string
+			want  string
+		}{
+			{"MIT", "MIT"},
+			{"Apache 2", "Apache-2.0"},
+			{"GPL-2.0+", "GPL-2.0-or-later"},
+			{"GPL-2.0-or-later WITH Classpath-exception-2.0", "GPL-2.0-or-later WITH Classpath-exception-2.0"},
+		}
+		for _, tt := range tests {
+			if got, err := spdx.NormalizeExpression(tt.input); err != nil || got != tt.want {
+				t.Errorf("NormalizeExpression(%q) = %q, %v; want %q", tt.input, got, err, tt.want)
+			}
+		}
+		if spdx.ValidLicense("0BSD") {
+			t.Error("0BSD should not be in the test registry")
+		}
+		if got := spdx.LicenseCategory("GPL-3.0-only"); got != spdx.CategoryCopyleft {
+			t.Errorf("LicenseCategory(GPL-3.0-only) = %q, want %q", got, spdx.CategoryCopyleft)
+		}
+
+		t.Run("subtest", func(t *testing.T) {
+			NewRegistry(t) // shares the parent's registry instead of waiting for it
+			if spdx.ValidLicense("0BSD") {
+				t.Error("0BSD should not be in the test registry")
+			}
+		})
+	})
+
+	if got := len(spdx.CurrentLicenseData().Licenses); got != embedded {
+		t.Errorf("%d licenses after the test, want the %d embedded ones restored", got, embedded)
+	}
+}
+
+func TestNewRegistryParallel(t *testing.T) {
+	var mu sync.Mutex
+	active := 0
+	for _, name := range []string{"a", "b", "c"} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			NewRegistry(t)
+			mu.Lock()
+			active++
+			if active > 1 {
+				t.Error("two tests hold the registry at once")
+			}
+			mu.Unlock()
+			if !spdx.ValidLicense("MIT") || spdx.ValidLicense("0BSD") {
+				t.Error("test registry not installed")
+			}
+			mu.Lock()
+			active--
+			mu.Unlock()
+		})
+	}
+}
+
+func TestRegistryDataDeterministic(t *testing.T) {
+	a, b := RegistryData(), RegistryData()
+	if a.Digest() != b.Digest() {
+		t.Error("RegistryData differs between calls")
+	}
+}
@@ -0,0 +1,173 @@
+// Package spdxtest provides property-based testing helpers for code built
+// on github.com/git-pkgs/spdx: a generator of random valid expressions and
+// invariant checks that should hold for any of them.
+//
+// Example:
+//
+//	g := spdxtest.NewGenerator(1)
+//	for i := 0; i < 1000; i++ {
+//		expr := g.Expression()
+//		if err := spdxtest.CheckRoundTrip(expr); err != nil {
+//			t.Fatal(err)
+//		}
+//	}
+package spdxtest
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/git-pkgs/spdx"
+)
+
+var (
+	idsOnce    sync.Once
+	licenseIDs []string
+	exceptions []string
+)
+
+// loadIDs collects SPDX license and exception identifiers from the
+// embedded dataset, keeping only those the strict parser accepts.
+func loadIDs() {
+	idsOnce.Do(func() {
+		for _, info := range spdx.ListLicenses() {
+			id := info.SPDXKey
+			if id == "" || strings.HasPrefix(id, "LicenseRef-") {
+				continue
+			}
+			if info.IsException {
+				if _, err := spdx.ParseStrict("MIT WITH " + id); err == nil {
+					exceptions = append(exceptions, id)
+				}
+				continue
+			}
+			if spdx.ValidLicense(id) {
+				licenseIDs = append(licenseIDs, id)
+			}
+		}
+		slices.Sort(licenseIDs)
+		slices.Sort(exceptions)
+	})
+}
+
+// Generator produces random valid SPDX expressions. The same seed always
+// produces the same sequence. A Generator is not safe for concurrent use.
+type Generator struct {
+	// MaxDepth bounds the nesting of AND/OR operators. Zero generates
+	// single licenses only.
+	MaxDepth int
+	// ExceptionRate is the probability that a license gets a WITH exception.
+	ExceptionRate float64
+	// RefRate is the probability that a leaf is a LicenseRef.
+	RefRate float64
+	// PlusRate is the probability that a license gets the + operator.
+	PlusRate float64
+
+	rnd *rand.Rand
+}
+
+// NewGenerator returns a Generator seeded with seed and default settings.
+func NewGenerator(seed uint64) *Generator {
+	loadIDs()
+	return &Generator{
+		MaxDepth:      3,
+		ExceptionRate: 0.1,
+		RefRate:       0.05,
+		PlusRate:      0.05,
+		rnd:           rand.New(rand.NewPCG(seed, seed)),
+	}
+}
+
+// LicenseID returns a random SPDX license identifier.
+func (g *Generator) LicenseID() string {
+	return licenseIDs[g.rnd.IntN(len(licenseIDs))]
+}
+
+// ExceptionID returns a random SPDX exception identifier.
+func (g *Generator) ExceptionID() string {
+	return exceptions[g.rnd.IntN(len(exceptions))]
+}
+
+// Expression returns a random valid expression string. Operands are
+// parenthesized at random, so the output is not always canonical.
+func (g *Generator) Expression() string {
+	return g.expression(g.MaxDepth)
+}
+
+func (g *Generator) expression(depth int) string {
+	if depth <= 0 || g.rnd.IntN(3) == 0 {
+		return g.leaf()
+	}
+
+	op := " AND "
+	if g.rnd.IntN(2) == 0 {
+		op = " OR "
+	}
+	left := g.expression(depth - 1)
+	right := g.expression(depth - 1)
+	if g.rnd.IntN(2) == 0 {
+		left = "(" + left + ")"
+	}
+	if g.rnd.IntN(2) == 0 {
+		right = "(" + right + ")"
+	}
+	return left + op + right
+}
+
+func (g *Generator) leaf() string {
+	if g.rnd.Float64() < g.RefRate {
+		return fmt.Sprintf("LicenseRef-test-%d", g.rnd.IntN(100))
+	}
+
+	s := g.LicenseID()
+	if g.rnd.Float64() < g.PlusRate {
+		s += "+"
+	}
+	if len(exceptions) > 0 && g.rnd.Float64() < g.ExceptionRate {
+		s += " WITH " + g.ExceptionID()
+	}
+	return s
+}
+
+// CheckRoundTrip verifies that expr parses, and that parsing its String()
+// gives back the same String() and the same licenses.
+func CheckRoundTrip(expr string) error {
+	first, err := spdx.ParseStrict(expr)
+	if err != nil {
+		return fmt.Errorf("parse %q: %w", expr, err)
+	}
+
+	canonical := first.String()
+	second, err := spdx.ParseStrict(canonical)
+	if err != nil {
+		return fmt.Errorf("reparse %q (from %q): %w", canonical, expr, err)
+	}
+
+	if got := second.String(); got != canonical {
+		return fmt.Errorf("round trip of %q: %q != %q", expr, got, canonical)
+	}
+	if !slices.Equal(first.Licenses(), second.Licenses()) {
+		return fmt.Errorf("round trip of %q changed licenses: %v != %v", expr, second.Licenses(), first.Licenses())
+	}
+	return nil
+}
+
+// CheckNormalizeIdempotent verifies that normalizing an already
+// normalized expression does not change it.
+func CheckNormalizeIdempotent(expr string) error {
+	once, err := spdx.NormalizeExpression(expr)
+	if err != nil {
+		return fmt.Errorf("normalize %q: %w", expr, err)
+	}
+	twice, err := spdx.NormalizeExpression(once)
+	if err != nil {
+		return fmt.Errorf("normalize %q (from %q): %w", once, expr, err)
+	}
+	if once != twice {
+		return fmt.Errorf("normalize of %q not idempotent: %q != %q", expr, twice, once)
+	}
+	return nil
+}
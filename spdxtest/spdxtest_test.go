@@ -0,0 +1,50 @@
+package spdxtest
+
+import "testing"
+
+func TestGeneratedExpressionsRoundTrip(t *testing.T) {
+	g := NewGenerator(42)
+	for i := 0; i < 500; i++ {
+		expr := g.Expression()
+		if err := CheckRoundTrip(expr); err != nil {
+			t.Fatal(err)
+		}
+		if err := CheckNormalizeIdempotent(expr); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestGeneratorDeterministic(t *testing.T) {
+	a, b := NewGenerator(7), NewGenerator(7)
+	for i := 0; i < 20; i++ {
+		if x, y := a.Expression(), b.Expression(); x != y {
+			t.Fatalf("same seed produced %q and %q", x, y)
+		}
+	}
+}
+
+func TestGeneratorMaxDepthZero(t *testing.T) {
+	g := NewGenerator(1)
+	g.MaxDepth = 0
+	g.ExceptionRate = 0
+	g.RefRate = 0
+	g.PlusRate = 0
+	for i := 0; i < 20; i++ {
+		expr := g.Expression()
+		if err := CheckRoundTrip(expr); err != nil {
+			t.Fatal(err)
+		}
+		for _, c := range expr {
+			if c == ' ' {
+				t.Fatalf("MaxDepth 0 produced compound expression %q", expr)
+			}
+		}
+	}
+}
+
+func TestCheckRoundTripRejectsInvalid(t *testing.T) {
+	if err := CheckRoundTrip("MIT AND"); err == nil {
+		t.Error("CheckRoundTrip should fail for invalid input")
+	}
+}
@@ -0,0 +1,69 @@
+package spdx
+
+import "strings"
+
+// candidateBullets are list markers stripped from the start of a candidate.
+var candidateBullets = []string{"- ", "* ", "• ", "+ "}
+
+// SplitCandidates splits a blob of scraped license metadata into candidate
+// license strings. Candidates are separated by newlines or ";". List
+// bullets and surrounding whitespace are removed and empty candidates are
+// dropped. The candidates are not normalized.
+//
+// Example:
+//
+//	SplitCandidates("MIT License\n- Apache 2.0; BSD")
+//	// ["MIT License", "Apache 2.0", "BSD"]
+func SplitCandidates(s string) []string {
+	var candidates []string
+	for _, line := range strings.FieldsFunc(s, func(r rune) bool {
+		return r == '\n' || r == '\r' || r == ';'
+	}) {
+		line = strings.TrimSpace(line)
+		for _, bullet := range candidateBullets {
+			line = strings.TrimSpace(strings.TrimPrefix(line, bullet))
+		}
+		if line != "" {
+			candidates = append(candidates, line)
+		}
+	}
+	return candidates
+}
+
+// NormalizeCandidates splits s with SplitCandidates, normalizes each
+// candidate as an expression, and joins the results with op. Use
+// OperatorAnd when the blob lists licenses that all apply, and OperatorOr
+// when it lists alternatives. Candidates that do not normalize are
+// returned in unrecognized rather than failing the whole blob. The error
+// is ErrEmptyExpression when s has no candidates and ErrInvalidLicense
+// when none of them normalize.
+//
+// Example:
+//
+//	NormalizeCandidates("MIT License\nApache 2.0\nsee COPYING", OperatorAnd)
+//	// "MIT AND Apache-2.0", ["see COPYING"], nil
+func NormalizeCandidates(s string, op Operator) (expr string, unrecognized []string, err error) {
+	candidates := SplitCandidates(s)
+	if len(candidates) == 0 {
+		return "", nil, ErrEmptyExpression
+	}
+
+	var normalized []string
+	for _, c := range candidates {
+		e, err := Parse(c)
+		if err != nil {
+			unrecognized = append(unrecognized, c)
+			continue
+		}
+		normalized = append(normalized, e.String())
+	}
+	if len(normalized) == 0 {
+		return "", unrecognized, ErrInvalidLicense
+	}
+
+	expr, err = Combine(normalized, op)
+	if err != nil {
+		return "", unrecognized, err
+	}
+	return expr, unrecognized, nil
+}
@@ -0,0 +1,68 @@
+package spdx
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestSplitCandidates(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{"MIT", []string{"MIT"}},
+		{"MIT License\nApache 2.0", []string{"MIT License", "Apache 2.0"}},
+		{"MIT; Apache 2.0;", []string{"MIT", "Apache 2.0"}},
+		{"- MIT\r\n* BSD 3-Clause\n\n• ISC", []string{"MIT", "BSD 3-Clause", "ISC"}},
+		{"Apache License, Version 2.0", []string{"Apache License, Version 2.0"}},
+		{"  \n ; ", nil},
+	}
+
+	for _, tt := range tests {
+		if got := SplitCandidates(tt.input); !slices.Equal(got, tt.want) {
+			t.Errorf("SplitCandidates(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeCandidates(t *testing.T) {
+	tests := []struct {
+		input        string
+		op           Operator
+		want         string
+		unrecognized []string
+	}{
+		{"MIT License\nApache 2.0", OperatorAnd, "MIT AND Apache-2.0", nil},
+		{"MIT License\nApache 2.0", OperatorOr, "MIT OR Apache-2.0", nil},
+		{"MIT; mit; MIT License", OperatorAnd, "MIT", nil},
+		{"GPL v2 OR MIT\nBSD 3-Clause", OperatorAnd, "(GPL-2.0-only OR MIT) AND BSD-3-Clause", nil},
+		{"MIT\nFAKEYLICENSE", OperatorAnd, "MIT", []string{"FAKEYLICENSE"}},
+		{"MIT License\nApache 2.0\nsee COPYING", OperatorAnd, "MIT AND Apache-2.0", []string{"see COPYING"}},
+	}
+
+	for _, tt := range tests {
+		got, unrecognized, err := NormalizeCandidates(tt.input, tt.op)
+		if err != nil {
+			t.Errorf("NormalizeCandidates(%q) error = %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("NormalizeCandidates(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+		if !slices.Equal(unrecognized, tt.unrecognized) {
+			t.Errorf("NormalizeCandidates(%q) unrecognized = %q, want %q", tt.input, unrecognized, tt.unrecognized)
+		}
+	}
+
+	if _, _, err := NormalizeCandidates(" \n ", OperatorAnd); !errors.Is(err, ErrEmptyExpression) {
+		t.Errorf("empty blob error = %v, want ErrEmptyExpression", err)
+	}
+	_, unrecognized, err := NormalizeCandidates("FAKEYLICENSE\nNOT-A-LICENSE", OperatorAnd)
+	if !errors.Is(err, ErrInvalidLicense) {
+		t.Errorf("all invalid error = %v, want ErrInvalidLicense", err)
+	}
+	if len(unrecognized) != 2 {
+		t.Errorf("unrecognized = %q, want both candidates", unrecognized)
+	}
+}
@@ -0,0 +1,41 @@
+package spdx
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Value implements driver.Valuer, storing the normalized expression
+// string. An empty ExpressionValue is stored as NULL.
+//
+// Example:
+//
+//	db.Exec("INSERT INTO packages (name, license) VALUES ($1, $2)", name, v)
+func (v ExpressionValue) Value() (driver.Value, error) {
+	if v.Expression == nil {
+		return nil, nil
+	}
+	return v.Expression.String(), nil
+}
+
+// Scan implements sql.Scanner, parsing the stored string with Parse so
+// that invalid rows fail at the database boundary. NULL and empty
+// strings clear the value.
+//
+// Example:
+//
+//	var v ExpressionValue
+//	err := db.QueryRow("SELECT license FROM packages WHERE name = $1", name).Scan(&v)
+func (v *ExpressionValue) Scan(src any) error {
+	switch s := src.(type) {
+	case nil:
+		v.Expression = nil
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(s))
+	case []byte:
+		return v.UnmarshalText(s)
+	default:
+		return fmt.Errorf("cannot scan %T into ExpressionValue", src)
+	}
+}
@@ -0,0 +1,54 @@
+package spdx
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+)
+
+var (
+	_ driver.Valuer = ExpressionValue{}
+	_ sql.Scanner   = (*ExpressionValue)(nil)
+)
+
+func TestExpressionValueValue(t *testing.T) {
+	var v ExpressionValue
+	if got, err := v.Value(); got != nil || err != nil {
+		t.Errorf("zero Value() = %v, %v; want nil, nil", got, err)
+	}
+
+	if err := v.Set("apache 2 or mit"); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := v.Value(); got != "Apache-2.0 OR MIT" || err != nil {
+		t.Errorf("Value() = %v, %v", got, err)
+	}
+}
+
+func TestExpressionValueScan(t *testing.T) {
+	tests := []struct {
+		src  any
+		want string
+		err  error
+	}{
+		{"MIT OR Apache-2.0", "MIT OR Apache-2.0", nil},
+		{[]byte("gpl v3"), "GPL-3.0-or-later", nil},
+		{nil, "", nil},
+		{"", "", nil},
+		{"MIT AND", "", ErrMissingOperand},
+	}
+
+	for _, tt := range tests {
+		var v ExpressionValue
+		err := v.Scan(tt.src)
+		if v.String() != tt.want || !errors.Is(err, tt.err) {
+			t.Errorf("Scan(%v) = %q, %v; want %q, %v", tt.src, v.String(), err, tt.want, tt.err)
+		}
+	}
+
+	var v ExpressionValue
+	if err := v.Scan(42); err == nil {
+		t.Error("Scan(int) should fail")
+	}
+}
@@ -0,0 +1,108 @@
+package spdx
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+//go:embed templates/*.txt
+var templateFS embed.FS
+
+// Template errors
+var (
+	ErrNoTemplate           = errors.New("no license template available")
+	ErrMissingTemplateField = errors.New("missing template field")
+)
+
+// reTemplateField matches a <<field>> placeholder in a license template.
+var reTemplateField = regexp.MustCompile(`<<([^<>]+)>>`)
+
+// loadTemplate returns the template text for a license identifier.
+func loadTemplate(id string) (string, error) {
+	canonical := lookupLicense(id)
+	if canonical == "" {
+		return "", fmt.Errorf("%w: %s", ErrInvalidLicenseID, id)
+	}
+	data, err := templateFS.ReadFile("templates/" + canonical + ".txt")
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrNoTemplate, canonical)
+	}
+	return string(data), nil
+}
+
+// TemplateLicenses returns the license identifiers that have a template
+// for GenerateLicenseText, sorted alphabetically.
+func TemplateLicenses() []string {
+	entries, _ := templateFS.ReadDir("templates")
+	ids := make([]string, 0, len(entries))
+	for _, e := range entries {
+		ids = append(ids, strings.TrimSuffix(e.Name(), ".txt"))
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// TemplateFields returns the parameter names a license template needs,
+// in the order they first appear in the text.
+//
+// Example:
+//
+//	TemplateFields("MIT")  // ["year", "copyright holder"], nil
+func TemplateFields(id string) ([]string, error) {
+	tmpl, err := loadTemplate(id)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var fields []string
+	for _, m := range reTemplateField.FindAllStringSubmatch(tmpl, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			fields = append(fields, m[1])
+		}
+	}
+	return fields, nil
+}
+
+// GenerateLicenseText fills the license template for id with params to
+// produce the text of a LICENSE file. Every field returned by
+// TemplateFields must be present in params; otherwise an error wrapping
+// ErrMissingTemplateField names the missing ones. Extra params are ignored.
+//
+// Templates are available for the licenses returned by TemplateLicenses.
+// Other valid identifiers return an error wrapping ErrNoTemplate.
+//
+// Example:
+//
+//	GenerateLicenseText("MIT", map[string]string{
+//		"year":             "2024",
+//		"copyright holder": "Example Corp",
+//	})
+//	// "MIT License\n\nCopyright (c) 2024 Example Corp\n..."
+func GenerateLicenseText(id string, params map[string]string) (string, error) {
+	tmpl, err := loadTemplate(id)
+	if err != nil {
+		return "", err
+	}
+
+	var missing []string
+	seen := make(map[string]bool)
+	for _, m := range reTemplateField.FindAllStringSubmatch(tmpl, -1) {
+		if _, ok := params[m[1]]; !ok && !seen[m[1]] {
+			missing = append(missing, m[1])
+		}
+		seen[m[1]] = true
+	}
+	if len(missing) > 0 {
+		return "", fmt.Errorf("%w: %s", ErrMissingTemplateField, strings.Join(missing, ", "))
+	}
+
+	return reTemplateField.ReplaceAllStringFunc(tmpl, func(field string) string {
+		return params[field[2:len(field)-2]]
+	}), nil
+}
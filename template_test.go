@@ -0,0 +1,71 @@
+package spdx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestGenerateLicenseText(t *testing.T) {
+	params := map[string]string{
+		"year":             "2024",
+		"copyright holder": "Example Corp",
+	}
+
+	for _, id := range TemplateLicenses() {
+		t.Run(id, func(t *testing.T) {
+			text, err := GenerateLicenseText(id, params)
+			if err != nil {
+				t.Fatalf("GenerateLicenseText(%q) error: %v", id, err)
+			}
+			if !strings.Contains(text, "2024") || !strings.Contains(text, "Example Corp") {
+				t.Errorf("GenerateLicenseText(%q) did not fill fields:\n%s", id, text)
+			}
+			if strings.Contains(text, "<<") {
+				t.Errorf("GenerateLicenseText(%q) left placeholders:\n%s", id, text)
+			}
+		})
+	}
+
+	text, err := GenerateLicenseText("mit", params)
+	if err != nil {
+		t.Fatalf("GenerateLicenseText(mit) error: %v", err)
+	}
+	if !strings.HasPrefix(text, "MIT License\n\nCopyright (c) 2024 Example Corp\n") {
+		t.Errorf("unexpected MIT text:\n%s", text)
+	}
+}
+
+func TestGenerateLicenseTextErrors(t *testing.T) {
+	_, err := GenerateLicenseText("MIT", map[string]string{"year": "2024"})
+	if !errors.Is(err, ErrMissingTemplateField) {
+		t.Errorf("missing field error = %v, want ErrMissingTemplateField", err)
+	}
+	if err != nil && !strings.Contains(err.Error(), "copyright holder") {
+		t.Errorf("error %q should name the missing field", err)
+	}
+
+	if _, err := GenerateLicenseText("GPL-3.0-only", nil); !errors.Is(err, ErrNoTemplate) {
+		t.Errorf("no template error = %v, want ErrNoTemplate", err)
+	}
+
+	if _, err := GenerateLicenseText("FAKEYLICENSE", nil); !errors.Is(err, ErrInvalidLicenseID) {
+		t.Errorf("invalid id error = %v, want ErrInvalidLicenseID", err)
+	}
+}
+
+func TestTemplateFields(t *testing.T) {
+	fields, err := TemplateFields("BSD-3-Clause")
+	if err != nil {
+		t.Fatalf("TemplateFields error: %v", err)
+	}
+	want := []string{"year", "copyright holder"}
+	if len(fields) != len(want) {
+		t.Fatalf("TemplateFields = %v, want %v", fields, want)
+	}
+	for i := range want {
+		if fields[i] != want[i] {
+			t.Errorf("TemplateFields = %v, want %v", fields, want)
+		}
+	}
+}
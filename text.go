@@ -0,0 +1,82 @@
+package spdx
+
+// MarshalText implements encoding.TextMarshaler.
+func (id LicenseID) MarshalText() ([]byte, error) {
+	return []byte(id), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, validating the
+// identifier with ParseID. Empty text gives the zero LicenseID, so
+// optional config fields can be left out.
+func (id *LicenseID) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*id = ""
+		return nil
+	}
+	parsed, err := ParseID(string(text))
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
+
+// Set implements flag.Value.
+//
+// Example:
+//
+//	var id LicenseID
+//	flag.Var(&id, "license", "SPDX license identifier")
+func (id *LicenseID) Set(s string) error {
+	return id.UnmarshalText([]byte(s))
+}
+
+// ExpressionValue holds a parsed expression in a form that works with
+// encoding.TextMarshaler users, such as encoding/json and YAML libraries,
+// and with flag.Var. Decoding parses with Parse, so invalid expressions
+// fail at decode time and valid ones are stored normalized. The zero
+// value holds no expression and encodes as an empty string.
+//
+// Example:
+//
+//	var cfg struct {
+//		License ExpressionValue `json:"license"`
+//	}
+//	err := json.Unmarshal([]byte(`{"license": "Apache 2 OR mit"}`), &cfg)
+//	cfg.License.String()  // "Apache-2.0 OR MIT"
+type ExpressionValue struct {
+	Expression
+}
+
+// String returns the normalized expression, or "" if there is none.
+func (v ExpressionValue) String() string {
+	if v.Expression == nil {
+		return ""
+	}
+	return v.Expression.String()
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v ExpressionValue) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Empty text clears
+// the value.
+func (v *ExpressionValue) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		v.Expression = nil
+		return nil
+	}
+	expr, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	v.Expression = expr
+	return nil
+}
+
+// Set implements flag.Value.
+func (v *ExpressionValue) Set(s string) error {
+	return v.UnmarshalText([]byte(s))
+}
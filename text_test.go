@@ -0,0 +1,87 @@
+package spdx
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"testing"
+)
+
+func TestLicenseIDText(t *testing.T) {
+	var cfg struct {
+		License LicenseID `json:"license"`
+	}
+	if err := json.Unmarshal([]byte(`{"license": "apache-2.0"}`), &cfg); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.License != "Apache-2.0" {
+		t.Errorf("License = %q, want Apache-2.0", cfg.License)
+	}
+
+	out, err := json.Marshal(cfg)
+	if err != nil || string(out) != `{"license":"Apache-2.0"}` {
+		t.Errorf("Marshal = %s, %v", out, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"license": "Apache 2"}`), &cfg); !errors.Is(err, ErrInvalidLicenseID) {
+		t.Errorf("Unmarshal of informal name error = %v, want ErrInvalidLicenseID", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"license": ""}`), &cfg); err != nil || cfg.License != "" {
+		t.Errorf("Unmarshal of empty = %q, %v", cfg.License, err)
+	}
+}
+
+func TestExpressionValueText(t *testing.T) {
+	var cfg struct {
+		License ExpressionValue `json:"license"`
+	}
+	if err := json.Unmarshal([]byte(`{"license": "Apache 2 OR mit"}`), &cfg); err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.License.String(); got != "Apache-2.0 OR MIT" {
+		t.Errorf("License = %q", got)
+	}
+	if got := cfg.License.Licenses(); len(got) != 2 {
+		t.Errorf("Licenses() = %v, want the embedded expression's licenses", got)
+	}
+
+	out, err := json.Marshal(cfg)
+	if err != nil || string(out) != `{"license":"Apache-2.0 OR MIT"}` {
+		t.Errorf("Marshal = %s, %v", out, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"license": "MIT OR"}`), &cfg); !errors.Is(err, ErrMissingOperand) {
+		t.Errorf("Unmarshal of invalid expression error = %v, want ErrMissingOperand", err)
+	}
+
+	var empty ExpressionValue
+	if out, err := json.Marshal(empty); err != nil || string(out) != `""` {
+		t.Errorf("Marshal of zero value = %s, %v", out, err)
+	}
+	if err := empty.UnmarshalText(nil); err != nil || empty.Expression != nil {
+		t.Errorf("UnmarshalText(nil) = %v, %v", empty.Expression, err)
+	}
+}
+
+func TestFlagValues(t *testing.T) {
+	var id LicenseID
+	var expr ExpressionValue
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Var(&id, "id", "license ID")
+	fs.Var(&expr, "expr", "license expression")
+
+	if err := fs.Parse([]string{"-id", "mit", "-expr", "GPL v3 OR MIT"}); err != nil {
+		t.Fatal(err)
+	}
+	if id != "MIT" || expr.String() != "GPL-3.0-or-later OR MIT" {
+		t.Errorf("id = %q, expr = %q", id, expr)
+	}
+
+	if err := fs.Parse([]string{"-id", "not-a-license"}); err == nil {
+		t.Error("an invalid -id should fail flag parsing")
+	}
+}
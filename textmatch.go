@@ -0,0 +1,329 @@
+package spdx
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// DefaultTextThreshold is the share of a license template that must be
+// found in a text for a TextMatcher with no Threshold to report a match.
+const DefaultTextThreshold = 0.8
+
+// maxMatchWords bounds the words of a text that are compared with the
+// templates, to keep the comparison's memory in check. Longer texts are
+// compared by their first maxMatchWords words.
+const maxMatchWords = 10000
+
+// TextSpan is a part of a matched text, as byte offsets.
+type TextSpan struct {
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+	Text  string `json:"text"`
+}
+
+// TextMatch reports how a license text compares with the template of the
+// license it matched, so a reviewer can check a modified license instead
+// of trusting a bare identifier.
+type TextMatch struct {
+	License string `json:"license"`
+	// Coverage is the share of the template's words found in the text,
+	// in order. It is what TextMatcher.Threshold is compared with.
+	Coverage float64 `json:"coverage"`
+	// Score is the similarity of the text and the template, from 0 to 1,
+	// counting both words of the template missing from the text and
+	// extra words in the text. It is 1 for an unmodified license.
+	Score float64 `json:"score"`
+	// Matched are the parts of the text that follow the template.
+	Matched []TextSpan `json:"matched"`
+	// Extra are the parts of the text that are not in the template,
+	// other than the values of its fields, such as the copyright
+	// holder.
+	Extra []TextSpan `json:"extra"`
+
+	differences []Difference // for NearMatch
+}
+
+// TextMatcher matches the full text of a license, such as a LICENSE file,
+// against the license templates, comparing words and ignoring case,
+// punctuation and layout. Templates are available for the licenses
+// TemplateLicenses returns. The zero value is ready to use.
+type TextMatcher struct {
+	// Threshold is the Coverage a template needs to match. Zero uses
+	// DefaultTextThreshold.
+	Threshold float64
+}
+
+// MatchLicenseText matches text with a TextMatcher using the default
+// threshold.
+//
+// Example:
+//
+//	m, err := MatchLicenseText(mitText + "\n\"Commons Clause\" License Condition v1.0 ...")
+//	m.License   // "MIT"
+//	m.Coverage  // 1
+//	m.Score     // 0.85
+//	m.Extra     // [{Start: 1071, End: 1408, Text: "Commons Clause\" License Condition v1.0 ..."}]
+func MatchLicenseText(text string) (*TextMatch, error) {
+	var m TextMatcher
+	return m.Match(text)
+}
+
+// Match returns the template that best matches text. It fails with
+// ErrLowConfidence if no template reaches the threshold, and with
+// ErrInvalidLicense if text has no words.
+func (m *TextMatcher) Match(text string) (*TextMatch, error) {
+	words := textWords(text)
+	if len(words) == 0 {
+		return nil, fmt.Errorf("%w: no license text", ErrInvalidLicense)
+	}
+
+	threshold := m.Threshold
+	if threshold == 0 {
+		threshold = DefaultTextThreshold
+	}
+
+	var best *TextMatch
+	for _, tmpl := range licenseTemplates() {
+		match := tmpl.match(text, words)
+		if best == nil || match.Score > best.Score || (match.Score == best.Score && match.Coverage > best.Coverage) {
+			best = match
+		}
+	}
+	if best == nil || best.Coverage < threshold {
+		if best == nil {
+			return nil, fmt.Errorf("%w: no license templates", ErrLowConfidence)
+		}
+		return nil, fmt.Errorf("%w: closest template %s covers %.2f", ErrLowConfidence, best.License, best.Coverage)
+	}
+	return best, nil
+}
+
+// textWord is a word of a text with its byte offsets.
+type textWord struct {
+	word       string
+	start, end int
+}
+
+// wordVariants folds spellings the SPDX matching guidelines treat as
+// equivalent.
+var wordVariants = map[string]string{
+	"licence":   "license",
+	"licences":  "licenses",
+	"licenced":  "licensed",
+	"licencing": "licensing",
+	"©":         "c",
+}
+
+// textWords splits s into lower-case words of letters and digits, and the
+// copyright sign, with their offsets.
+func textWords(s string) []textWord {
+	var words []textWord
+	start := -1
+	flush := func(end int) {
+		if start < 0 {
+			return
+		}
+		w := strings.ToLower(s[start:end])
+		if v, ok := wordVariants[w]; ok {
+			w = v
+		}
+		words = append(words, textWord{w, start, end})
+		start = -1
+	}
+	for i, r := range s {
+		switch {
+		case r == '©':
+			flush(i)
+			start = i
+			flush(i + len("©"))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if start < 0 {
+				start = i
+			}
+		default:
+			flush(i)
+		}
+	}
+	flush(len(s))
+	return words
+}
+
+// licenseTemplate is a template split into words. A field is an empty
+// word, which matches nothing, so its value in a text is left between
+// matched words.
+type licenseTemplate struct {
+	id     string
+	text   string
+	words  []string
+	spans  [][2]int // byte offsets of each word in text
+	fields int      // number of field words
+}
+
+var (
+	templatesOnce sync.Once
+	templates     []*licenseTemplate
+)
+
+// licenseTemplates returns the embedded templates split into words.
+func licenseTemplates() []*licenseTemplate {
+	templatesOnce.Do(func() {
+		for _, id := range TemplateLicenses() {
+			data, err := templateFS.ReadFile("templates/" + id + ".txt")
+			if err != nil {
+				continue
+			}
+			t := &licenseTemplate{id: id, text: string(data)}
+			last := 0
+			addWords := func(end int) {
+				for _, w := range textWords(t.text[last:end]) {
+					t.words = append(t.words, w.word)
+					t.spans = append(t.spans, [2]int{last + w.start, last + w.end})
+				}
+			}
+			for _, loc := range reTemplateField.FindAllStringIndex(t.text, -1) {
+				addWords(loc[0])
+				t.words = append(t.words, "")
+				t.spans = append(t.spans, [2]int{loc[0], loc[1]})
+				t.fields++
+				last = loc[1]
+			}
+			addWords(len(t.text))
+			templates = append(templates, t)
+		}
+	})
+	return templates
+}
+
+// match aligns the words of a text with the template by their longest
+// common subsequence and reports the result.
+func (t *licenseTemplate) match(text string, words []textWord) *TextMatch {
+	if len(words) > maxMatchWords {
+		words = words[:maxMatchWords]
+	}
+	n, m := len(t.words), len(words)
+
+	// lcs[i][j] is the length of the longest common subsequence of
+	// t.words[i:] and words[j:]
+	lcs := make([][]int32, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			switch {
+			case t.words[i] != "" && t.words[i] == words[j].word:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	// tmplAt[j] is the template index word j matched, or -1
+	tmplAt := make([]int, m)
+	for i, j := 0, 0; j < m; {
+		switch {
+		case i < n && t.words[i] != "" && t.words[i] == words[j].word:
+			tmplAt[j] = i
+			i++
+			j++
+		case i < n && lcs[i+1][j] >= lcs[i][j+1]:
+			i++
+		default:
+			tmplAt[j] = -1
+			j++
+		}
+	}
+
+	compactMatches(tmplAt, words)
+
+	// Unmatched words between two matched words count as a field value
+	// if the template has a field between them
+	isField := func(from, to int) bool {
+		return slices.Contains(t.words[from:to], "")
+	}
+	match := &TextMatch{License: t.id, Matched: []TextSpan{}, Extra: []TextSpan{}}
+	matched, extra := 0, 0
+	prevTmpl := -1
+	for j := 0; j < m; {
+		k := j
+		if tmplAt[j] >= 0 {
+			for k < m && tmplAt[k] >= 0 && (k == j || tmplAt[k] == tmplAt[k-1]+1) {
+				k++
+			}
+			match.Matched = append(match.Matched, wordSpan(text, words[j:k]))
+			matched += k - j
+			prevTmpl = tmplAt[k-1]
+			j = k
+			continue
+		}
+		for k < m && tmplAt[k] < 0 {
+			k++
+		}
+		nextTmpl := n
+		if k < m {
+			nextTmpl = tmplAt[k]
+		}
+		if !isField(prevTmpl+1, nextTmpl) {
+			span := wordSpan(text, words[j:k])
+			match.Extra = append(match.Extra, span)
+			match.differences = append(match.differences, t.added(span, prevTmpl, nextTmpl))
+			extra += k - j
+		}
+		j = k
+	}
+
+	match.differences = append(match.differences, t.removed(tmplAt, words)...)
+	slices.SortStableFunc(match.differences, func(a, b Difference) int { return a.Offset - b.Offset })
+
+	total := n - t.fields
+	match.Coverage = float64(matched) / float64(total)
+	match.Score = 2 * float64(matched) / float64(total+matched+extra)
+	return match
+}
+
+// compactMatches moves lone matched words within unmatched text to the
+// edge of the run they are repeated at, where they join the matched words
+// around them. The alignment takes the first equal word it can, so in
+// "conditions: you may not use the software. The above copyright notice"
+// the inserted "the" would be matched in place of "The".
+func compactMatches(tmplAt []int, words []textWord) {
+	run := func(j int) bool { // word j continues or starts a run of matches
+		t := tmplAt[j]
+		return (j > 0 && tmplAt[j-1] >= 0 && tmplAt[j-1] == t-1) ||
+			(j+1 < len(tmplAt) && tmplAt[j+1] >= 0 && tmplAt[j+1] == t+1)
+	}
+	for j, t := range tmplAt {
+		if t < 0 || run(j) {
+			continue
+		}
+		// Slide forward to just before the next match, if that continues it
+		k := j + 1
+		for k < len(tmplAt) && tmplAt[k] < 0 {
+			k++
+		}
+		if k > j+1 && k < len(tmplAt) && tmplAt[k] == t+1 && words[k-1].word == words[j].word {
+			tmplAt[j], tmplAt[k-1] = -1, t
+			continue
+		}
+		// Slide back to just after the previous match, if that continues it
+		k = j - 1
+		for k >= 0 && tmplAt[k] < 0 {
+			k--
+		}
+		if k >= 0 && k < j-1 && tmplAt[k] == t-1 && words[k+1].word == words[j].word {
+			tmplAt[j], tmplAt[k+1] = -1, t
+		}
+	}
+}
+
+// wordSpan returns the span of text from the first to the last word.
+func wordSpan(text string, words []textWord) TextSpan {
+	start, end := words[0].start, words[len(words)-1].end
+	return TextSpan{Start: start, End: end, Text: text[start:end]}
+}
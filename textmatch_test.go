@@ -0,0 +1,165 @@
+package spdx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// commonsClause is the rider appended to licenses such as MIT to forbid
+// selling the software.
+const commonsClause = `"Commons Clause" License Condition v1.0
+
+The Software is provided to you by the Licensor under the License, as defined below, subject to the following condition.
+
+Without limiting other conditions in the License, the grant of rights under the License will not include, and the License does not grant to you, the right to Sell the Software.
+`
+
+func mustLicenseText(t *testing.T, id string) string {
+	t.Helper()
+	text, err := GenerateLicenseText(id, map[string]string{
+		"year":             "2024",
+		"copyright holder": "Example Corp",
+		"organization":     "Example Corp",
+	})
+	if err != nil {
+		t.Fatalf("GenerateLicenseText(%q) error: %v", id, err)
+	}
+	return text
+}
+
+func TestMatchLicenseText(t *testing.T) {
+	for _, id := range TemplateLicenses() {
+		t.Run(id, func(t *testing.T) {
+			m, err := MatchLicenseText(mustLicenseText(t, id))
+			if err != nil {
+				t.Fatalf("MatchLicenseText error: %v", err)
+			}
+			if m.License != id {
+				t.Errorf("License = %q, want %q", m.License, id)
+			}
+			if m.Score != 1 || m.Coverage != 1 {
+				t.Errorf("Score = %v, Coverage = %v, want 1, 1", m.Score, m.Coverage)
+			}
+			if len(m.Extra) != 0 {
+				t.Errorf("Extra = %v, want none", m.Extra)
+			}
+		})
+	}
+}
+
+func TestMatchLicenseTextReformatted(t *testing.T) {
+	text := strings.ToUpper(strings.Join(strings.Fields(mustLicenseText(t, "MIT")), " "))
+	text = strings.ReplaceAll(text, "(C)", "©")
+	m, err := MatchLicenseText(text)
+	if err != nil {
+		t.Fatalf("MatchLicenseText error: %v", err)
+	}
+	if m.License != "MIT" || m.Score != 1 {
+		t.Errorf("got %s with score %v, want MIT with score 1", m.License, m.Score)
+	}
+}
+
+func TestMatchLicenseTextExtra(t *testing.T) {
+	mit := mustLicenseText(t, "MIT")
+	text := mit + "\n" + commonsClause
+
+	m, err := MatchLicenseText(text)
+	if err != nil {
+		t.Fatalf("MatchLicenseText error: %v", err)
+	}
+	if m.License != "MIT" {
+		t.Errorf("License = %q, want MIT", m.License)
+	}
+	if m.Coverage != 1 {
+		t.Errorf("Coverage = %v, want 1", m.Coverage)
+	}
+	if m.Score >= 0.9 {
+		t.Errorf("Score = %v, want it lowered by the extra text", m.Score)
+	}
+	if len(m.Extra) != 1 {
+		t.Fatalf("Extra = %v, want one span", m.Extra)
+	}
+	extra := m.Extra[0]
+	if extra.Text != text[extra.Start:extra.End] {
+		t.Errorf("Extra text %q does not match its offsets", extra.Text)
+	}
+	if !strings.HasPrefix(extra.Text, "Commons Clause") || !strings.HasSuffix(extra.Text, "Sell the Software") {
+		t.Errorf("Extra = %q, want the Commons Clause", extra.Text)
+	}
+	if extra.Start < len(mit) {
+		t.Errorf("Extra starts at %d, inside the MIT text", extra.Start)
+	}
+	for _, span := range m.Matched {
+		if span.End > len(mit) {
+			t.Errorf("Matched span %q runs into the extra text", span.Text)
+		}
+	}
+}
+
+func TestMatchLicenseTextInserted(t *testing.T) {
+	mit := mustLicenseText(t, "MIT")
+	text := strings.Replace(mit, "The above copyright notice", "You may not use the Software for evil. The above copyright notice", 1)
+
+	m, err := MatchLicenseText(text)
+	if err != nil {
+		t.Fatalf("MatchLicenseText error: %v", err)
+	}
+	if len(m.Extra) != 1 || m.Extra[0].Text != "You may not use the Software for evil" {
+		t.Errorf("Extra = %v, want the inserted sentence", m.Extra)
+	}
+	plain, _ := MatchLicenseText(mit)
+	if len(m.Matched) != len(plain.Matched)+1 {
+		t.Errorf("Matched = %d spans, want %d: the insertion splits one", len(m.Matched), len(plain.Matched)+1)
+	}
+}
+
+func TestMatchLicenseTextFields(t *testing.T) {
+	text := strings.Replace(mustLicenseText(t, "MIT"), "2024 Example Corp", "2019-2024 The Example Project Authors and contributors", 1)
+	m, err := MatchLicenseText(text)
+	if err != nil {
+		t.Fatalf("MatchLicenseText error: %v", err)
+	}
+	if len(m.Extra) != 0 || m.Score != 1 {
+		t.Errorf("field values counted as extra text: Score = %v, Extra = %v", m.Score, m.Extra)
+	}
+}
+
+func TestMatchLicenseTextClosest(t *testing.T) {
+	// 0BSD is ISC without the notice condition, so each text is close to
+	// both templates
+	for _, id := range []string{"ISC", "0BSD"} {
+		m, err := MatchLicenseText(mustLicenseText(t, id))
+		if err != nil {
+			t.Fatalf("MatchLicenseText(%s) error: %v", id, err)
+		}
+		if m.License != id {
+			t.Errorf("MatchLicenseText(%s) = %s", id, m.License)
+		}
+	}
+}
+
+func TestTextMatcherThreshold(t *testing.T) {
+	mit := mustLicenseText(t, "MIT")
+	half := mit[:len(mit)/2]
+
+	if _, err := MatchLicenseText(half); !errors.Is(err, ErrLowConfidence) {
+		t.Errorf("half a license error = %v, want ErrLowConfidence", err)
+	}
+
+	m := TextMatcher{Threshold: 0.4}
+	got, err := m.Match(half)
+	if err != nil {
+		t.Fatalf("Match with lower threshold error: %v", err)
+	}
+	if got.License != "MIT" || got.Coverage >= DefaultTextThreshold {
+		t.Errorf("got %s with coverage %v", got.License, got.Coverage)
+	}
+
+	if _, err := MatchLicenseText("This is not a license."); !errors.Is(err, ErrLowConfidence) {
+		t.Errorf("unrelated text error = %v, want ErrLowConfidence", err)
+	}
+	if _, err := MatchLicenseText(" \n\t"); !errors.Is(err, ErrInvalidLicense) {
+		t.Errorf("empty text error = %v, want ErrInvalidLicense", err)
+	}
+}
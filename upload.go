@@ -0,0 +1,151 @@
+package spdx
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// PackageRegistry names a package registry whose rules for the license
+// field ValidateForRegistry applies.
+type PackageRegistry string
+
+const (
+	// RegistryNPM is npm, which checks package.json's license field with
+	// validate-npm-package-license: a case-sensitive SPDX expression
+	// without LicenseRef, "UNLICENSED", or "SEE LICENSE IN <filename>".
+	RegistryNPM PackageRegistry = "npm"
+	// RegistryPyPI is PyPI, which checks the License-Expression core
+	// metadata field under PEP 639: an SPDX expression matched without
+	// regard to case, with LicenseRef but not DocumentRef.
+	RegistryPyPI PackageRegistry = "pypi"
+	// RegistryCrates is crates.io, which checks Cargo.toml's license
+	// field as an SPDX expression and still accepts "/" for OR, as in
+	// "MIT/Apache-2.0".
+	RegistryCrates PackageRegistry = "crates.io"
+)
+
+// RegistryCheck is the result of ValidateForRegistry.
+type RegistryCheck struct {
+	Registry PackageRegistry
+	OK       bool
+	// Message is the error the registry's own tooling reports, empty
+	// when OK.
+	Message string
+	// Reason explains the failure in detail when the expression itself
+	// is invalid SPDX.
+	Reason Reason
+	// Suggestion is an expression the registry would accept, if one is
+	// known.
+	Suggestion string
+}
+
+// Messages the registries' tooling reports for a rejected license field.
+const (
+	npmLicenseWarning    = `license should be a valid SPDX license expression (without "LicenseRef"), "UNLICENSED", or "SEE LICENSE IN <filename>"`
+	cratesLicenseMessage = "unknown or invalid license expression; see http://opensource.org/licenses for options, and http://spdx.org/licenses/ for their identifiers"
+)
+
+var (
+	// reNPMSeeLicense matches npm's pointer to a license file.
+	reNPMSeeLicense = regexp.MustCompile(`^SEE LICEN[CS]E IN .+$`)
+	// reCratesSlash matches the "/" crates.io accepts in place of OR.
+	reCratesSlash = regexp.MustCompile(`\s*/\s*`)
+)
+
+// ValidateForRegistry checks expression against the documented rules of
+// a package registry's license field and reports whether an upload would
+// pass, with the message the registry's tooling shows when it would not.
+// An unknown registry fails every expression.
+//
+// Example:
+//
+//	ValidateForRegistry("SEE LICENSE IN LICENSE.md", RegistryNPM).OK  // true
+//	ValidateForRegistry("MIT/Apache-2.0", RegistryCrates).OK          // true
+//	ValidateForRegistry("MIT/Apache-2.0", RegistryPyPI).Message      // "Unknown license: 'MIT/Apache-2.0'"
+//	ValidateForRegistry("mit", RegistryNPM).Message
+//	// `license is similar to the valid expression "MIT"`
+func ValidateForRegistry(expression string, registry PackageRegistry) RegistryCheck {
+	check := RegistryCheck{Registry: registry}
+	trimmed := strings.TrimSpace(expression)
+
+	switch registry {
+	case RegistryNPM:
+		if trimmed == "UNLICENSED" || trimmed == "UNLICENCED" || reNPMSeeLicense.MatchString(trimmed) {
+			check.OK = true
+			return check
+		}
+		check.fail(trimmed, npmLicenseWarning, func(tok posToken, raw string) bool {
+			switch tok.typ {
+			case tokenLicenseRef, tokenDocumentRef:
+				return false
+			case tokenAnd, tokenOr, tokenWith:
+				return raw == tok.value
+			case tokenLicense:
+				return lookupLicense(raw) == raw || lookupException(raw) == raw
+			}
+			return true
+		})
+		if !check.OK && check.Suggestion != "" {
+			check.Message = fmt.Sprintf("license is similar to the valid expression %q", check.Suggestion)
+		}
+	case RegistryPyPI:
+		check.fail(trimmed, fmt.Sprintf("Invalid license expression: %s", pythonQuote(trimmed)), func(tok posToken, raw string) bool {
+			return tok.typ != tokenDocumentRef
+		})
+		if !check.OK && check.Reason.Code == CodeInvalidLicenseID {
+			check.Message = fmt.Sprintf("Unknown license: %s", pythonQuote(check.Reason.Token))
+		}
+	case RegistryCrates:
+		check.fail(reCratesSlash.ReplaceAllString(trimmed, " OR "), cratesLicenseMessage, nil)
+	default:
+		check.Message = fmt.Sprintf("unknown package registry %q", registry)
+	}
+	return check
+}
+
+// fail validates expr as a strict SPDX expression without NONE or
+// NOASSERTION, which no registry accepts, and with allowed approving each
+// token, given its text as written. On failure it sets Message to message
+// and fills in Reason and Suggestion.
+func (c *RegistryCheck) fail(expr, message string, allowed func(tok posToken, raw string) bool) {
+	reason, invalid := InvalidReason(expr)
+	if !invalid {
+		toks, _ := scanTokens(expr)
+		for _, tok := range toks {
+			raw := expr[tok.offset : tok.offset+len(tok.value)]
+			upper := strings.ToUpper(raw)
+			if tok.typ == tokenLicense && (upper == "NONE" || upper == "NOASSERTION") {
+				reason = Reason{Code: CodeInvalidSpecialValue, Position: tok.offset + 1, Token: raw,
+					Message: fmt.Sprintf("%s at position %d is not accepted by %s; name the license instead.", upper, tok.offset+1, c.Registry)}
+				invalid = true
+				break
+			}
+			if tok.typ != tokenEOF && allowed != nil && !allowed(tok, raw) {
+				reason = Reason{Code: CodeUnexpectedToken, Position: tok.offset + 1, Token: raw,
+					Message: fmt.Sprintf("%q at position %d is not accepted by %s.", raw, tok.offset+1, c.Registry)}
+				invalid = true
+				break
+			}
+		}
+	}
+	if !invalid {
+		c.OK = true
+		return
+	}
+
+	c.Message = message
+	c.Reason = reason
+	if suggestion := suggestExpression(expr, loadConfig()); suggestion != "" && ValidateForRegistry(suggestion, c.Registry).OK {
+		c.Suggestion = suggestion
+	}
+}
+
+// pythonQuote quotes s the way Python's repr quotes a string, which PyPI's
+// messages use.
+func pythonQuote(s string) string {
+	if strings.Contains(s, "'") && !strings.Contains(s, `"`) {
+		return `"` + s + `"`
+	}
+	return "'" + strings.ReplaceAll(s, "'", `\'`) + "'"
+}
@@ -0,0 +1,64 @@
+package spdx
+
+import "testing"
+
+func TestValidateForRegistry(t *testing.T) {
+	tests := []struct {
+		registry   PackageRegistry
+		input      string
+		ok         bool
+		message    string
+		suggestion string
+	}{
+		{RegistryNPM, "MIT OR Apache-2.0", true, "", ""},
+		{RegistryNPM, "UNLICENSED", true, "", ""},
+		{RegistryNPM, "SEE LICENSE IN LICENSE.md", true, "", ""},
+		{RegistryNPM, "GPL-2.0-only WITH Classpath-exception-2.0", true, "", ""},
+		{RegistryNPM, "mit", false, `license is similar to the valid expression "MIT"`, "MIT"},
+		{RegistryNPM, "MIT or ISC", false, `license is similar to the valid expression "MIT OR ISC"`, "MIT OR ISC"},
+		{RegistryNPM, "LicenseRef-Custom", false, npmLicenseWarning, ""},
+		{RegistryNPM, "see license in LICENSE", false, npmLicenseWarning, ""},
+		{RegistryNPM, "MIT/Apache-2.0", false, npmLicenseWarning, ""},
+		{RegistryNPM, "NONE", false, npmLicenseWarning, ""},
+
+		{RegistryPyPI, "MIT OR Apache-2.0", true, "", ""},
+		{RegistryPyPI, "mit or apache-2.0", true, "", ""},
+		{RegistryPyPI, "LicenseRef-Proprietary", true, "", ""},
+		{RegistryPyPI, "DocumentRef-x:LicenseRef-y", false, "Invalid license expression: 'DocumentRef-x:LicenseRef-y'", ""},
+		{RegistryPyPI, "MIT/Apache-2.0", false, "Unknown license: 'MIT/Apache-2.0'", ""},
+		{RegistryPyPI, "Apache 2", false, "Unknown license: 'Apache'", "Apache-2.0"},
+		{RegistryPyPI, "MIT AND", false, "Invalid license expression: 'MIT AND'", ""},
+		{RegistryPyPI, "NOASSERTION", false, "Invalid license expression: 'NOASSERTION'", ""},
+
+		{RegistryCrates, "MIT OR Apache-2.0", true, "", ""},
+		{RegistryCrates, "MIT/Apache-2.0", true, "", ""},
+		{RegistryCrates, "MIT / Apache-2.0", true, "", ""},
+		{RegistryCrates, "Apache 2.0", false, cratesLicenseMessage, "Apache-2.0"},
+		{RegistryCrates, "UNLICENSED", false, cratesLicenseMessage, ""},
+
+		{"cpan", "MIT", false, `unknown package registry "cpan"`, ""},
+	}
+
+	for _, tt := range tests {
+		got := ValidateForRegistry(tt.input, tt.registry)
+		if got.OK != tt.ok || got.Message != tt.message || got.Suggestion != tt.suggestion {
+			t.Errorf("ValidateForRegistry(%q, %s) = %v, %q, suggestion %q; want %v, %q, %q", tt.input, tt.registry, got.OK, got.Message, got.Suggestion, tt.ok, tt.message, tt.suggestion)
+		}
+		if !got.OK && tt.registry != "cpan" && got.Reason.Message == "" {
+			t.Errorf("ValidateForRegistry(%q, %s) has no Reason", tt.input, tt.registry)
+		}
+	}
+}
+
+func TestPythonQuote(t *testing.T) {
+	tests := map[string]string{
+		"MIT":         "'MIT'",
+		"it's":        `"it's"`,
+		`it's "this"`: `'it\'s "this"'`,
+	}
+	for input, want := range tests {
+		if got := pythonQuote(input); got != want {
+			t.Errorf("pythonQuote(%q) = %s, want %s", input, got, want)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package spdx
+
+// spdxLicenseListURL is the base of the SPDX license list detail pages,
+// which exist for every license and exception identifier.
+const spdxLicenseListURL = "https://spdx.org/licenses/"
+
+// seeAlsoData holds the first seeAlso URL from the SPDX license list for
+// common licenses and exceptions.
+var seeAlsoData = map[string]string{
+	"0BSD":              "http://landley.net/toybox/license.html",
+	"AGPL-3.0-only":     "https://www.gnu.org/licenses/agpl.txt",
+	"AGPL-3.0-or-later": "https://www.gnu.org/licenses/agpl.txt",
+	"Apache-2.0":        "https://www.apache.org/licenses/LICENSE-2.0",
+	"Artistic-2.0":      "http://www.perlfoundation.org/artistic_license_2_0",
+	"BlueOak-1.0.0":     "https://blueoakcouncil.org/license/1.0.0",
+	"BSD-2-Clause":      "https://opensource.org/licenses/BSD-2-Clause",
+	"BSD-3-Clause":      "https://opensource.org/licenses/BSD-3-Clause",
+	"BSL-1.0":           "http://www.boost.org/LICENSE_1_0.txt",
+	"CC-BY-4.0":         "https://creativecommons.org/licenses/by/4.0/legalcode",
+	"CC-BY-SA-4.0":      "https://creativecommons.org/licenses/by-sa/4.0/legalcode",
+	"CC0-1.0":           "https://creativecommons.org/publicdomain/zero/1.0/legalcode",
+	"CDDL-1.0":          "https://opensource.org/licenses/cddl1",
+	"EPL-1.0":           "http://www.eclipse.org/legal/epl-v10.html",
+	"EPL-2.0":           "https://www.eclipse.org/legal/epl-2.0",
+	"EUPL-1.2":          "https://joinup.ec.europa.eu/page/eupl-text-11-12",
+	"GPL-2.0-only":      "https://www.gnu.org/licenses/old-licenses/gpl-2.0-standalone.html",
+	"GPL-2.0-or-later":  "https://www.gnu.org/licenses/old-licenses/gpl-2.0-standalone.html",
+	"GPL-3.0-only":      "https://www.gnu.org/licenses/gpl-3.0-standalone.html",
+	"GPL-3.0-or-later":  "https://www.gnu.org/licenses/gpl-3.0-standalone.html",
+	"ISC":               "https://www.isc.org/licenses/",
+	"LGPL-2.0-only":     "https://www.gnu.org/licenses/old-licenses/lgpl-2.0-standalone.html",
+	"LGPL-2.0-or-later": "https://www.gnu.org/licenses/old-licenses/lgpl-2.0-standalone.html",
+	"LGPL-2.1-only":     "https://www.gnu.org/licenses/old-licenses/lgpl-2.1-standalone.html",
+	"LGPL-2.1-or-later": "https://www.gnu.org/licenses/old-licenses/lgpl-2.1-standalone.html",
+	"LGPL-3.0-only":     "https://www.gnu.org/licenses/lgpl-3.0-standalone.html",
+	"LGPL-3.0-or-later": "https://www.gnu.org/licenses/lgpl-3.0-standalone.html",
+	"MIT":               "https://opensource.org/license/mit/",
+	"MIT-0":             "https://github.com/aws/mit-0",
+	"MPL-1.1":           "http://www.mozilla.org/MPL/MPL-1.1.html",
+	"MPL-2.0":           "https://www.mozilla.org/MPL/2.0/",
+	"OFL-1.1":           "http://scripts.sil.org/cms/scripts/page.php?item_id=OFL_web",
+	"PostgreSQL":        "http://www.postgresql.org/about/licence",
+	"Python-2.0":        "https://opensource.org/licenses/Python-2.0",
+	"Unlicense":         "https://unlicense.org/",
+	"UPL-1.0":           "https://opensource.org/licenses/UPL",
+	"WTFPL":             "http://www.wtfpl.net/about/",
+	"Zlib":              "http://www.zlib.net/zlib_license.html",
+
+	// Exceptions
+	"Classpath-exception-2.0": "http://www.gnu.org/software/classpath/license.html",
+	"GCC-exception-3.1":       "http://www.gnu.org/licenses/gcc-exception-3.1.html",
+	"Linux-syscall-note":      "https://git.kernel.org/pub/scm/linux/kernel/git/torvalds/linux.git/tree/COPYING",
+	"LLVM-exception":          "http://llvm.org/foundation/relicensing/LICENSE.txt",
+}
+
+// LicenseURL returns the spdx.org detail page for a license identifier and
+// the primary seeAlso URL from the SPDX license list. seeAlso is empty for
+// licenses without URL data, and both are empty for unknown identifiers.
+//
+// Example:
+//
+//	LicenseURL("Apache-2.0")
+//	// "https://spdx.org/licenses/Apache-2.0.html",
+//	// "https://www.apache.org/licenses/LICENSE-2.0"
+func LicenseURL(id string) (page, seeAlso string) {
+	canonical := lookupLicense(id)
+	if canonical == "" {
+		return "", ""
+	}
+	seeAlso, ok := seeAlsoData[canonical]
+	if !ok {
+		seeAlso = seeAlsoData[upgradeGPL(canonical)]
+	}
+	return spdxLicenseListURL + canonical + ".html", seeAlso
+}
+
+// ExceptionURL returns the spdx.org detail page for an exception identifier
+// and its primary seeAlso URL, like LicenseURL.
+//
+// Example:
+//
+//	ExceptionURL("LLVM-exception")
+//	// "https://spdx.org/licenses/LLVM-exception.html",
+//	// "http://llvm.org/foundation/relicensing/LICENSE.txt"
+func ExceptionURL(id string) (page, seeAlso string) {
+	canonical := lookupException(id)
+	if canonical == "" {
+		return "", ""
+	}
+	return spdxLicenseListURL + canonical + ".html", seeAlsoData[canonical]
+}
@@ -0,0 +1,44 @@
+package spdx
+
+import "testing"
+
+func TestLicenseURL(t *testing.T) {
+	tests := []struct {
+		id, page, seeAlso string
+	}{
+		{"Apache-2.0", "https://spdx.org/licenses/Apache-2.0.html", "https://www.apache.org/licenses/LICENSE-2.0"},
+		{"mit", "https://spdx.org/licenses/MIT.html", "https://opensource.org/license/mit/"},
+		{"GPL-2.0", "https://spdx.org/licenses/GPL-2.0.html", "https://www.gnu.org/licenses/old-licenses/gpl-2.0-standalone.html"},
+		{"Glide", "https://spdx.org/licenses/Glide.html", ""},
+		{"FAKEYLICENSE", "", ""},
+		{"Classpath-exception-2.0", "", ""},
+	}
+
+	for _, tt := range tests {
+		page, seeAlso := LicenseURL(tt.id)
+		if page != tt.page || seeAlso != tt.seeAlso {
+			t.Errorf("LicenseURL(%q) = %q, %q, want %q, %q", tt.id, page, seeAlso, tt.page, tt.seeAlso)
+		}
+	}
+}
+
+func TestExceptionURL(t *testing.T) {
+	page, seeAlso := ExceptionURL("classpath-exception-2.0")
+	if page != "https://spdx.org/licenses/Classpath-exception-2.0.html" {
+		t.Errorf("page = %q", page)
+	}
+	if seeAlso != "http://www.gnu.org/software/classpath/license.html" {
+		t.Errorf("seeAlso = %q", seeAlso)
+	}
+	if page, _ := ExceptionURL("MIT"); page != "" {
+		t.Errorf("ExceptionURL(MIT) = %q, want empty", page)
+	}
+}
+
+func TestSeeAlsoDataKeys(t *testing.T) {
+	for id := range seeAlsoData {
+		if lookupLicense(id) != id && lookupException(id) != id {
+			t.Errorf("seeAlsoData key %q is not a canonical SPDX identifier", id)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package spdx
+
+// Walk calls fn for each node of expr in pre-order: a node before its
+// operands, and the left operand of AND and OR before the right. If fn
+// returns false, Walk stops without visiting the remaining nodes. A nil
+// expr visits nothing.
+//
+// Example:
+//
+//	expr, _ := Parse("MIT AND (Apache-2.0 OR GPL-2.0-only)")
+//	var ids []string
+//	Walk(expr, func(e Expression) bool {
+//		if l, ok := e.(*License); ok {
+//			ids = append(ids, l.ID)
+//		}
+//		return true
+//	})
+//	// ids: ["MIT", "Apache-2.0", "GPL-2.0-only"]
+func Walk(expr Expression, fn func(Expression) bool) {
+	walk(expr, fn)
+}
+
+// walk implements Walk, reporting false once fn has stopped the walk.
+func walk(expr Expression, fn func(Expression) bool) bool {
+	if expr == nil {
+		return true
+	}
+	if !fn(expr) {
+		return false
+	}
+	switch e := expr.(type) {
+	case *AndExpression:
+		return walk(e.Left, fn) && walk(e.Right, fn)
+	case *OrExpression:
+		return walk(e.Left, fn) && walk(e.Right, fn)
+	}
+	return true
+}
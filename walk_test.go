@@ -0,0 +1,64 @@
+package spdx
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestWalk(t *testing.T) {
+	expr, err := Parse("MIT AND (Apache-2.0 OR GPL-2.0-only WITH Classpath-exception-2.0) AND LicenseRef-Foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var visited []string
+	Walk(expr, func(e Expression) bool {
+		switch n := e.(type) {
+		case *AndExpression:
+			visited = append(visited, "AND")
+		case *OrExpression:
+			visited = append(visited, "OR")
+		default:
+			visited = append(visited, n.String())
+		}
+		return true
+	})
+	want := []string{"AND", "AND", "MIT", "OR", "Apache-2.0", "GPL-2.0-only WITH Classpath-exception-2.0", "LicenseRef-Foo"}
+	if !slices.Equal(visited, want) {
+		t.Errorf("visited %v, want %v", visited, want)
+	}
+}
+
+func TestWalkStops(t *testing.T) {
+	expr, err := Parse("MIT OR (GPL-3.0-only AND ISC) OR Apache-2.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var licenses []string
+	Walk(expr, func(e Expression) bool {
+		l, ok := e.(*License)
+		if !ok {
+			return true
+		}
+		licenses = append(licenses, l.ID)
+		return !IsCopyleft(l.ID)
+	})
+	if want := []string{"MIT", "GPL-3.0-only"}; !slices.Equal(licenses, want) {
+		t.Errorf("visited %v before stopping, want %v", licenses, want)
+	}
+
+	calls := 0
+	Walk(expr, func(Expression) bool {
+		calls++
+		return false
+	})
+	if calls != 1 {
+		t.Errorf("fn called %d times after returning false at the root, want 1", calls)
+	}
+
+	Walk(nil, func(Expression) bool {
+		t.Error("fn called for a nil expression")
+		return true
+	})
+}